@@ -190,15 +190,31 @@ func flattenCacheBehaviors(cbs *cloudfront.CacheBehaviors) []interface{} {
 func expandCloudFrontDefaultCacheBehavior(m map[string]interface{}) *cloudfront.DefaultCacheBehavior {
 	dcb := &cloudfront.DefaultCacheBehavior{
 		Compress:               aws.Bool(m["compress"].(bool)),
-		DefaultTTL:             aws.Int64(int64(m["default_ttl"].(int))),
 		FieldLevelEncryptionId: aws.String(m["field_level_encryption_id"].(string)),
-		ForwardedValues:        expandForwardedValues(m["forwarded_values"].([]interface{})[0].(map[string]interface{})),
-		MaxTTL:                 aws.Int64(int64(m["max_ttl"].(int))),
-		MinTTL:                 aws.Int64(int64(m["min_ttl"].(int))),
 		TargetOriginId:         aws.String(m["target_origin_id"].(string)),
 		ViewerProtocolPolicy:   aws.String(m["viewer_protocol_policy"].(string)),
 	}
 
+	if v, ok := m["cache_policy_id"].(string); ok && v != "" {
+		dcb.CachePolicyId = aws.String(v)
+	} else {
+		dcb.DefaultTTL = aws.Int64(int64(m["default_ttl"].(int)))
+		dcb.MaxTTL = aws.Int64(int64(m["max_ttl"].(int)))
+		dcb.MinTTL = aws.Int64(int64(m["min_ttl"].(int)))
+	}
+
+	if v, ok := m["forwarded_values"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		dcb.ForwardedValues = expandForwardedValues(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["origin_request_policy_id"].(string); ok && v != "" {
+		dcb.OriginRequestPolicyId = aws.String(v)
+	}
+
+	if v, ok := m["realtime_log_config_arn"].(string); ok && v != "" {
+		dcb.RealtimeLogConfigArn = aws.String(v)
+	}
+
 	if v, ok := m["trusted_signers"]; ok {
 		dcb.TrustedSigners = expandTrustedSigners(v.([]interface{}))
 	} else {
@@ -225,15 +241,31 @@ func expandCloudFrontDefaultCacheBehavior(m map[string]interface{}) *cloudfront.
 func expandCacheBehavior(m map[string]interface{}) *cloudfront.CacheBehavior {
 	cb := &cloudfront.CacheBehavior{
 		Compress:               aws.Bool(m["compress"].(bool)),
-		DefaultTTL:             aws.Int64(int64(m["default_ttl"].(int))),
 		FieldLevelEncryptionId: aws.String(m["field_level_encryption_id"].(string)),
-		ForwardedValues:        expandForwardedValues(m["forwarded_values"].([]interface{})[0].(map[string]interface{})),
-		MaxTTL:                 aws.Int64(int64(m["max_ttl"].(int))),
-		MinTTL:                 aws.Int64(int64(m["min_ttl"].(int))),
 		TargetOriginId:         aws.String(m["target_origin_id"].(string)),
 		ViewerProtocolPolicy:   aws.String(m["viewer_protocol_policy"].(string)),
 	}
 
+	if v, ok := m["cache_policy_id"].(string); ok && v != "" {
+		cb.CachePolicyId = aws.String(v)
+	} else {
+		cb.DefaultTTL = aws.Int64(int64(m["default_ttl"].(int)))
+		cb.MaxTTL = aws.Int64(int64(m["max_ttl"].(int)))
+		cb.MinTTL = aws.Int64(int64(m["min_ttl"].(int)))
+	}
+
+	if v, ok := m["forwarded_values"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		cb.ForwardedValues = expandForwardedValues(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := m["origin_request_policy_id"].(string); ok && v != "" {
+		cb.OriginRequestPolicyId = aws.String(v)
+	}
+
+	if v, ok := m["realtime_log_config_arn"].(string); ok && v != "" {
+		cb.RealtimeLogConfigArn = aws.String(v)
+	}
+
 	if v, ok := m["trusted_signers"]; ok {
 		cb.TrustedSigners = expandTrustedSigners(v.([]interface{}))
 	} else {
@@ -268,6 +300,15 @@ func flattenCloudFrontDefaultCacheBehavior(dcb *cloudfront.DefaultCacheBehavior)
 		"min_ttl":                   aws.Int64Value(dcb.MinTTL),
 	}
 
+	if dcb.CachePolicyId != nil {
+		m["cache_policy_id"] = aws.StringValue(dcb.CachePolicyId)
+	}
+	if dcb.OriginRequestPolicyId != nil {
+		m["origin_request_policy_id"] = aws.StringValue(dcb.OriginRequestPolicyId)
+	}
+	if dcb.RealtimeLogConfigArn != nil {
+		m["realtime_log_config_arn"] = aws.StringValue(dcb.RealtimeLogConfigArn)
+	}
 	if dcb.ForwardedValues != nil {
 		m["forwarded_values"] = []interface{}{flattenForwardedValues(dcb.ForwardedValues)}
 	}
@@ -305,6 +346,15 @@ func flattenCacheBehavior(cb *cloudfront.CacheBehavior) map[string]interface{} {
 	m["target_origin_id"] = aws.StringValue(cb.TargetOriginId)
 	m["min_ttl"] = int(aws.Int64Value(cb.MinTTL))
 
+	if cb.CachePolicyId != nil {
+		m["cache_policy_id"] = aws.StringValue(cb.CachePolicyId)
+	}
+	if cb.OriginRequestPolicyId != nil {
+		m["origin_request_policy_id"] = aws.StringValue(cb.OriginRequestPolicyId)
+	}
+	if cb.RealtimeLogConfigArn != nil {
+		m["realtime_log_config_arn"] = aws.StringValue(cb.RealtimeLogConfigArn)
+	}
 	if cb.ForwardedValues != nil {
 		m["forwarded_values"] = []interface{}{flattenForwardedValues(cb.ForwardedValues)}
 	}
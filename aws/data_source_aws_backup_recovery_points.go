@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
+)
+
+func dataSourceAwsBackupRecoveryPoints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsBackupRecoveryPointsRead,
+
+		Schema: map[string]*schema.Schema{
+			"backup_vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"backup_plan_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"created_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"created_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"recovery_point_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"recovery_points": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_plan_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_size_in_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"completion_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"encryption_key_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"iam_role_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_encrypted": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"recovery_point_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsBackupRecoveryPointsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	input := &backup.ListRecoveryPointsByBackupVaultInput{
+		BackupVaultName: aws.String(d.Get("backup_vault_name").(string)),
+	}
+
+	if v, ok := d.GetOk("backup_plan_id"); ok {
+		input.ByBackupPlanId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("created_after"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		input.ByCreatedAfter = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("created_before"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string))
+		input.ByCreatedBefore = aws.Time(t)
+	}
+
+	if v, ok := d.GetOk("resource_arn"); ok {
+		input.ByResourceArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ByResourceType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Listing Backup Recovery Points: %s", input)
+	var recoveryPoints []*backup.RecoveryPointByBackupVault
+	err := conn.ListRecoveryPointsByBackupVaultPages(input, func(page *backup.ListRecoveryPointsByBackupVaultOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, recoveryPoint := range page.RecoveryPoints {
+			if recoveryPoint == nil {
+				continue
+			}
+
+			recoveryPoints = append(recoveryPoints, recoveryPoint)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Backup Recovery Points: %w", err)
+	}
+
+	// Most recent first so that index 0 is the latest recovery point.
+	sort.Slice(recoveryPoints, func(i, j int) bool {
+		return aws.TimeValue(recoveryPoints[i].CreationDate).After(aws.TimeValue(recoveryPoints[j].CreationDate))
+	})
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(input.String())))
+
+	if err := d.Set("recovery_point_arns", flattenBackupRecoveryPointArns(recoveryPoints)); err != nil {
+		return fmt.Errorf("error setting recovery_point_arns: %w", err)
+	}
+
+	if err := d.Set("recovery_points", flattenBackupRecoveryPoints(recoveryPoints)); err != nil {
+		return fmt.Errorf("error setting recovery_points: %w", err)
+	}
+
+	return nil
+}
+
+func flattenBackupRecoveryPointArns(recoveryPoints []*backup.RecoveryPointByBackupVault) []string {
+	arns := make([]string, 0, len(recoveryPoints))
+
+	for _, recoveryPoint := range recoveryPoints {
+		arns = append(arns, aws.StringValue(recoveryPoint.RecoveryPointArn))
+	}
+
+	return arns
+}
+
+func flattenBackupRecoveryPoints(recoveryPoints []*backup.RecoveryPointByBackupVault) []interface{} {
+	tfList := make([]interface{}, 0, len(recoveryPoints))
+
+	for _, recoveryPoint := range recoveryPoints {
+		tfMap := map[string]interface{}{
+			"backup_size_in_bytes": int(aws.Int64Value(recoveryPoint.BackupSizeInBytes)),
+			"encryption_key_arn":   aws.StringValue(recoveryPoint.EncryptionKeyArn),
+			"iam_role_arn":         aws.StringValue(recoveryPoint.IamRoleArn),
+			"is_encrypted":         aws.BoolValue(recoveryPoint.IsEncrypted),
+			"recovery_point_arn":   aws.StringValue(recoveryPoint.RecoveryPointArn),
+			"resource_arn":         aws.StringValue(recoveryPoint.ResourceArn),
+			"resource_type":        aws.StringValue(recoveryPoint.ResourceType),
+			"status":               aws.StringValue(recoveryPoint.Status),
+		}
+
+		if v := recoveryPoint.CompletionDate; v != nil {
+			tfMap["completion_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := recoveryPoint.CreationDate; v != nil {
+			tfMap["creation_date"] = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		if v := recoveryPoint.CreatedBy; v != nil {
+			tfMap["backup_plan_id"] = aws.StringValue(v.BackupPlanId)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSBackupRecoveryPointsDataSource_basic(t *testing.T) {
+	datasourceName := "data.aws_backup_recovery_points.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSBackup(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsBackupRecoveryPointsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "recovery_point_arns.#", "0"),
+					resource.TestCheckResourceAttr(datasourceName, "recovery_points.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSBackupRecoveryPointsDataSource_Filters(t *testing.T) {
+	datasourceName := "data.aws_backup_recovery_points.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSBackup(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsBackupRecoveryPointsDataSourceConfig_filters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "recovery_point_arns.#", "0"),
+					resource.TestCheckResourceAttr(datasourceName, "recovery_points.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsBackupRecoveryPointsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+data "aws_backup_recovery_points" "test" {
+  backup_vault_name = aws_backup_vault.test.name
+}
+`, rName)
+}
+
+func testAccAwsBackupRecoveryPointsDataSourceConfig_filters(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_backup_vault" "test" {
+  name = %[1]q
+}
+
+data "aws_backup_recovery_points" "test" {
+  backup_vault_name = aws_backup_vault.test.name
+  resource_arn      = "arn:${data.aws_partition.current.partition}:ec2:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:volume/vol-12345678"
+  resource_type     = "EBS"
+  created_after     = "2020-01-01T00:00:00Z"
+  created_before    = "2030-01-01T00:00:00Z"
+}
+`, rName)
+}
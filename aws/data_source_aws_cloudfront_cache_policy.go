@@ -0,0 +1,153 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+)
+
+func dataSourceAwsCloudFrontCachePolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudFrontCachePolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"max_ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"min_ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"parameters_in_cache_key_and_forwarded_to_origin": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cookies_config": cloudFrontPolicyItemsConfigDataSourceSchema("cookie_behavior", "cookies"),
+						"enable_accept_encoding_gzip": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"headers_config":       cloudFrontPolicyItemsConfigDataSourceSchema("header_behavior", "headers"),
+						"query_strings_config": cloudFrontPolicyItemsConfigDataSourceSchema("query_string_behavior", "query_strings"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsCloudFrontCachePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	id := d.Get("id").(string)
+
+	if id == "" {
+		name := d.Get("name").(string)
+		input := &cloudfront.ListCachePoliciesInput{}
+
+		for {
+			output, err := conn.ListCachePolicies(input)
+
+			if err != nil {
+				return fmt.Errorf("error listing CloudFront Cache Policies: %w", err)
+			}
+
+			if output == nil || output.CachePolicyList == nil {
+				break
+			}
+
+			for _, item := range output.CachePolicyList.Items {
+				if item == nil || item.CachePolicy == nil || item.CachePolicy.CachePolicyConfig == nil {
+					continue
+				}
+
+				if aws.StringValue(item.CachePolicy.CachePolicyConfig.Name) == name {
+					id = aws.StringValue(item.CachePolicy.Id)
+					break
+				}
+			}
+
+			if id != "" || aws.StringValue(output.CachePolicyList.NextMarker) == "" {
+				break
+			}
+
+			input.Marker = output.CachePolicyList.NextMarker
+		}
+
+		if id == "" {
+			return fmt.Errorf("no CloudFront Cache Policy found with name %q", name)
+		}
+	}
+
+	output, err := finder.CachePolicyByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Cache Policy (%s): %w", id, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading CloudFront Cache Policy (%s): not found", id)
+	}
+
+	d.SetId(id)
+	d.Set("etag", output.ETag)
+
+	return flattenCloudFrontCachePolicyConfig(d, output.CachePolicy.CachePolicyConfig)
+}
+
+func cloudFrontPolicyItemsConfigDataSourceSchema(behaviorKey, itemsKey string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				behaviorKey: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				itemsKey: {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"items": {
+								Type:     schema.TypeSet,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
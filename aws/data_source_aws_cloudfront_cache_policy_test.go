@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsCloudFrontCachePolicy_basic(t *testing.T) {
+	dataSource1Name := "data.aws_cloudfront_cache_policy.by_id"
+	dataSource2Name := "data.aws_cloudfront_cache_policy.by_name"
+	resourceName := "aws_cloudfront_cache_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontCachePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontCachePolicyDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSource1Name, "comment", resourceName, "comment"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "default_ttl", resourceName, "default_ttl"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "max_ttl", resourceName, "max_ttl"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "min_ttl", resourceName, "min_ttl"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "parameters_in_cache_key_and_forwarded_to_origin.#", resourceName, "parameters_in_cache_key_and_forwarded_to_origin.#"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "parameters_in_cache_key_and_forwarded_to_origin.0.cookies_config.0.cookie_behavior", resourceName, "parameters_in_cache_key_and_forwarded_to_origin.0.cookies_config.0.cookie_behavior"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsCloudFrontCachePolicy_Managed(t *testing.T) {
+	dataSourceName := "data.aws_cloudfront_cache_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontCachePolicyDataSourceConfigManaged(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "658327ea-f89d-4fab-a63d-7e88639e58f6"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-CachingOptimized"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameters_in_cache_key_and_forwarded_to_origin.0.enable_accept_encoding_gzip", "true"),
+				),
+			},
+			{
+				Config:      testAccAWSCloudFrontCachePolicyDataSourceConfigNonExistent(),
+				ExpectError: regexp.MustCompile(`no CloudFront Cache Policy found with name`),
+			},
+		},
+	})
+}
+
+func testAccAWSCloudFrontCachePolicyDataSourceConfig(rName string) string {
+	return composeConfig(
+		testAccAWSCloudFrontCachePolicyConfig(rName),
+		`
+data "aws_cloudfront_cache_policy" "by_id" {
+  id = aws_cloudfront_cache_policy.test.id
+}
+
+data "aws_cloudfront_cache_policy" "by_name" {
+  name = aws_cloudfront_cache_policy.test.name
+}
+`)
+}
+
+func testAccAWSCloudFrontCachePolicyDataSourceConfigManaged() string {
+	return `
+data "aws_cloudfront_cache_policy" "test" {
+  name = "Managed-CachingOptimized"
+}
+`
+}
+
+func testAccAWSCloudFrontCachePolicyDataSourceConfigNonExistent() string {
+	return `
+data "aws_cloudfront_cache_policy" "test" {
+  name = "tf-acc-test-does-not-exist"
+}
+`
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+)
+
+func dataSourceAwsCloudFrontOriginRequestPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCloudFrontOriginRequestPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cookies_config": cloudFrontPolicyItemsConfigDataSourceSchema("cookie_behavior", "cookies"),
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"headers_config": cloudFrontPolicyItemsConfigDataSourceSchema("header_behavior", "headers"),
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"query_strings_config": cloudFrontPolicyItemsConfigDataSourceSchema("query_string_behavior", "query_strings"),
+		},
+	}
+}
+
+func dataSourceAwsCloudFrontOriginRequestPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	id := d.Get("id").(string)
+
+	if id == "" {
+		name := d.Get("name").(string)
+		input := &cloudfront.ListOriginRequestPoliciesInput{}
+
+		for {
+			output, err := conn.ListOriginRequestPolicies(input)
+
+			if err != nil {
+				return fmt.Errorf("error listing CloudFront Origin Request Policies: %w", err)
+			}
+
+			if output == nil || output.OriginRequestPolicyList == nil {
+				break
+			}
+
+			for _, item := range output.OriginRequestPolicyList.Items {
+				if item == nil || item.OriginRequestPolicy == nil || item.OriginRequestPolicy.OriginRequestPolicyConfig == nil {
+					continue
+				}
+
+				if aws.StringValue(item.OriginRequestPolicy.OriginRequestPolicyConfig.Name) == name {
+					id = aws.StringValue(item.OriginRequestPolicy.Id)
+					break
+				}
+			}
+
+			if id != "" || aws.StringValue(output.OriginRequestPolicyList.NextMarker) == "" {
+				break
+			}
+
+			input.Marker = output.OriginRequestPolicyList.NextMarker
+		}
+
+		if id == "" {
+			return fmt.Errorf("no CloudFront Origin Request Policy found with name %q", name)
+		}
+	}
+
+	output, err := finder.OriginRequestPolicyByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Origin Request Policy (%s): %w", id, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading CloudFront Origin Request Policy (%s): not found", id)
+	}
+
+	d.SetId(id)
+	d.Set("etag", output.ETag)
+
+	return flattenCloudFrontOriginRequestPolicyConfig(d, output.OriginRequestPolicy.OriginRequestPolicyConfig)
+}
@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsCloudFrontOriginRequestPolicy_basic(t *testing.T) {
+	dataSource1Name := "data.aws_cloudfront_origin_request_policy.by_id"
+	dataSource2Name := "data.aws_cloudfront_origin_request_policy.by_name"
+	resourceName := "aws_cloudfront_origin_request_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontOriginRequestPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginRequestPolicyDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSource1Name, "comment", resourceName, "comment"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "cookies_config.#", resourceName, "cookies_config.#"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "etag", resourceName, "etag"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "headers_config.#", resourceName, "headers_config.#"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSource1Name, "query_strings_config.#", resourceName, "query_strings_config.#"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSource2Name, "name", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsCloudFrontOriginRequestPolicy_Managed(t *testing.T) {
+	dataSourceName := "data.aws_cloudfront_origin_request_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontOriginRequestPolicyDataSourceConfigManaged(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "216adef6-5c7f-47e4-b989-5492eafa07d3"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Managed-AllViewer"),
+					resource.TestCheckResourceAttr(dataSourceName, "headers_config.0.header_behavior", "allViewer"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSCloudFrontOriginRequestPolicyDataSourceConfig(rName string) string {
+	return composeConfig(
+		testAccAWSCloudFrontOriginRequestPolicyConfig(rName),
+		`
+data "aws_cloudfront_origin_request_policy" "by_id" {
+  id = aws_cloudfront_origin_request_policy.test.id
+}
+
+data "aws_cloudfront_origin_request_policy" "by_name" {
+  name = aws_cloudfront_origin_request_policy.test.name
+}
+`)
+}
+
+func testAccAWSCloudFrontOriginRequestPolicyDataSourceConfigManaged() string {
+	return `
+data "aws_cloudfront_origin_request_policy" "test" {
+  name = "Managed-AllViewer"
+}
+`
+}
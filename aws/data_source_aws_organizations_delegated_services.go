@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsOrganizationsDelegatedServices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsOrganizationsDelegatedServicesRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"delegated_services": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delegation_enabled_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_principal": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsOrganizationsDelegatedServicesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	accountID := d.Get("account_id").(string)
+	input := &organizations.ListDelegatedServicesForAccountInput{
+		AccountId: aws.String(accountID),
+	}
+
+	var delegatedServices []*organizations.DelegatedService
+
+	err := conn.ListDelegatedServicesForAccountPages(input, func(page *organizations.ListDelegatedServicesForAccountOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		delegatedServices = append(delegatedServices, page.DelegatedServices...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Organizations Delegated Services for account (%s): %w", accountID, err)
+	}
+
+	if err := d.Set("delegated_services", flattenOrganizationsDelegatedServices(delegatedServices)); err != nil {
+		return fmt.Errorf("error setting delegated_services: %w", err)
+	}
+
+	d.SetId(accountID)
+
+	return nil
+}
+
+func flattenOrganizationsDelegatedServices(delegatedServices []*organizations.DelegatedService) []map[string]interface{} {
+	if len(delegatedServices) == 0 {
+		return nil
+	}
+
+	var result []map[string]interface{}
+
+	for _, delegatedService := range delegatedServices {
+		if delegatedService == nil {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"delegation_enabled_date": aws.TimeValue(delegatedService.DelegationEnabledDate).Format(time.RFC3339),
+			"service_principal":       aws.StringValue(delegatedService.ServicePrincipal),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAwsOrganizationsDescendantAccounts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsOrganizationsDescendantAccountsRead,
+
+		Schema: map[string]*schema.Schema{
+			"accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"joined_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"joined_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsOrganizationsDescendantAccountsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).organizationsconn
+
+	parentID := d.Get("parent_id").(string)
+
+	accounts, err := getOrganizationsDescendantAccounts(conn, []string{parentID})
+
+	if err != nil {
+		return fmt.Errorf("error listing Organizations Accounts descending from parent (%s): %w", parentID, err)
+	}
+
+	if err := d.Set("accounts", accounts); err != nil {
+		return fmt.Errorf("error setting accounts: %w", err)
+	}
+
+	d.SetId(parentID)
+
+	return nil
+}
+
+// getOrganizationsDescendantAccounts walks the organization tree depth-first from the last
+// element of path, returning every account found along with the IDs of its ancestors.
+func getOrganizationsDescendantAccounts(conn *organizations.Organizations, path []string) ([]map[string]interface{}, error) {
+	parentID := path[len(path)-1]
+	var result []map[string]interface{}
+
+	accountsInput := &organizations.ListAccountsForParentInput{
+		ParentId: aws.String(parentID),
+	}
+
+	err := conn.ListAccountsForParentPages(accountsInput, func(page *organizations.ListAccountsForParentOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, account := range page.Accounts {
+			if account == nil {
+				continue
+			}
+
+			result = append(result, map[string]interface{}{
+				"arn":              aws.StringValue(account.Arn),
+				"email":            aws.StringValue(account.Email),
+				"id":               aws.StringValue(account.Id),
+				"joined_method":    aws.StringValue(account.JoinedMethod),
+				"joined_timestamp": aws.TimeValue(account.JoinedTimestamp).Format(time.RFC3339),
+				"name":             aws.StringValue(account.Name),
+				"parent_id":        parentID,
+				"path":             strings.Join(path, "/"),
+				"status":           aws.StringValue(account.Status),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing accounts for parent (%s): %w", parentID, err)
+	}
+
+	ousInput := &organizations.ListOrganizationalUnitsForParentInput{
+		ParentId: aws.String(parentID),
+	}
+
+	var ouIDs []string
+
+	err = conn.ListOrganizationalUnitsForParentPages(ousInput, func(page *organizations.ListOrganizationalUnitsForParentOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, ou := range page.OrganizationalUnits {
+			if ou == nil {
+				continue
+			}
+
+			ouIDs = append(ouIDs, aws.StringValue(ou.Id))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing organizational units for parent (%s): %w", parentID, err)
+	}
+
+	for _, ouID := range ouIDs {
+		childPath := make([]string, len(path), len(path)+1)
+		copy(childPath, path)
+		childPath = append(childPath, ouID)
+
+		accounts, err := getOrganizationsDescendantAccounts(conn, childPath)
+
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, accounts...)
+	}
+
+	return result, nil
+}
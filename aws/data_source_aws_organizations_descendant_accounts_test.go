@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func testAccDataSourceAwsOrganizationsDescendantAccounts_basic(t *testing.T) {
+	dataSourceName := "data.aws_organizations_descendant_accounts.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOrganizationsEnabledPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsOrganizationsDescendantAccountsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrGreaterThanValue(dataSourceName, "accounts.#", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "accounts.0.arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "accounts.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "accounts.0.parent_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "accounts.0.path"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "accounts.0.status"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAwsOrganizationsDescendantAccountsConfig = `
+data "aws_organizations_organization" "test" {}
+
+data "aws_organizations_descendant_accounts" "test" {
+  parent_id = data.aws_organizations_organization.test.roots[0].id
+}
+`
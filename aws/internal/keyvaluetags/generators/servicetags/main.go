@@ -79,6 +79,7 @@ var sliceServiceNames = []string{
 	"redshift",
 	"resourcegroupstaggingapi",
 	"route53",
+	"route53domains",
 	"route53resolver",
 	"s3",
 	"sagemaker",
@@ -0,0 +1,37 @@
+package cloudformation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+const (
+	TypeVersionArnSeparator = "/"
+	TypeVersionArnPrefix    = "type/"
+)
+
+// TypeVersionARNToTypeARNAndVersionID converts Type Version Amazon Resource Name (ARN) into Type ARN and Version ID
+func TypeVersionARNToTypeARNAndVersionID(inputARN string) (string, string, error) {
+	parsedARN, err := arn.Parse(inputARN)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing ARN (%s): %w", inputARN, err)
+	}
+
+	if actual, expected := parsedARN.Service, "cloudformation"; actual != expected {
+		return "", "", fmt.Errorf("expected service %s in ARN (%s), got: %s", expected, inputARN, actual)
+	}
+
+	// Type Version ARNs have the form type/<type>/<type-name>/<version-id>.
+	resourceParts := strings.Split(parsedARN.Resource, TypeVersionArnSeparator)
+
+	if actual, expected := len(resourceParts), 4; actual != expected || !strings.HasPrefix(parsedARN.Resource, TypeVersionArnPrefix) {
+		return "", "", fmt.Errorf("expected %d resource parts in ARN (%s), got: %d", expected, inputARN, actual)
+	}
+
+	parsedARN.Resource = strings.Join(resourceParts[:3], TypeVersionArnSeparator)
+
+	return parsedARN.String(), resourceParts[3], nil
+}
@@ -0,0 +1,49 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+// TypeByARN returns the registry type or type version corresponding to the specified ARN.
+// Returns nil if no type is found.
+func TypeByARN(conn *cloudformation.CloudFormation, arn string) (*cloudformation.DescribeTypeOutput, error) {
+	input := &cloudformation.DescribeTypeInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeType(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// TypeVersionsByTypeARN returns the LIVE versions of the registry type corresponding to the specified type ARN.
+func TypeVersionsByTypeARN(conn *cloudformation.CloudFormation, typeARN string) ([]*cloudformation.TypeVersionSummary, error) {
+	input := &cloudformation.ListTypeVersionsInput{
+		Arn:              aws.String(typeARN),
+		DeprecatedStatus: aws.String(cloudformation.DeprecatedStatusLive),
+	}
+	var results []*cloudformation.TypeVersionSummary
+
+	err := conn.ListTypeVersionsPages(input, func(page *cloudformation.ListTypeVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, summary := range page.TypeVersionSummaries {
+			if summary == nil {
+				continue
+			}
+
+			results = append(results, summary)
+		}
+
+		return !lastPage
+	})
+
+	return results, err
+}
@@ -0,0 +1,72 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// StackStatus NotFound
+	StackStatusNotFound = "NotFound"
+)
+
+// ChangeSetStatus fetches the Change Set and its Status
+func ChangeSetStatus(conn *cloudformation.CloudFormation, stackID, changeSetName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeChangeSet(&cloudformation.DescribeChangeSetInput{
+			ChangeSetName: aws.String(changeSetName),
+			StackName:     aws.String(stackID),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// StackStatus fetches the Stack and its Status
+func StackStatus(conn *cloudformation.CloudFormation, stackID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
+			StackName: aws.String(stackID),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || len(output.Stacks) == 0 {
+			return nil, StackStatusNotFound, nil
+		}
+
+		stack := output.Stacks[0]
+
+		return stack, aws.StringValue(stack.StackStatus), nil
+	}
+}
+
+// TypeRegistrationProgressStatus fetches the progress of the Type registration
+func TypeRegistrationProgressStatus(conn *cloudformation.CloudFormation, registrationToken string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeTypeRegistration(&cloudformation.DescribeTypeRegistrationInput{
+			RegistrationToken: aws.String(registrationToken),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.ProgressStatus), nil
+	}
+}
@@ -0,0 +1,101 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Change Set to be created
+	ChangeSetCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Type registration to complete
+	TypeRegistrationTimeout = 5 * time.Minute
+)
+
+// ChangeSetCreated waits for a Change Set to return CREATE_COMPLETE
+func ChangeSetCreated(conn *cloudformation.CloudFormation, stackID, changeSetName string) (*cloudformation.DescribeChangeSetOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.ChangeSetStatusCreateInProgress,
+			cloudformation.ChangeSetStatusCreatePending,
+		},
+		Target: []string{
+			cloudformation.ChangeSetStatusCreateComplete,
+		},
+		Refresh: ChangeSetStatus(conn, stackID, changeSetName),
+		Timeout: ChangeSetCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*cloudformation.DescribeChangeSetOutput); ok {
+		if err != nil && aws.StringValue(v.Status) == cloudformation.ChangeSetStatusFailed {
+			err = fmt.Errorf("%s: %w", aws.StringValue(v.StatusReason), err)
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// StackUpdated waits for a Stack to finish updating
+func StackUpdated(conn *cloudformation.CloudFormation, stackID string, timeout time.Duration) (*cloudformation.Stack, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.StackStatusUpdateCompleteCleanupInProgress,
+			cloudformation.StackStatusUpdateInProgress,
+			cloudformation.StackStatusUpdateRollbackInProgress,
+			cloudformation.StackStatusUpdateRollbackCompleteCleanupInProgress,
+		},
+		Target: []string{
+			cloudformation.StackStatusCreateComplete,
+			cloudformation.StackStatusUpdateComplete,
+			cloudformation.StackStatusUpdateRollbackComplete,
+			cloudformation.StackStatusUpdateRollbackFailed,
+		},
+		Refresh:    StackStatus(conn, stackID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*cloudformation.Stack); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// TypeRegistrationProgressStatusComplete waits for a Type registration to return COMPLETE
+func TypeRegistrationProgressStatusComplete(conn *cloudformation.CloudFormation, registrationToken string) (*cloudformation.DescribeTypeRegistrationOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudformation.RegistrationStatusInProgress,
+		},
+		Target: []string{
+			cloudformation.RegistrationStatusComplete,
+		},
+		Refresh: TypeRegistrationProgressStatus(conn, registrationToken),
+		Timeout: TypeRegistrationTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*cloudformation.DescribeTypeRegistrationOutput); ok {
+		if err != nil && aws.StringValue(v.ProgressStatus) == cloudformation.RegistrationStatusFailed {
+			err = fmt.Errorf("%s: %w", aws.StringValue(v.Description), err)
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
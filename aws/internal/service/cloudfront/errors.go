@@ -0,0 +1,7 @@
+package cloudfront
+
+const (
+	// ErrCodeNoSuchMonitoringSubscription is returned when a distribution has no monitoring subscription.
+	// The vendored SDK does not define this error code.
+	ErrCodeNoSuchMonitoringSubscription = "NoSuchMonitoringSubscription"
+)
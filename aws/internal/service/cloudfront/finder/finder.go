@@ -0,0 +1,126 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
+// CachePolicyByID returns the cache policy corresponding to the specified identifier, along with its ETag.
+// Returns nil if no cache policy is found.
+func CachePolicyByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetCachePolicyOutput, error) {
+	input := &cloudfront.GetCachePolicyInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetCachePolicy(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CachePolicy == nil || output.CachePolicy.CachePolicyConfig == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// OriginRequestPolicyByID returns the origin request policy corresponding to the specified identifier, along with its ETag.
+// Returns nil if no origin request policy is found.
+func OriginRequestPolicyByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetOriginRequestPolicyOutput, error) {
+	input := &cloudfront.GetOriginRequestPolicyInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetOriginRequestPolicy(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OriginRequestPolicy == nil || output.OriginRequestPolicy.OriginRequestPolicyConfig == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// RealtimeLogConfigByARN returns the real-time log configuration corresponding to the specified ARN.
+// Returns nil if no configuration is found.
+func RealtimeLogConfigByARN(conn *cloudfront.CloudFront, arn string) (*cloudfront.RealtimeLogConfig, error) {
+	input := &cloudfront.GetRealtimeLogConfigInput{
+		ARN: aws.String(arn),
+	}
+
+	output, err := conn.GetRealtimeLogConfig(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.RealtimeLogConfig, nil
+}
+
+// FieldLevelEncryptionConfigByID returns the field-level encryption configuration corresponding to the specified identifier, along with its ETag.
+// Returns nil if no configuration is found.
+func FieldLevelEncryptionConfigByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetFieldLevelEncryptionConfigOutput, error) {
+	input := &cloudfront.GetFieldLevelEncryptionConfigInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetFieldLevelEncryptionConfig(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.FieldLevelEncryptionConfig == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// FieldLevelEncryptionProfileByID returns the field-level encryption profile corresponding to the specified identifier, along with its ETag.
+// Returns nil if no profile is found.
+func FieldLevelEncryptionProfileByID(conn *cloudfront.CloudFront, id string) (*cloudfront.GetFieldLevelEncryptionProfileOutput, error) {
+	input := &cloudfront.GetFieldLevelEncryptionProfileInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetFieldLevelEncryptionProfile(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.FieldLevelEncryptionProfile == nil || output.FieldLevelEncryptionProfile.FieldLevelEncryptionProfileConfig == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// MonitoringSubscriptionByDistributionID returns the monitoring subscription of the specified distribution.
+// Returns nil if no monitoring subscription is found.
+func MonitoringSubscriptionByDistributionID(conn *cloudfront.CloudFront, id string) (*cloudfront.MonitoringSubscription, error) {
+	input := &cloudfront.GetMonitoringSubscriptionInput{
+		DistributionId: aws.String(id),
+	}
+
+	output, err := conn.GetMonitoringSubscription(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.MonitoringSubscription, nil
+}
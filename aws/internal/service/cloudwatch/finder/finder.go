@@ -0,0 +1,37 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// CompositeAlarmByName returns the composite alarm corresponding to the specified name.
+// Returns nil if no composite alarm is found.
+func CompositeAlarmByName(conn *cloudwatch.CloudWatch, name string) (*cloudwatch.CompositeAlarm, error) {
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: aws.StringSlice([]string{name}),
+		AlarmTypes: aws.StringSlice([]string{cloudwatch.AlarmTypeCompositeAlarm}),
+	}
+
+	output, err := conn.DescribeAlarms(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, alarm := range output.CompositeAlarms {
+		if alarm == nil {
+			continue
+		}
+
+		if aws.StringValue(alarm.AlarmName) == name {
+			return alarm, nil
+		}
+	}
+
+	return nil, nil
+}
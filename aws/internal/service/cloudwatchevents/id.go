@@ -0,0 +1,101 @@
+package cloudwatchevents
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const DefaultEventBusName = "default"
+
+// Partner event bus names have the form aws.partner/<partner-name>/<event-namespace>/<event-name>
+// and so contain the ID separator.
+var partnerEventBusPattern = regexp.MustCompile(`^aws\.partner(/[\.\-_A-Za-z0-9]+){2,}$`)
+
+const permissionIDSeparator = "/"
+
+func PermissionCreateID(eventBusName, statementID string) string {
+	if eventBusName == "" || eventBusName == DefaultEventBusName {
+		return statementID
+	}
+	return eventBusName + permissionIDSeparator + statementID
+}
+
+func PermissionParseID(id string) (string, string, error) {
+	eventBusName, statementID, ok := parseEventBusScopedID(id, permissionIDSeparator)
+
+	if !ok {
+		return "", "", fmt.Errorf("unexpected format for ID (%q), expected <statement-id> or <event-bus-name>"+permissionIDSeparator+"<statement-id>", id)
+	}
+
+	return eventBusName, statementID, nil
+}
+
+const ruleIDSeparator = "/"
+
+func RuleCreateID(eventBusName, ruleName string) string {
+	if eventBusName == "" || eventBusName == DefaultEventBusName {
+		return ruleName
+	}
+	return eventBusName + ruleIDSeparator + ruleName
+}
+
+func RuleParseID(id string) (string, string, error) {
+	eventBusName, ruleName, ok := parseEventBusScopedID(id, ruleIDSeparator)
+
+	if !ok {
+		return "", "", fmt.Errorf("unexpected format for ID (%q), expected <rule-name> or <event-bus-name>"+ruleIDSeparator+"<rule-name>", id)
+	}
+
+	return eventBusName, ruleName, nil
+}
+
+const targetIDSeparator = "-"
+
+// TargetCreateID returns the resource ID of a target. Targets on the default event bus
+// keep the legacy <rule-name>-<target-id> format.
+func TargetCreateID(eventBusName, ruleName, targetID string) string {
+	id := ruleName + targetIDSeparator + targetID
+	if eventBusName == "" || eventBusName == DefaultEventBusName {
+		return id
+	}
+	return eventBusName + targetIDSeparator + id
+}
+
+const targetImportIDSeparator = "/"
+
+// TargetParseImportID parses an import ID of the form <rule-name>/<target-id> or
+// <event-bus-name>/<rule-name>/<target-id> into its event bus name, rule name and target ID.
+func TargetParseImportID(id string) (string, string, string, error) {
+	i := strings.LastIndex(id, targetImportIDSeparator)
+
+	if i > 0 && i < len(id)-1 {
+		if eventBusName, ruleName, ok := parseEventBusScopedID(id[:i], targetImportIDSeparator); ok {
+			return eventBusName, ruleName, id[i+1:], nil
+		}
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%q), expected <rule-name>"+targetImportIDSeparator+"<target-id> or <event-bus-name>"+targetImportIDSeparator+"<rule-name>"+targetImportIDSeparator+"<target-id>", id)
+}
+
+// parseEventBusScopedID splits an ID of the form <name> or <event-bus-name><separator><name>.
+// An ID without an event bus name refers to the default event bus.
+func parseEventBusScopedID(id, separator string) (string, string, bool) {
+	parts := strings.Split(id, separator)
+
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return DefaultEventBusName, parts[0], true
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], true
+	case len(parts) > 2:
+		i := strings.LastIndex(id, separator)
+		eventBusName, name := id[:i], id[i+1:]
+
+		if partnerEventBusPattern.MatchString(eventBusName) && name != "" {
+			return eventBusName, name, true
+		}
+	}
+
+	return "", "", false
+}
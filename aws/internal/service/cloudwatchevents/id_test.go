@@ -0,0 +1,287 @@
+package cloudwatchevents_test
+
+import (
+	"testing"
+
+	tfevents "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents"
+)
+
+func TestRuleParseID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectedError        bool
+		ExpectedEventBusName string
+		ExpectedRuleName     string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "single separator",
+			InputID:       "/",
+			ExpectedError: true,
+		},
+		{
+			TestName:             "rule name",
+			InputID:              "TestRule",
+			ExpectedEventBusName: tfevents.DefaultEventBusName,
+			ExpectedRuleName:     "TestRule",
+		},
+		{
+			TestName:             "event bus name and rule name",
+			InputID:              "TestEventBus/TestRule",
+			ExpectedEventBusName: "TestEventBus",
+			ExpectedRuleName:     "TestRule",
+		},
+		{
+			TestName:      "empty event bus name",
+			InputID:       "/TestRule",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty rule name",
+			InputID:       "TestEventBus/",
+			ExpectedError: true,
+		},
+		{
+			TestName:             "partner event bus name and rule name",
+			InputID:              "aws.partner/example.com/Test/TestRule",
+			ExpectedEventBusName: "aws.partner/example.com/Test",
+			ExpectedRuleName:     "TestRule",
+		},
+		{
+			TestName:      "too many separators",
+			InputID:       "TestEventBus/Test/TestRule",
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotEventBusName, gotRuleName, err := tfevents.RuleParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotEventBusName != testCase.ExpectedEventBusName {
+				t.Errorf("got event bus name %q, expected %q", gotEventBusName, testCase.ExpectedEventBusName)
+			}
+
+			if gotRuleName != testCase.ExpectedRuleName {
+				t.Errorf("got rule name %q, expected %q", gotRuleName, testCase.ExpectedRuleName)
+			}
+		})
+	}
+}
+
+func TestRuleCreateID(t *testing.T) {
+	testCases := []struct {
+		TestName     string
+		EventBusName string
+		RuleName     string
+		ExpectedID   string
+	}{
+		{
+			TestName:   "no event bus name",
+			RuleName:   "TestRule",
+			ExpectedID: "TestRule",
+		},
+		{
+			TestName:     "default event bus name",
+			EventBusName: tfevents.DefaultEventBusName,
+			RuleName:     "TestRule",
+			ExpectedID:   "TestRule",
+		},
+		{
+			TestName:     "custom event bus name",
+			EventBusName: "TestEventBus",
+			RuleName:     "TestRule",
+			ExpectedID:   "TestEventBus/TestRule",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := tfevents.RuleCreateID(testCase.EventBusName, testCase.RuleName); got != testCase.ExpectedID {
+				t.Errorf("got ID %q, expected %q", got, testCase.ExpectedID)
+			}
+		})
+	}
+}
+
+func TestPermissionParseID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectedError        bool
+		ExpectedEventBusName string
+		ExpectedStatementID  string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:             "statement ID",
+			InputID:              "TestStatement",
+			ExpectedEventBusName: tfevents.DefaultEventBusName,
+			ExpectedStatementID:  "TestStatement",
+		},
+		{
+			TestName:             "event bus name and statement ID",
+			InputID:              "TestEventBus/TestStatement",
+			ExpectedEventBusName: "TestEventBus",
+			ExpectedStatementID:  "TestStatement",
+		},
+		{
+			TestName:             "partner event bus name and statement ID",
+			InputID:              "aws.partner/example.com/Test/TestStatement",
+			ExpectedEventBusName: "aws.partner/example.com/Test",
+			ExpectedStatementID:  "TestStatement",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotEventBusName, gotStatementID, err := tfevents.PermissionParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotEventBusName != testCase.ExpectedEventBusName {
+				t.Errorf("got event bus name %q, expected %q", gotEventBusName, testCase.ExpectedEventBusName)
+			}
+
+			if gotStatementID != testCase.ExpectedStatementID {
+				t.Errorf("got statement ID %q, expected %q", gotStatementID, testCase.ExpectedStatementID)
+			}
+		})
+	}
+}
+
+func TestTargetParseImportID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectedError        bool
+		ExpectedEventBusName string
+		ExpectedRuleName     string
+		ExpectedTargetID     string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "rule name only",
+			InputID:       "TestRule",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty target ID",
+			InputID:       "TestRule/",
+			ExpectedError: true,
+		},
+		{
+			TestName:             "rule name and target ID",
+			InputID:              "TestRule/TestTarget",
+			ExpectedEventBusName: tfevents.DefaultEventBusName,
+			ExpectedRuleName:     "TestRule",
+			ExpectedTargetID:     "TestTarget",
+		},
+		{
+			TestName:             "event bus name, rule name and target ID",
+			InputID:              "TestEventBus/TestRule/TestTarget",
+			ExpectedEventBusName: "TestEventBus",
+			ExpectedRuleName:     "TestRule",
+			ExpectedTargetID:     "TestTarget",
+		},
+		{
+			TestName:             "partner event bus name, rule name and target ID",
+			InputID:              "aws.partner/example.com/Test/TestRule/TestTarget",
+			ExpectedEventBusName: "aws.partner/example.com/Test",
+			ExpectedRuleName:     "TestRule",
+			ExpectedTargetID:     "TestTarget",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotEventBusName, gotRuleName, gotTargetID, err := tfevents.TargetParseImportID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotEventBusName != testCase.ExpectedEventBusName {
+				t.Errorf("got event bus name %q, expected %q", gotEventBusName, testCase.ExpectedEventBusName)
+			}
+
+			if gotRuleName != testCase.ExpectedRuleName {
+				t.Errorf("got rule name %q, expected %q", gotRuleName, testCase.ExpectedRuleName)
+			}
+
+			if gotTargetID != testCase.ExpectedTargetID {
+				t.Errorf("got target ID %q, expected %q", gotTargetID, testCase.ExpectedTargetID)
+			}
+		})
+	}
+}
+
+func TestTargetCreateID(t *testing.T) {
+	testCases := []struct {
+		TestName     string
+		EventBusName string
+		RuleName     string
+		TargetID     string
+		ExpectedID   string
+	}{
+		{
+			TestName:   "no event bus name",
+			RuleName:   "TestRule",
+			TargetID:   "TestTarget",
+			ExpectedID: "TestRule-TestTarget",
+		},
+		{
+			TestName:     "default event bus name",
+			EventBusName: tfevents.DefaultEventBusName,
+			RuleName:     "TestRule",
+			TargetID:     "TestTarget",
+			ExpectedID:   "TestRule-TestTarget",
+		},
+		{
+			TestName:     "custom event bus name",
+			EventBusName: "TestEventBus",
+			RuleName:     "TestRule",
+			TargetID:     "TestTarget",
+			ExpectedID:   "TestEventBus-TestRule-TestTarget",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := tfevents.TargetCreateID(testCase.EventBusName, testCase.RuleName, testCase.TargetID); got != testCase.ExpectedID {
+				t.Errorf("got ID %q, expected %q", got, testCase.ExpectedID)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+)
+
+// QueryDefinitionByID returns the Logs Insights query definition corresponding to the specified ID.
+// The optional name prefix narrows the search.
+// Returns nil if no query definition is found.
+func QueryDefinitionByID(conn *cloudwatchlogs.CloudWatchLogs, namePrefix, queryDefinitionID string) (*cloudwatchlogs.QueryDefinition, error) {
+	input := &cloudwatchlogs.DescribeQueryDefinitionsInput{}
+
+	if namePrefix != "" {
+		input.QueryDefinitionNamePrefix = aws.String(namePrefix)
+	}
+
+	// DescribeQueryDefinitions has no paginator in the SDK.
+	for {
+		output, err := conn.DescribeQueryDefinitions(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return nil, nil
+		}
+
+		for _, queryDefinition := range output.QueryDefinitions {
+			if queryDefinition == nil {
+				continue
+			}
+
+			if aws.StringValue(queryDefinition.QueryDefinitionId) == queryDefinitionID {
+				return queryDefinition, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
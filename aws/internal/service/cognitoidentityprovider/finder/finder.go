@@ -0,0 +1,86 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+)
+
+// UICustomizationByUserPoolIDAndClientID returns the UI customization corresponding to the specified user pool and client IDs.
+// Returns nil if no UI customization is found.
+func UICustomizationByUserPoolIDAndClientID(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID, clientID string) (*cognitoidentityprovider.UICustomizationType, error) {
+	input := &cognitoidentityprovider.GetUICustomizationInput{
+		ClientId:   aws.String(clientID),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	output, err := conn.GetUICustomization(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.UICustomization == nil {
+		return nil, nil
+	}
+
+	// The API falls back to the user pool level customization if the
+	// client does not have its own.
+	if aws.StringValue(output.UICustomization.ClientId) != clientID {
+		return nil, nil
+	}
+
+	// The API returns an empty customization if none has been set.
+	if output.UICustomization.CSS == nil && output.UICustomization.ImageUrl == nil {
+		return nil, nil
+	}
+
+	return output.UICustomization, nil
+}
+
+// UserByUserPoolIDAndUsername returns the user corresponding to the specified user pool ID and username.
+func UserByUserPoolIDAndUsername(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID, username string) (*cognitoidentityprovider.AdminGetUserOutput, error) {
+	input := &cognitoidentityprovider.AdminGetUserInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String(username),
+	}
+
+	output, err := conn.AdminGetUser(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// GroupNamesByUserPoolIDAndUsername returns the names of the groups the specified user belongs to.
+func GroupNamesByUserPoolIDAndUsername(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID, username string) ([]string, error) {
+	input := &cognitoidentityprovider.AdminListGroupsForUserInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String(username),
+	}
+
+	var groupNames []string
+
+	err := conn.AdminListGroupsForUserPages(input, func(page *cognitoidentityprovider.AdminListGroupsForUserOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, group := range page.Groups {
+			if group == nil {
+				continue
+			}
+
+			groupNames = append(groupNames, aws.StringValue(group.GroupName))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return groupNames, nil
+}
@@ -0,0 +1,42 @@
+package cognitoidentityprovider
+
+import (
+	"fmt"
+	"strings"
+)
+
+const uiCustomizationIDSeparator = ","
+
+func UICustomizationCreateID(userPoolID, clientID string) string {
+	parts := []string{userPoolID, clientID}
+	id := strings.Join(parts, uiCustomizationIDSeparator)
+	return id
+}
+
+func UICustomizationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, uiCustomizationIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected user-pool-id"+uiCustomizationIDSeparator+"client-id", id)
+}
+
+const userIDSeparator = "/"
+
+func UserCreateID(userPoolID, username string) string {
+	parts := []string{userPoolID, username}
+	id := strings.Join(parts, userIDSeparator)
+	return id
+}
+
+func UserParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, userIDSeparator, 2)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected user-pool-id"+userIDSeparator+"username", id)
+}
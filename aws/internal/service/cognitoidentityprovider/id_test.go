@@ -0,0 +1,120 @@
+package cognitoidentityprovider_test
+
+import (
+	"testing"
+
+	tfcognitoidentityprovider "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider"
+)
+
+func TestUICustomizationParseID(t *testing.T) {
+	testCases := []struct {
+		TestName           string
+		InputID            string
+		ExpectError        bool
+		ExpectedUserPoolID string
+		ExpectedClientID   string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "us-west-2_abc123",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing client ID",
+			InputID:     "us-west-2_abc123,",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "us-west-2_abc123,ALL,extra",
+			ExpectError: true,
+		},
+		{
+			TestName:           "valid ID",
+			InputID:            tfcognitoidentityprovider.UICustomizationCreateID("us-west-2_abc123", "ALL"),
+			ExpectedUserPoolID: "us-west-2_abc123",
+			ExpectedClientID:   "ALL",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotUserPoolID, gotClientID, err := tfcognitoidentityprovider.UICustomizationParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotUserPoolID != testCase.ExpectedUserPoolID {
+				t.Errorf("got user pool ID %s, expected %s", gotUserPoolID, testCase.ExpectedUserPoolID)
+			}
+
+			if gotClientID != testCase.ExpectedClientID {
+				t.Errorf("got client ID %s, expected %s", gotClientID, testCase.ExpectedClientID)
+			}
+		})
+	}
+}
+
+func TestUserParseID(t *testing.T) {
+	testCases := []struct {
+		TestName           string
+		InputID            string
+		ExpectError        bool
+		ExpectedUserPoolID string
+		ExpectedUsername   string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "us-west-2_abc123",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing username",
+			InputID:     "us-west-2_abc123/",
+			ExpectError: true,
+		},
+		{
+			TestName:           "valid ID",
+			InputID:            tfcognitoidentityprovider.UserCreateID("us-west-2_abc123", "test"),
+			ExpectedUserPoolID: "us-west-2_abc123",
+			ExpectedUsername:   "test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotUserPoolID, gotUsername, err := tfcognitoidentityprovider.UserParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotUserPoolID != testCase.ExpectedUserPoolID {
+				t.Errorf("got user pool ID %s, expected %s", gotUserPoolID, testCase.ExpectedUserPoolID)
+			}
+
+			if gotUsername != testCase.ExpectedUsername {
+				t.Errorf("got username %s, expected %s", gotUsername, testCase.ExpectedUsername)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+)
+
+// GlobalReplicationGroupByID returns the ElastiCache global replication group corresponding to the specified ID,
+// including information about its members.
+// Returns nil if no global replication group is found.
+func GlobalReplicationGroupByID(conn *elasticache.ElastiCache, id string) (*elasticache.GlobalReplicationGroup, error) {
+	input := &elasticache.DescribeGlobalReplicationGroupsInput{
+		GlobalReplicationGroupId: aws.String(id),
+		ShowMemberInfo:           aws.Bool(true),
+	}
+
+	output, err := conn.DescribeGlobalReplicationGroups(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, globalReplicationGroup := range output.GlobalReplicationGroups {
+		if globalReplicationGroup == nil {
+			continue
+		}
+
+		if aws.StringValue(globalReplicationGroup.GlobalReplicationGroupId) == id {
+			return globalReplicationGroup, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// GlobalReplicationGroupMemberByID returns the member of the specified ElastiCache global replication group
+// corresponding to the specified replication group ID.
+// Returns nil if no global replication group or member is found.
+func GlobalReplicationGroupMemberByID(conn *elasticache.ElastiCache, globalReplicationGroupID, replicationGroupID string) (*elasticache.GlobalReplicationGroupMember, error) {
+	globalReplicationGroup, err := GlobalReplicationGroupByID(conn, globalReplicationGroupID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if globalReplicationGroup == nil {
+		return nil, nil
+	}
+
+	for _, member := range globalReplicationGroup.Members {
+		if member == nil {
+			continue
+		}
+
+		if aws.StringValue(member.ReplicationGroupId) == replicationGroupID {
+			return member, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,82 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+)
+
+const (
+	// GlobalReplicationGroup NotFound
+	GlobalReplicationGroupStatusNotFound = "NotFound"
+
+	// GlobalReplicationGroup Unknown
+	GlobalReplicationGroupStatusUnknown = "Unknown"
+
+	// GlobalReplicationGroupMember NotFound
+	GlobalReplicationGroupMemberStatusNotFound = "NotFound"
+
+	// GlobalReplicationGroupMember Unknown
+	GlobalReplicationGroupMemberStatusUnknown = "Unknown"
+)
+
+// GlobalReplicationGroup statuses, which are not part of an enum in the API model
+const (
+	GlobalReplicationGroupStatusAvailable   = "available"
+	GlobalReplicationGroupStatusCreating    = "creating"
+	GlobalReplicationGroupStatusDeleting    = "deleting"
+	GlobalReplicationGroupStatusDeleted     = "deleted"
+	GlobalReplicationGroupStatusModifying   = "modifying"
+	GlobalReplicationGroupStatusPrimaryOnly = "primary-only"
+)
+
+// GlobalReplicationGroupMember statuses, which are not part of an enum in the API model
+const (
+	GlobalReplicationGroupMemberStatusAssociated     = "associated"
+	GlobalReplicationGroupMemberStatusAssociating    = "associating"
+	GlobalReplicationGroupMemberStatusDisassociating = "disassociating"
+)
+
+// GlobalReplicationGroupStatus fetches the global replication group and its Status
+func GlobalReplicationGroupStatus(conn *elasticache.ElastiCache, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.GlobalReplicationGroupByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+			return nil, GlobalReplicationGroupStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, GlobalReplicationGroupStatusUnknown, err
+		}
+
+		if output == nil || aws.StringValue(output.Status) == GlobalReplicationGroupStatusDeleted {
+			return nil, GlobalReplicationGroupStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// GlobalReplicationGroupMemberStatus fetches the global replication group member and its Status
+func GlobalReplicationGroupMemberStatus(conn *elasticache.ElastiCache, globalReplicationGroupID, replicationGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.GlobalReplicationGroupMemberByID(conn, globalReplicationGroupID, replicationGroupID)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+			return nil, GlobalReplicationGroupMemberStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, GlobalReplicationGroupMemberStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, GlobalReplicationGroupMemberStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
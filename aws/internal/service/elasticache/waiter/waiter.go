@@ -0,0 +1,113 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a global replication group to become available
+	GlobalReplicationGroupAvailableTimeout = 60 * time.Minute
+
+	// Maximum amount of time to wait for a global replication group to be deleted
+	GlobalReplicationGroupDeletedTimeout = 20 * time.Minute
+
+	// Maximum amount of time to wait for a global replication group member to be associated
+	GlobalReplicationGroupMemberAssociatedTimeout = 30 * time.Minute
+
+	// Maximum amount of time to wait for a global replication group member to be detached
+	GlobalReplicationGroupMemberDetachedTimeout = 20 * time.Minute
+
+	globalReplicationGroupMinTimeout = 10 * time.Second
+	globalReplicationGroupDelay      = 30 * time.Second
+)
+
+// GlobalReplicationGroupAvailable waits for a global replication group to return Available
+func GlobalReplicationGroupAvailable(conn *elasticache.ElastiCache, id string, timeout time.Duration) (*elasticache.GlobalReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{GlobalReplicationGroupStatusCreating, GlobalReplicationGroupStatusModifying},
+		Target:     []string{GlobalReplicationGroupStatusAvailable, GlobalReplicationGroupStatusPrimaryOnly},
+		Refresh:    GlobalReplicationGroupStatus(conn, id),
+		Timeout:    timeout,
+		MinTimeout: globalReplicationGroupMinTimeout,
+		Delay:      globalReplicationGroupDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// GlobalReplicationGroupDeleted waits for a global replication group to be deleted
+func GlobalReplicationGroupDeleted(conn *elasticache.ElastiCache, id string) (*elasticache.GlobalReplicationGroup, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			GlobalReplicationGroupStatusAvailable,
+			GlobalReplicationGroupStatusDeleting,
+			GlobalReplicationGroupStatusModifying,
+			GlobalReplicationGroupStatusPrimaryOnly,
+		},
+		Target:     []string{},
+		Refresh:    GlobalReplicationGroupStatus(conn, id),
+		Timeout:    GlobalReplicationGroupDeletedTimeout,
+		MinTimeout: globalReplicationGroupMinTimeout,
+		Delay:      globalReplicationGroupDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroup); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// GlobalReplicationGroupMemberAssociated waits for a replication group to finish joining a global replication group
+func GlobalReplicationGroupMemberAssociated(conn *elasticache.ElastiCache, globalReplicationGroupID, replicationGroupID string) (*elasticache.GlobalReplicationGroupMember, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{GlobalReplicationGroupMemberStatusAssociating},
+		Target:     []string{GlobalReplicationGroupMemberStatusAssociated},
+		Refresh:    GlobalReplicationGroupMemberStatus(conn, globalReplicationGroupID, replicationGroupID),
+		Timeout:    GlobalReplicationGroupMemberAssociatedTimeout,
+		MinTimeout: globalReplicationGroupMinTimeout,
+		Delay:      globalReplicationGroupDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroupMember); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// GlobalReplicationGroupMemberDetached waits for a replication group to leave a global replication group
+func GlobalReplicationGroupMemberDetached(conn *elasticache.ElastiCache, globalReplicationGroupID, replicationGroupID string) (*elasticache.GlobalReplicationGroupMember, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			GlobalReplicationGroupMemberStatusAssociated,
+			GlobalReplicationGroupMemberStatusDisassociating,
+		},
+		Target:     []string{},
+		Refresh:    GlobalReplicationGroupMemberStatus(conn, globalReplicationGroupID, replicationGroupID),
+		Timeout:    GlobalReplicationGroupMemberDetachedTimeout,
+		MinTimeout: globalReplicationGroupMinTimeout,
+		Delay:      globalReplicationGroupDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*elasticache.GlobalReplicationGroupMember); ok {
+		return v, err
+	}
+
+	return nil, err
+}
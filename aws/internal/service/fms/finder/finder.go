@@ -0,0 +1,26 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+)
+
+// PolicyByID returns the Firewall Manager policy corresponding to the specified ID.
+// Returns nil if no policy is found.
+func PolicyByID(conn *fms.FMS, policyID string) (*fms.GetPolicyOutput, error) {
+	input := &fms.GetPolicyInput{
+		PolicyId: aws.String(policyID),
+	}
+
+	output, err := conn.GetPolicy(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Policy == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
@@ -0,0 +1,58 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+)
+
+// DevEndpointByName returns the development endpoint corresponding to the specified name.
+// Returns nil if no development endpoint is found.
+func DevEndpointByName(conn *glue.Glue, name string) (*glue.DevEndpoint, error) {
+	input := &glue.GetDevEndpointInput{
+		EndpointName: aws.String(name),
+	}
+
+	output, err := conn.GetDevEndpoint(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.DevEndpoint, nil
+}
+
+// MLTransformByID returns the machine learning transform corresponding to the specified ID.
+func MLTransformByID(conn *glue.Glue, id string) (*glue.GetMLTransformOutput, error) {
+	input := &glue.GetMLTransformInput{
+		TransformId: aws.String(id),
+	}
+
+	return conn.GetMLTransform(input)
+}
+
+// PartitionByValues returns the partition corresponding to the specified partition values.
+// Returns nil if no partition is found.
+func PartitionByValues(conn *glue.Glue, catalogID, dbName, tableName string, values []string) (*glue.Partition, error) {
+	input := &glue.GetPartitionInput{
+		CatalogId:       aws.String(catalogID),
+		DatabaseName:    aws.String(dbName),
+		TableName:       aws.String(tableName),
+		PartitionValues: aws.StringSlice(values),
+	}
+
+	output, err := conn.GetPartition(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Partition, nil
+}
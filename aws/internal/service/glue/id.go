@@ -0,0 +1,25 @@
+package glue
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	partitionIDSeparator     = ":"
+	partitionValuesSeparator = "#"
+)
+
+func PartitionCreateID(catalogID, dbName, tableName string, values []string) string {
+	return strings.Join([]string{catalogID, dbName, tableName, strings.Join(values, partitionValuesSeparator)}, partitionIDSeparator)
+}
+
+func PartitionParseID(id string) (string, string, string, []string, error) {
+	parts := strings.SplitN(id, partitionIDSeparator, 4)
+
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", nil, fmt.Errorf("unexpected format for ID (%q), expected <catalog-id>"+partitionIDSeparator+"<database-name>"+partitionIDSeparator+"<table-name>"+partitionIDSeparator+"<value1>"+partitionValuesSeparator+"<value2>...", id)
+	}
+
+	return parts[0], parts[1], parts[2], strings.Split(parts[3], partitionValuesSeparator), nil
+}
@@ -0,0 +1,103 @@
+package glue_test
+
+import (
+	"reflect"
+	"testing"
+
+	tfglue "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue"
+)
+
+func TestPartitionParseID(t *testing.T) {
+	testCases := []struct {
+		TestName          string
+		InputID           string
+		ExpectedError     bool
+		ExpectedCatalogID string
+		ExpectedDBName    string
+		ExpectedTableName string
+		ExpectedValues    []string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing values",
+			InputID:       "123456789012:TestDB:TestTable",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty values",
+			InputID:       "123456789012:TestDB:TestTable:",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty database name",
+			InputID:       "123456789012::TestTable:a",
+			ExpectedError: true,
+		},
+		{
+			TestName:          "single value",
+			InputID:           "123456789012:TestDB:TestTable:a",
+			ExpectedCatalogID: "123456789012",
+			ExpectedDBName:    "TestDB",
+			ExpectedTableName: "TestTable",
+			ExpectedValues:    []string{"a"},
+		},
+		{
+			TestName:          "multiple values",
+			InputID:           "123456789012:TestDB:TestTable:a#b#c",
+			ExpectedCatalogID: "123456789012",
+			ExpectedDBName:    "TestDB",
+			ExpectedTableName: "TestTable",
+			ExpectedValues:    []string{"a", "b", "c"},
+		},
+		{
+			TestName:          "value containing separator",
+			InputID:           "123456789012:TestDB:TestTable:2020-01-01 00:00:00",
+			ExpectedCatalogID: "123456789012",
+			ExpectedDBName:    "TestDB",
+			ExpectedTableName: "TestTable",
+			ExpectedValues:    []string{"2020-01-01 00:00:00"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotCatalogID, gotDBName, gotTableName, gotValues, err := tfglue.PartitionParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotCatalogID != testCase.ExpectedCatalogID {
+				t.Errorf("got catalog ID %s, expected %s", gotCatalogID, testCase.ExpectedCatalogID)
+			}
+
+			if gotDBName != testCase.ExpectedDBName {
+				t.Errorf("got database name %s, expected %s", gotDBName, testCase.ExpectedDBName)
+			}
+
+			if gotTableName != testCase.ExpectedTableName {
+				t.Errorf("got table name %s, expected %s", gotTableName, testCase.ExpectedTableName)
+			}
+
+			if !reflect.DeepEqual(gotValues, testCase.ExpectedValues) {
+				t.Errorf("got values %v, expected %v", gotValues, testCase.ExpectedValues)
+			}
+		})
+	}
+}
+
+func TestPartitionCreateID(t *testing.T) {
+	id := tfglue.PartitionCreateID("123456789012", "TestDB", "TestTable", []string{"a", "b"})
+
+	if expected := "123456789012:TestDB:TestTable:a#b"; id != expected {
+		t.Fatalf("got ID %s, expected %s", id, expected)
+	}
+}
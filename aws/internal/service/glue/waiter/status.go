@@ -0,0 +1,71 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+)
+
+const (
+	// DevEndpointStatus values are not modeled as enums in the Glue API
+	DevEndpointStatusFailed       = "FAILED"
+	DevEndpointStatusProvisioning = "PROVISIONING"
+	DevEndpointStatusReady        = "READY"
+	DevEndpointStatusTerminating  = "TERMINATING"
+
+	// DevEndpointStatus NotFound
+	DevEndpointStatusNotFound = "NotFound"
+
+	// DevEndpointStatus Unknown
+	DevEndpointStatusUnknown = "Unknown"
+
+	// MLTransformStatus NotFound
+	MLTransformStatusNotFound = "NotFound"
+
+	// MLTransformStatus Unknown
+	MLTransformStatusUnknown = "Unknown"
+)
+
+// DevEndpointStatus fetches the DevEndpoint and its Status
+func DevEndpointStatus(conn *glue.Glue, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		devEndpoint, err := finder.DevEndpointByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+			return nil, DevEndpointStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, DevEndpointStatusUnknown, err
+		}
+
+		if devEndpoint == nil {
+			return nil, DevEndpointStatusNotFound, nil
+		}
+
+		return devEndpoint, aws.StringValue(devEndpoint.Status), nil
+	}
+}
+
+// MLTransformStatus fetches the MLTransform and its Status
+func MLTransformStatus(conn *glue.Glue, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.MLTransformByID(conn, id)
+
+		if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+			return nil, MLTransformStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, MLTransformStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, MLTransformStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,84 @@
+package waiter
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a DevEndpoint to return READY
+	DevEndpointCreatedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for a DevEndpoint to be deleted
+	DevEndpointDeletedTimeout = 15 * time.Minute
+
+	// Maximum amount of time to wait for an MLTransform to be deleted
+	MLTransformDeletedTimeout = 2 * time.Minute
+)
+
+// DevEndpointCreated waits for a DevEndpoint to return READY
+func DevEndpointCreated(conn *glue.Glue, name string) (*glue.DevEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{DevEndpointStatusProvisioning},
+		Target:  []string{DevEndpointStatusReady},
+		Refresh: DevEndpointStatus(conn, name),
+		Timeout: DevEndpointCreatedTimeout,
+		Delay:   15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*glue.DevEndpoint); ok {
+		if aws.StringValue(v.Status) == DevEndpointStatusFailed && v.FailureReason != nil {
+			err = errors.New(aws.StringValue(v.FailureReason))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// DevEndpointDeleted waits for a DevEndpoint to be deleted
+func DevEndpointDeleted(conn *glue.Glue, name string) (*glue.DevEndpoint, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{DevEndpointStatusTerminating},
+		Target:  []string{},
+		Refresh: DevEndpointStatus(conn, name),
+		Timeout: DevEndpointDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*glue.DevEndpoint); ok {
+		if aws.StringValue(v.Status) == DevEndpointStatusFailed && v.FailureReason != nil {
+			err = errors.New(aws.StringValue(v.FailureReason))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// MLTransformDeleted waits for an MLTransform to be deleted
+func MLTransformDeleted(conn *glue.Glue, id string) (*glue.GetMLTransformOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{glue.TransformStatusTypeDeleting},
+		Target:  []string{},
+		Refresh: MLTransformStatus(conn, id),
+		Timeout: MLTransformDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*glue.GetMLTransformOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,112 @@
+package equivalency
+
+import (
+	"bytes"
+	"encoding/json"
+	"log"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+)
+
+type detectorModelDefinition iotevents.DetectorModelDefinition
+
+func (dmd *detectorModelDefinition) Reduce() error {
+	// Remove null State objects so they can be safely sorted
+	states := make([]*iotevents.State, 0, len(dmd.States))
+
+	for _, state := range dmd.States {
+		if state != nil {
+			states = append(states, state)
+		}
+	}
+
+	dmd.States = states
+
+	// Deal with State objects which may be re-ordered in the API
+	sort.Slice(dmd.States, func(i, j int) bool {
+		return aws.StringValue(dmd.States[i].StateName) < aws.StringValue(dmd.States[j].StateName)
+	})
+
+	for _, state := range dmd.States {
+		// Prevent difference of API response that adds empty lifecycles when not configured during the request
+		if state.OnEnter != nil && len(state.OnEnter.Events) == 0 {
+			state.OnEnter = nil
+		}
+
+		// Prevent difference of API response that adds empty lifecycles when not configured during the request
+		if state.OnExit != nil && len(state.OnExit.Events) == 0 {
+			state.OnExit = nil
+		}
+
+		if state.OnInput != nil {
+			// Prevent difference of API response that adds an empty array when not configured during the request
+			if len(state.OnInput.Events) == 0 {
+				state.OnInput.Events = nil
+			}
+
+			// Prevent difference of API response that adds an empty array when not configured during the request
+			if len(state.OnInput.TransitionEvents) == 0 {
+				state.OnInput.TransitionEvents = nil
+			}
+
+			// Prevent difference of API response that adds empty lifecycles when not configured during the request
+			if state.OnInput.Events == nil && state.OnInput.TransitionEvents == nil {
+				state.OnInput = nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// EquivalentIotEventsDetectorModelDefinitionJSON determines equality between two IoT Events DetectorModelDefinition JSON strings
+func EquivalentIotEventsDetectorModelDefinitionJSON(str1, str2 string) (bool, error) {
+	if str1 == "" {
+		str1 = "{}"
+	}
+
+	if str2 == "" {
+		str2 = "{}"
+	}
+
+	var dmd1, dmd2 detectorModelDefinition
+
+	if err := json.Unmarshal([]byte(str1), &dmd1); err != nil {
+		return false, err
+	}
+
+	if err := dmd1.Reduce(); err != nil {
+		return false, err
+	}
+
+	canonicalJson1, err := jsonutil.BuildJSON(dmd1)
+
+	if err != nil {
+		return false, err
+	}
+
+	if err := json.Unmarshal([]byte(str2), &dmd2); err != nil {
+		return false, err
+	}
+
+	if err := dmd2.Reduce(); err != nil {
+		return false, err
+	}
+
+	canonicalJson2, err := jsonutil.BuildJSON(dmd2)
+
+	if err != nil {
+		return false, err
+	}
+
+	equal := bytes.Equal(canonicalJson1, canonicalJson2)
+
+	if !equal {
+		log.Printf("[DEBUG] Canonical IoT Events Detector Model Definition JSON are not equal.\nFirst: %s\nSecond: %s\n", canonicalJson1, canonicalJson2)
+	}
+
+	return equal, nil
+}
@@ -0,0 +1,184 @@
+package equivalency
+
+import (
+	"testing"
+)
+
+func TestEquivalentIotEventsDetectorModelDefinitionJSON(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		ApiJson           string
+		ConfigurationJson string
+		ExpectEquivalent  bool
+		ExpectError       bool
+	}{
+		{
+			Name:              "empty",
+			ApiJson:           ``,
+			ConfigurationJson: ``,
+			ExpectEquivalent:  true,
+		},
+		{
+			Name: "empty lifecycles",
+			ApiJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		{
+			"onEnter": {
+				"events": []
+			},
+			"onExit": {
+				"events": []
+			},
+			"onInput": {
+				"events": [],
+				"transitionEvents": [
+					{
+						"actions": [],
+						"condition": "$input.Example.temperature > 70",
+						"eventName": "Overheated",
+						"nextState": "Alarm"
+					}
+				]
+			},
+			"stateName": "Normal"
+		}
+	]
+}
+`,
+			ConfigurationJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		{
+			"stateName": "Normal",
+			"onInput": {
+				"transitionEvents": [
+					{
+						"eventName": "Overheated",
+						"condition": "$input.Example.temperature > 70",
+						"nextState": "Alarm",
+						"actions": []
+					}
+				]
+			}
+		}
+	]
+}
+`,
+			ExpectEquivalent: true,
+		},
+		{
+			Name: "reordered states",
+			ApiJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		{
+			"stateName": "Normal"
+		},
+		{
+			"stateName": "Alarm"
+		}
+	]
+}
+`,
+			ConfigurationJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		{
+			"stateName": "Alarm"
+		},
+		{
+			"stateName": "Normal"
+		}
+	]
+}
+`,
+			ExpectEquivalent: true,
+		},
+		{
+			Name: "different initial state",
+			ApiJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		{
+			"stateName": "Normal"
+		},
+		{
+			"stateName": "Alarm"
+		}
+	]
+}
+`,
+			ConfigurationJson: `
+{
+	"initialStateName": "Alarm",
+	"states": [
+		{
+			"stateName": "Normal"
+		},
+		{
+			"stateName": "Alarm"
+		}
+	]
+}
+`,
+			ExpectEquivalent: false,
+		},
+		{
+			Name: "null state",
+			ApiJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		{
+			"stateName": "Normal"
+		}
+	]
+}
+`,
+			ConfigurationJson: `
+{
+	"initialStateName": "Normal",
+	"states": [
+		null,
+		{
+			"stateName": "Normal"
+		},
+		null
+	]
+}
+`,
+			ExpectEquivalent: true,
+		},
+		{
+			Name:              "invalid JSON",
+			ApiJson:           `{}`,
+			ConfigurationJson: `{`,
+			ExpectEquivalent:  false,
+			ExpectError:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := EquivalentIotEventsDetectorModelDefinitionJSON(testCase.ConfigurationJson, testCase.ApiJson)
+
+			if err != nil && !testCase.ExpectError {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.ExpectError {
+				t.Errorf("expected error, but received none")
+			}
+
+			if got != testCase.ExpectEquivalent {
+				t.Errorf("got %t, expected %t", got, testCase.ExpectEquivalent)
+			}
+		})
+	}
+}
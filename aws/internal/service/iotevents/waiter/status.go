@@ -0,0 +1,65 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+)
+
+const (
+	// DetectorModelStatus NotFound
+	DetectorModelStatusNotFound = "NotFound"
+
+	// DetectorModelStatus Unknown
+	DetectorModelStatusUnknown = "Unknown"
+
+	// InputStatus NotFound
+	InputStatusNotFound = "NotFound"
+
+	// InputStatus Unknown
+	InputStatusUnknown = "Unknown"
+)
+
+// DetectorModelStatus fetches the DetectorModel and its Status
+func DetectorModelStatus(conn *iotevents.IoTEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		detectorModel, err := finder.DetectorModelByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+			return nil, DetectorModelStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, DetectorModelStatusUnknown, err
+		}
+
+		if detectorModel == nil || detectorModel.DetectorModelConfiguration == nil {
+			return nil, DetectorModelStatusNotFound, nil
+		}
+
+		return detectorModel, aws.StringValue(detectorModel.DetectorModelConfiguration.Status), nil
+	}
+}
+
+// InputStatus fetches the Input and its Status
+func InputStatus(conn *iotevents.IoTEvents, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input, err := finder.InputByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+			return nil, InputStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, InputStatusUnknown, err
+		}
+
+		if input == nil || input.InputConfiguration == nil {
+			return nil, InputStatusNotFound, nil
+		}
+
+		return input, aws.StringValue(input.InputConfiguration.Status), nil
+	}
+}
@@ -0,0 +1,94 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a DetectorModel to become active
+	DetectorModelActiveTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a DetectorModel to be deleted
+	DetectorModelDeletedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for an Input to become active
+	InputActiveTimeout = 2 * time.Minute
+
+	// Maximum amount of time to wait for an Input to be deleted
+	InputDeletedTimeout = 2 * time.Minute
+)
+
+// DetectorModelActive waits for a DetectorModel to return Active
+func DetectorModelActive(conn *iotevents.IoTEvents, name string) (*iotevents.DetectorModel, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotevents.DetectorModelVersionStatusActivating},
+		Target:  []string{iotevents.DetectorModelVersionStatusActive},
+		Refresh: DetectorModelStatus(conn, name),
+		Timeout: DetectorModelActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// DetectorModelDeleted waits for a DetectorModel to be deleted
+func DetectorModelDeleted(conn *iotevents.IoTEvents, name string) (*iotevents.DetectorModel, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: iotevents.DetectorModelVersionStatus_Values(),
+		Target:  []string{},
+		Refresh: DetectorModelStatus(conn, name),
+		Timeout: DetectorModelDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*iotevents.DetectorModel); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// InputActive waits for an Input to return Active
+func InputActive(conn *iotevents.IoTEvents, name string) (*iotevents.Input, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotevents.InputStatusCreating, iotevents.InputStatusUpdating},
+		Target:  []string{iotevents.InputStatusActive},
+		Refresh: InputStatus(conn, name),
+		Timeout: InputActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*iotevents.Input); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// InputDeleted waits for an Input to be deleted
+func InputDeleted(conn *iotevents.IoTEvents, name string) (*iotevents.Input, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iotevents.InputStatusDeleting},
+		Target:  []string{},
+		Refresh: InputStatus(conn, name),
+		Timeout: InputDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*iotevents.Input); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,45 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+)
+
+// ApplicationDetailByName returns the application corresponding to the specified name.
+func ApplicationDetailByName(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string) (*kinesisanalyticsv2.ApplicationDetail, error) {
+	input := &kinesisanalyticsv2.DescribeApplicationInput{
+		ApplicationName: aws.String(name),
+	}
+
+	output, err := conn.DescribeApplication(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.ApplicationDetail, nil
+}
+
+// SnapshotDetailsByApplicationAndSnapshotNames returns the application snapshot corresponding to the specified application and snapshot names.
+func SnapshotDetailsByApplicationAndSnapshotNames(conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicationName, snapshotName string) (*kinesisanalyticsv2.SnapshotDetails, error) {
+	input := &kinesisanalyticsv2.DescribeApplicationSnapshotInput{
+		ApplicationName: aws.String(applicationName),
+		SnapshotName:    aws.String(snapshotName),
+	}
+
+	output, err := conn.DescribeApplicationSnapshot(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.SnapshotDetails, nil
+}
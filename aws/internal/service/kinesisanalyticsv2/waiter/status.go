@@ -0,0 +1,65 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/finder"
+)
+
+const (
+	// ApplicationStatus NotFound
+	ApplicationStatusNotFound = "NotFound"
+
+	// ApplicationStatus Unknown
+	ApplicationStatusUnknown = "Unknown"
+
+	// SnapshotStatus NotFound
+	SnapshotStatusNotFound = "NotFound"
+
+	// SnapshotStatus Unknown
+	SnapshotStatusUnknown = "Unknown"
+)
+
+// ApplicationStatus fetches the ApplicationDetail and its Status
+func ApplicationStatus(conn *kinesisanalyticsv2.KinesisAnalyticsV2, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		applicationDetail, err := finder.ApplicationDetailByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException) {
+			return nil, ApplicationStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, ApplicationStatusUnknown, err
+		}
+
+		if applicationDetail == nil {
+			return nil, ApplicationStatusNotFound, nil
+		}
+
+		return applicationDetail, aws.StringValue(applicationDetail.ApplicationStatus), nil
+	}
+}
+
+// SnapshotStatus fetches the SnapshotDetails and its Status
+func SnapshotStatus(conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicationName, snapshotName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshotDetails, err := finder.SnapshotDetailsByApplicationAndSnapshotNames(conn, applicationName, snapshotName)
+
+		if tfawserr.ErrCodeEquals(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException) {
+			return nil, SnapshotStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, SnapshotStatusUnknown, err
+		}
+
+		if snapshotDetails == nil {
+			return nil, SnapshotStatusNotFound, nil
+		}
+
+		return snapshotDetails, aws.StringValue(snapshotDetails.SnapshotStatus), nil
+	}
+}
@@ -0,0 +1,38 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+// DelegatedAdministratorByAccountIDAndServicePrincipal returns the delegated administrator
+// corresponding to the specified account ID and service principal.
+// Returns nil if no delegated administrator is found.
+func DelegatedAdministratorByAccountIDAndServicePrincipal(conn *organizations.Organizations, accountID, servicePrincipal string) (*organizations.DelegatedAdministrator, error) {
+	input := &organizations.ListDelegatedAdministratorsInput{
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+
+	var result *organizations.DelegatedAdministrator
+
+	err := conn.ListDelegatedAdministratorsPages(input, func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, delegatedAdministrator := range page.DelegatedAdministrators {
+			if delegatedAdministrator == nil {
+				continue
+			}
+
+			if aws.StringValue(delegatedAdministrator.Id) == accountID {
+				result = delegatedAdministrator
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
@@ -0,0 +1,24 @@
+package organizations
+
+import (
+	"fmt"
+	"strings"
+)
+
+const delegatedAdministratorIDSeparator = "/"
+
+func DelegatedAdministratorCreateID(accountID, servicePrincipal string) string {
+	parts := []string{accountID, servicePrincipal}
+	id := strings.Join(parts, delegatedAdministratorIDSeparator)
+	return id
+}
+
+func DelegatedAdministratorParseID(id string) (string, string, error) {
+	parts := strings.Split(id, delegatedAdministratorIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected account-id"+delegatedAdministratorIDSeparator+"service-principal", id)
+}
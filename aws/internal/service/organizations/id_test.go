@@ -0,0 +1,71 @@
+package organizations_test
+
+import (
+	"testing"
+
+	tforganizations "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/organizations"
+)
+
+func TestDelegatedAdministratorParseID(t *testing.T) {
+	testCases := []struct {
+		TestName                 string
+		InputID                  string
+		ExpectError              bool
+		ExpectedAccountID        string
+		ExpectedServicePrincipal string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing account ID",
+			InputID:     "/config.amazonaws.com",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing service principal",
+			InputID:     "123456789012/",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "123456789012/config.amazonaws.com/extra",
+			ExpectError: true,
+		},
+		{
+			TestName:                 "valid ID",
+			InputID:                  tforganizations.DelegatedAdministratorCreateID("123456789012", "config.amazonaws.com"),
+			ExpectedAccountID:        "123456789012",
+			ExpectedServicePrincipal: "config.amazonaws.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotAccountID, gotServicePrincipal, err := tforganizations.DelegatedAdministratorParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotAccountID != testCase.ExpectedAccountID {
+				t.Errorf("got account ID %s, expected %s", gotAccountID, testCase.ExpectedAccountID)
+			}
+
+			if gotServicePrincipal != testCase.ExpectedServicePrincipal {
+				t.Errorf("got service principal %s, expected %s", gotServicePrincipal, testCase.ExpectedServicePrincipal)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+// DBClusterRoleByDBClusterIDAndRoleARN returns the DB cluster IAM role association corresponding to the specified DB cluster ID and role ARN.
+// Returns nil if no DB cluster or associated role is found.
+func DBClusterRoleByDBClusterIDAndRoleARN(conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
+	input := &rds.DescribeDBClustersInput{
+		DBClusterIdentifier: aws.String(dbClusterID),
+	}
+
+	output, err := conn.DescribeDBClusters(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, dbCluster := range output.DBClusters {
+		if aws.StringValue(dbCluster.DBClusterIdentifier) != dbClusterID {
+			continue
+		}
+
+		for _, associatedRole := range dbCluster.AssociatedRoles {
+			if aws.StringValue(associatedRole.RoleArn) == roleARN {
+				return associatedRole, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// DBProxyTargetGroupByDBProxyNameAndTargetGroupName returns the DB proxy target group corresponding to the specified DB proxy and target group names.
+// Returns nil if no target group is found.
+func DBProxyTargetGroupByDBProxyNameAndTargetGroupName(conn *rds.RDS, dbProxyName, targetGroupName string) (*rds.DBProxyTargetGroup, error) {
+	input := &rds.DescribeDBProxyTargetGroupsInput{
+		DBProxyName:     aws.String(dbProxyName),
+		TargetGroupName: aws.String(targetGroupName),
+	}
+
+	var targetGroup *rds.DBProxyTargetGroup
+
+	err := conn.DescribeDBProxyTargetGroupsPages(input, func(page *rds.DescribeDBProxyTargetGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, tg := range page.TargetGroups {
+			if aws.StringValue(tg.TargetGroupName) == targetGroupName {
+				targetGroup = tg
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return targetGroup, nil
+}
+
+// DBProxyTarget returns the DB proxy target corresponding to the specified DB proxy name, target group name, target type and RDS resource ID.
+// Returns nil if no target is found.
+func DBProxyTarget(conn *rds.RDS, dbProxyName, targetGroupName, targetType, rdsResourceID string) (*rds.DBProxyTarget, error) {
+	input := &rds.DescribeDBProxyTargetsInput{
+		DBProxyName:     aws.String(dbProxyName),
+		TargetGroupName: aws.String(targetGroupName),
+	}
+
+	var target *rds.DBProxyTarget
+
+	err := conn.DescribeDBProxyTargetsPages(input, func(page *rds.DescribeDBProxyTargetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, t := range page.Targets {
+			if aws.StringValue(t.Type) == targetType && aws.StringValue(t.RdsResourceId) == rdsResourceID {
+				target = t
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
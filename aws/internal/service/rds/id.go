@@ -0,0 +1,42 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+)
+
+const clusterRoleAssociationIDSeparator = ","
+
+func ClusterRoleAssociationCreateID(dbClusterID, roleARN string) string {
+	parts := []string{dbClusterID, roleARN}
+	id := strings.Join(parts, clusterRoleAssociationIDSeparator)
+	return id
+}
+
+func ClusterRoleAssociationParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, clusterRoleAssociationIDSeparator, 2)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected db-cluster-id"+clusterRoleAssociationIDSeparator+"role-arn", id)
+}
+
+const proxyTargetIDSeparator = "/"
+
+func ProxyTargetCreateID(dbProxyName, targetGroupName, targetType, rdsResourceID string) string {
+	parts := []string{dbProxyName, targetGroupName, targetType, rdsResourceID}
+	id := strings.Join(parts, proxyTargetIDSeparator)
+	return id
+}
+
+func ProxyTargetParseID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, proxyTargetIDSeparator)
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected db-proxy-name"+proxyTargetIDSeparator+"target-group-name"+proxyTargetIDSeparator+"target-type"+proxyTargetIDSeparator+"rds-resource-id", id)
+}
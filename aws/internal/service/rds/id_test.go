@@ -0,0 +1,137 @@
+package rds_test
+
+import (
+	"testing"
+
+	tfrds "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds"
+)
+
+func TestClusterRoleAssociationParseID(t *testing.T) {
+	testCases := []struct {
+		TestName            string
+		InputID             string
+		ExpectError         bool
+		ExpectedDBClusterID string
+		ExpectedRoleARN     string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing DB cluster ID",
+			InputID:     ",arn:aws:iam::123456789012:role/test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing role ARN",
+			InputID:     "test-cluster,",
+			ExpectError: true,
+		},
+		{
+			TestName:            "valid ID",
+			InputID:             tfrds.ClusterRoleAssociationCreateID("test-cluster", "arn:aws:iam::123456789012:role/test"),
+			ExpectedDBClusterID: "test-cluster",
+			ExpectedRoleARN:     "arn:aws:iam::123456789012:role/test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotDBClusterID, gotRoleARN, err := tfrds.ClusterRoleAssociationParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotDBClusterID != testCase.ExpectedDBClusterID {
+				t.Errorf("got DB cluster ID %s, expected %s", gotDBClusterID, testCase.ExpectedDBClusterID)
+			}
+
+			if gotRoleARN != testCase.ExpectedRoleARN {
+				t.Errorf("got role ARN %s, expected %s", gotRoleARN, testCase.ExpectedRoleARN)
+			}
+		})
+	}
+}
+
+func TestProxyTargetParseID(t *testing.T) {
+	testCases := []struct {
+		TestName                string
+		InputID                 string
+		ExpectError             bool
+		ExpectedDBProxyName     string
+		ExpectedTargetGroupName string
+		ExpectedTargetType      string
+		ExpectedRdsResourceID   string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing target type",
+			InputID:     "test-proxy/default//test-instance",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "test-proxy/default/RDS_INSTANCE/test-instance/extra",
+			ExpectError: true,
+		},
+		{
+			TestName:                "valid ID",
+			InputID:                 tfrds.ProxyTargetCreateID("test-proxy", "default", "RDS_INSTANCE", "test-instance"),
+			ExpectedDBProxyName:     "test-proxy",
+			ExpectedTargetGroupName: "default",
+			ExpectedTargetType:      "RDS_INSTANCE",
+			ExpectedRdsResourceID:   "test-instance",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotDBProxyName, gotTargetGroupName, gotTargetType, gotRdsResourceID, err := tfrds.ProxyTargetParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotDBProxyName != testCase.ExpectedDBProxyName {
+				t.Errorf("got DB proxy name %s, expected %s", gotDBProxyName, testCase.ExpectedDBProxyName)
+			}
+
+			if gotTargetGroupName != testCase.ExpectedTargetGroupName {
+				t.Errorf("got target group name %s, expected %s", gotTargetGroupName, testCase.ExpectedTargetGroupName)
+			}
+
+			if gotTargetType != testCase.ExpectedTargetType {
+				t.Errorf("got target type %s, expected %s", gotTargetType, testCase.ExpectedTargetType)
+			}
+
+			if gotRdsResourceID != testCase.ExpectedRdsResourceID {
+				t.Errorf("got RDS resource ID %s, expected %s", gotRdsResourceID, testCase.ExpectedRdsResourceID)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
 )
 
 const (
@@ -12,6 +13,16 @@ const (
 
 	// EventSubscription Unknown
 	EventSubscriptionStatusUnknown = "Unknown"
+
+	// DBClusterRole NotFound
+	DBClusterRoleStatusNotFound = "NotFound"
+
+	// DBClusterRole Unknown
+	DBClusterRoleStatusUnknown = "Unknown"
+
+	// Constants not currently provided by the AWS Go SDK
+	DBClusterRoleStatusActive  = "ACTIVE"
+	DBClusterRoleStatusPending = "PENDING"
 )
 
 // EventSubscriptionStatus fetches the EventSubscription and its Status
@@ -34,3 +45,20 @@ func EventSubscriptionStatus(conn *rds.RDS, subscriptionName string) resource.St
 		return output.EventSubscriptionsList[0], aws.StringValue(output.EventSubscriptionsList[0].Status), nil
 	}
 }
+
+// DBClusterRoleStatus fetches the DBClusterRole and its Status
+func DBClusterRoleStatus(conn *rds.RDS, dbClusterID, roleARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.DBClusterRoleByDBClusterIDAndRoleARN(conn, dbClusterID, roleARN)
+
+		if err != nil {
+			return nil, DBClusterRoleStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, DBClusterRoleStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
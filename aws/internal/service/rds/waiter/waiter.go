@@ -10,6 +10,12 @@ import (
 const (
 	// Maximum amount of time to wait for an EventSubscription to return Deleted
 	EventSubscriptionDeletedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a DBClusterRole to return Active
+	DBClusterRoleAssociationCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a DBClusterRole to return Deleted
+	DBClusterRoleAssociationDeletedTimeout = 5 * time.Minute
 )
 
 // DeploymentDeployed waits for a EventSubscription to return Deleted
@@ -29,3 +35,41 @@ func EventSubscriptionDeleted(conn *rds.RDS, subscriptionName string) (*rds.Even
 
 	return nil, err
 }
+
+// DBClusterRoleAssociationCreated waits for a DBClusterRole to return Active
+func DBClusterRoleAssociationCreated(conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{DBClusterRoleStatusPending},
+		Target:  []string{DBClusterRoleStatusActive},
+		Refresh: DBClusterRoleStatus(conn, dbClusterID, roleARN),
+		Timeout: DBClusterRoleAssociationCreatedTimeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*rds.DBClusterRole); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// DBClusterRoleAssociationDeleted waits for a DBClusterRole to return Deleted
+func DBClusterRoleAssociationDeleted(conn *rds.RDS, dbClusterID, roleARN string) (*rds.DBClusterRole, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{DBClusterRoleStatusActive, DBClusterRoleStatusPending},
+		Target:  []string{},
+		Refresh: DBClusterRoleStatus(conn, dbClusterID, roleARN),
+		Timeout: DBClusterRoleAssociationDeletedTimeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*rds.DBClusterRole); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,24 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+)
+
+// DomainDetailByName returns the domain details corresponding to the specified domain name.
+func DomainDetailByName(conn *route53domains.Route53Domains, domainName string) (*route53domains.GetDomainDetailOutput, error) {
+	input := &route53domains.GetDomainDetailInput{
+		DomainName: aws.String(domainName),
+	}
+
+	return conn.GetDomainDetail(input)
+}
+
+// OperationDetailByID returns the operation details corresponding to the specified operation ID.
+func OperationDetailByID(conn *route53domains.Route53Domains, id string) (*route53domains.GetOperationDetailOutput, error) {
+	input := &route53domains.GetOperationDetailInput{
+		OperationId: aws.String(id),
+	}
+
+	return conn.GetOperationDetail(input)
+}
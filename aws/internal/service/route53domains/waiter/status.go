@@ -0,0 +1,30 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53domains/finder"
+)
+
+const (
+	// OperationStatus Unknown
+	OperationStatusUnknown = "Unknown"
+)
+
+// OperationStatus fetches the Operation and its Status
+func OperationStatus(conn *route53domains.Route53Domains, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.OperationDetailByID(conn, id)
+
+		if err != nil {
+			return nil, OperationStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, OperationStatusUnknown, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,39 @@
+package waiter
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// OperationSucceeded waits for an Operation to return Successful
+func OperationSucceeded(conn *route53domains.Route53Domains, id string, timeout time.Duration) (*route53domains.GetOperationDetailOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			route53domains.OperationStatusSubmitted,
+			route53domains.OperationStatusInProgress,
+		},
+		Target:       []string{route53domains.OperationStatusSuccessful},
+		Refresh:      OperationStatus(conn, id),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*route53domains.GetOperationDetailOutput); ok {
+		if status := aws.StringValue(v.Status); err != nil && (status == route53domains.OperationStatusError || status == route53domains.OperationStatusFailed) {
+			if message := aws.StringValue(v.Message); message != "" {
+				err = errors.New(message)
+			}
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,65 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53resolver/finder"
+)
+
+const (
+	// QueryLogConfigAssociationStatus NotFound
+	QueryLogConfigAssociationStatusNotFound = "NotFound"
+
+	// QueryLogConfigAssociationStatus Unknown
+	QueryLogConfigAssociationStatusUnknown = "Unknown"
+
+	// QueryLogConfigStatus NotFound
+	QueryLogConfigStatusNotFound = "NotFound"
+
+	// QueryLogConfigStatus Unknown
+	QueryLogConfigStatusUnknown = "Unknown"
+)
+
+// QueryLogConfigAssociationStatus fetches the QueryLogConfigAssociation and its Status
+func QueryLogConfigAssociationStatus(conn *route53resolver.Route53Resolver, queryLogConfigAssociationID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		queryLogConfigAssociation, err := finder.QueryLogConfigAssociationByID(conn, queryLogConfigAssociationID)
+
+		if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
+			return nil, QueryLogConfigAssociationStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, QueryLogConfigAssociationStatusUnknown, err
+		}
+
+		if queryLogConfigAssociation == nil {
+			return nil, QueryLogConfigAssociationStatusNotFound, nil
+		}
+
+		return queryLogConfigAssociation, aws.StringValue(queryLogConfigAssociation.Status), nil
+	}
+}
+
+// QueryLogConfigStatus fetches the QueryLogConfig and its Status
+func QueryLogConfigStatus(conn *route53resolver.Route53Resolver, queryLogConfigID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		queryLogConfig, err := finder.QueryLogConfigByID(conn, queryLogConfigID)
+
+		if tfawserr.ErrCodeEquals(err, route53resolver.ErrCodeResourceNotFoundException) {
+			return nil, QueryLogConfigStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, QueryLogConfigStatusUnknown, err
+		}
+
+		if queryLogConfig == nil {
+			return nil, QueryLogConfigStatusNotFound, nil
+		}
+
+		return queryLogConfig, aws.StringValue(queryLogConfig.Status), nil
+	}
+}
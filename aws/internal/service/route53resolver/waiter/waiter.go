@@ -0,0 +1,100 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a QueryLogConfigAssociation to return ACTIVE
+	QueryLogConfigAssociationCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a QueryLogConfigAssociation to be deleted
+	QueryLogConfigAssociationDeletedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a QueryLogConfig to return CREATED
+	QueryLogConfigCreatedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a QueryLogConfig to be deleted
+	QueryLogConfigDeletedTimeout = 5 * time.Minute
+)
+
+// QueryLogConfigAssociationCreated waits for a QueryLogConfigAssociation to return ACTIVE
+func QueryLogConfigAssociationCreated(conn *route53resolver.Route53Resolver, queryLogConfigAssociationID string) (*route53resolver.ResolverQueryLogConfigAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53resolver.ResolverQueryLogConfigAssociationStatusCreating},
+		Target:  []string{route53resolver.ResolverQueryLogConfigAssociationStatusActive},
+		Refresh: QueryLogConfigAssociationStatus(conn, queryLogConfigAssociationID),
+		Timeout: QueryLogConfigAssociationCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*route53resolver.ResolverQueryLogConfigAssociation); ok {
+		if err != nil && v.Error != nil && aws.StringValue(v.Error) != route53resolver.ResolverQueryLogConfigAssociationErrorNone {
+			err = fmt.Errorf("%s: %s: %w", aws.StringValue(v.Error), aws.StringValue(v.ErrorMessage), err)
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// QueryLogConfigAssociationDeleted waits for a QueryLogConfigAssociation to be deleted
+func QueryLogConfigAssociationDeleted(conn *route53resolver.Route53Resolver, queryLogConfigAssociationID string) (*route53resolver.ResolverQueryLogConfigAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53resolver.ResolverQueryLogConfigAssociationStatusDeleting},
+		Target:  []string{},
+		Refresh: QueryLogConfigAssociationStatus(conn, queryLogConfigAssociationID),
+		Timeout: QueryLogConfigAssociationDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*route53resolver.ResolverQueryLogConfigAssociation); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// QueryLogConfigCreated waits for a QueryLogConfig to return CREATED
+func QueryLogConfigCreated(conn *route53resolver.Route53Resolver, queryLogConfigID string) (*route53resolver.ResolverQueryLogConfig, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53resolver.ResolverQueryLogConfigStatusCreating},
+		Target:  []string{route53resolver.ResolverQueryLogConfigStatusCreated},
+		Refresh: QueryLogConfigStatus(conn, queryLogConfigID),
+		Timeout: QueryLogConfigCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*route53resolver.ResolverQueryLogConfig); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// QueryLogConfigDeleted waits for a QueryLogConfig to be deleted
+func QueryLogConfigDeleted(conn *route53resolver.Route53Resolver, queryLogConfigID string) (*route53resolver.ResolverQueryLogConfig, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53resolver.ResolverQueryLogConfigStatusDeleting},
+		Target:  []string{},
+		Refresh: QueryLogConfigStatus(conn, queryLogConfigID),
+		Timeout: QueryLogConfigDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*route53resolver.ResolverQueryLogConfig); ok {
+		return v, err
+	}
+
+	return nil, err
+}
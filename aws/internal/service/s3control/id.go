@@ -0,0 +1,24 @@
+package s3control
+
+import (
+	"fmt"
+	"strings"
+)
+
+const jobIDSeparator = ":"
+
+func JobCreateID(accountID, jobID string) string {
+	parts := []string{accountID, jobID}
+	id := strings.Join(parts, jobIDSeparator)
+	return id
+}
+
+func JobParseID(id string) (string, string, error) {
+	parts := strings.Split(id, jobIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected account-id"+jobIDSeparator+"job-id", id)
+}
@@ -0,0 +1,71 @@
+package s3control_test
+
+import (
+	"testing"
+
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
+)
+
+func TestJobParseID(t *testing.T) {
+	testCases := []struct {
+		TestName          string
+		InputID           string
+		ExpectError       bool
+		ExpectedAccountID string
+		ExpectedJobID     string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing account ID",
+			InputID:     ":ba6e3f4e-4b3f-4f3c-8e6e-2b1c5d0e9a7f",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing job ID",
+			InputID:     "123456789012:",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "123456789012:ba6e3f4e-4b3f-4f3c-8e6e-2b1c5d0e9a7f:extra",
+			ExpectError: true,
+		},
+		{
+			TestName:          "valid ID",
+			InputID:           tfs3control.JobCreateID("123456789012", "ba6e3f4e-4b3f-4f3c-8e6e-2b1c5d0e9a7f"),
+			ExpectedAccountID: "123456789012",
+			ExpectedJobID:     "ba6e3f4e-4b3f-4f3c-8e6e-2b1c5d0e9a7f",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotAccountID, gotJobID, err := tfs3control.JobParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotAccountID != testCase.ExpectedAccountID {
+				t.Errorf("got account ID %s, expected %s", gotAccountID, testCase.ExpectedAccountID)
+			}
+
+			if gotJobID != testCase.ExpectedJobID {
+				t.Errorf("got job ID %s, expected %s", gotJobID, testCase.ExpectedJobID)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/finder"
+)
+
+const (
+	// JobStatus NotFound
+	JobStatusNotFound = "NotFound"
+
+	// JobStatus Unknown
+	JobStatusUnknown = "Unknown"
+)
+
+// JobStatus fetches the S3 Batch Operations job and its Status
+func JobStatus(conn *s3control.S3Control, accountID, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.JobByID(conn, accountID, jobID)
+
+		if tfawserr.ErrCodeEquals(err, s3control.ErrCodeNotFoundException) {
+			return nil, JobStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, JobStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, JobStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,116 @@
+package waiter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Job to finish preparing
+	JobPreparedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Job to be cancelled
+	JobCancelledTimeout = 10 * time.Minute
+)
+
+// JobPrepared waits for a Job to finish reading its manifest and either await confirmation or start running
+func JobPrepared(conn *s3control.S3Control, accountID, jobID string) (*s3control.JobDescriptor, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			s3control.JobStatusNew,
+			s3control.JobStatusPreparing,
+		},
+		Target: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusComplete,
+			s3control.JobStatusCompleting,
+			s3control.JobStatusReady,
+			s3control.JobStatusSuspended,
+		},
+		Refresh: JobStatus(conn, accountID, jobID),
+		Timeout: JobPreparedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		return v, jobFailureError(v, err)
+	}
+
+	return nil, err
+}
+
+// JobCompleted waits for a Job to return Complete
+func JobCompleted(conn *s3control.S3Control, accountID, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusCompleting,
+			s3control.JobStatusNew,
+			s3control.JobStatusPaused,
+			s3control.JobStatusPausing,
+			s3control.JobStatusPreparing,
+			s3control.JobStatusReady,
+		},
+		Target:  []string{s3control.JobStatusComplete},
+		Refresh: JobStatus(conn, accountID, jobID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		return v, jobFailureError(v, err)
+	}
+
+	return nil, err
+}
+
+// JobCancelled waits for a Job to leave the Cancelling status
+func JobCancelled(conn *s3control.S3Control, accountID, jobID string) (*s3control.JobDescriptor, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{s3control.JobStatusCancelling},
+		Target: []string{
+			s3control.JobStatusCancelled,
+			s3control.JobStatusComplete,
+			s3control.JobStatusFailed,
+		},
+		Refresh: JobStatus(conn, accountID, jobID),
+		Timeout: JobCancelledTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+func jobFailureError(job *s3control.JobDescriptor, err error) error {
+	if err == nil || job == nil {
+		return err
+	}
+
+	var errs []string
+
+	for _, failure := range job.FailureReasons {
+		if failure == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(failure.FailureCode), aws.StringValue(failure.FailureReason)))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s: %w", strings.Join(errs, ", "), err)
+	}
+
+	return err
+}
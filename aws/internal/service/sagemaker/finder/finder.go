@@ -0,0 +1,90 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+)
+
+// AppByName returns the Studio app corresponding to the specified domain, user profile, type and name.
+// Returns nil if no app is found.
+func AppByName(conn *sagemaker.SageMaker, domainID, userProfileName, appType, appName string) (*sagemaker.DescribeAppOutput, error) {
+	input := &sagemaker.DescribeAppInput{
+		AppName:         aws.String(appName),
+		AppType:         aws.String(appType),
+		DomainId:        aws.String(domainID),
+		UserProfileName: aws.String(userProfileName),
+	}
+
+	output, err := conn.DescribeApp(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// CodeRepositoryByName returns the code repository corresponding to the specified name.
+// Returns nil if no code repository is found.
+func CodeRepositoryByName(conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeCodeRepositoryOutput, error) {
+	input := &sagemaker.DescribeCodeRepositoryInput{
+		CodeRepositoryName: aws.String(name),
+	}
+
+	output, err := conn.DescribeCodeRepository(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// DomainByID returns the Studio domain corresponding to the specified ID.
+// Returns nil if no domain is found.
+func DomainByID(conn *sagemaker.SageMaker, domainID string) (*sagemaker.DescribeDomainOutput, error) {
+	input := &sagemaker.DescribeDomainInput{
+		DomainId: aws.String(domainID),
+	}
+
+	output, err := conn.DescribeDomain(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// UserProfileByName returns the Studio user profile corresponding to the specified domain and name.
+// Returns nil if no user profile is found.
+func UserProfileByName(conn *sagemaker.SageMaker, domainID, userProfileName string) (*sagemaker.DescribeUserProfileOutput, error) {
+	input := &sagemaker.DescribeUserProfileInput{
+		DomainId:        aws.String(domainID),
+		UserProfileName: aws.String(userProfileName),
+	}
+
+	output, err := conn.DescribeUserProfile(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
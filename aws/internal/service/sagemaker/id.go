@@ -0,0 +1,43 @@
+package sagemaker
+
+import (
+	"fmt"
+	"strings"
+)
+
+const appIDSeparator = ":"
+
+func AppCreateID(domainID, userProfileName, appType, appName string) string {
+	parts := []string{domainID, userProfileName, appType, appName}
+	id := strings.Join(parts, appIDSeparator)
+	return id
+}
+
+func AppParseID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, appIDSeparator)
+	if len(parts) == 4 && parts[0] != "" && parts[1] != "" && parts[2] != "" && parts[3] != "" {
+		return parts[0], parts[1], parts[2], parts[3], nil
+	}
+
+	return "", "", "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected domain-id"+appIDSeparator+"user-profile-name"+
+			appIDSeparator+"app-type"+appIDSeparator+"app-name", id)
+}
+
+const userProfileIDSeparator = ":"
+
+func UserProfileCreateID(domainID, userProfileName string) string {
+	parts := []string{domainID, userProfileName}
+	id := strings.Join(parts, userProfileIDSeparator)
+	return id
+}
+
+func UserProfileParseID(id string) (string, string, error) {
+	parts := strings.Split(id, userProfileIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected domain-id"+userProfileIDSeparator+"user-profile-name", id)
+}
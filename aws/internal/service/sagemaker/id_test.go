@@ -0,0 +1,127 @@
+package sagemaker_test
+
+import (
+	"testing"
+
+	tfsagemaker "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker"
+)
+
+func TestAppParseID(t *testing.T) {
+	testCases := []struct {
+		TestName                string
+		InputID                 string
+		ExpectError             bool
+		ExpectedDomainID        string
+		ExpectedUserProfileName string
+		ExpectedAppType         string
+		ExpectedAppName         string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "d-abcdefghijkl:user",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing app name",
+			InputID:     "d-abcdefghijkl:user:JupyterServer:",
+			ExpectError: true,
+		},
+		{
+			TestName:                "valid ID",
+			InputID:                 tfsagemaker.AppCreateID("d-abcdefghijkl", "user", "JupyterServer", "default"),
+			ExpectedDomainID:        "d-abcdefghijkl",
+			ExpectedUserProfileName: "user",
+			ExpectedAppType:         "JupyterServer",
+			ExpectedAppName:         "default",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotDomainID, gotUserProfileName, gotAppType, gotAppName, err := tfsagemaker.AppParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotDomainID != testCase.ExpectedDomainID {
+				t.Errorf("got domain ID %s, expected %s", gotDomainID, testCase.ExpectedDomainID)
+			}
+
+			if gotUserProfileName != testCase.ExpectedUserProfileName {
+				t.Errorf("got user profile name %s, expected %s", gotUserProfileName, testCase.ExpectedUserProfileName)
+			}
+
+			if gotAppType != testCase.ExpectedAppType {
+				t.Errorf("got app type %s, expected %s", gotAppType, testCase.ExpectedAppType)
+			}
+
+			if gotAppName != testCase.ExpectedAppName {
+				t.Errorf("got app name %s, expected %s", gotAppName, testCase.ExpectedAppName)
+			}
+		})
+	}
+}
+
+func TestUserProfileParseID(t *testing.T) {
+	testCases := []struct {
+		TestName                string
+		InputID                 string
+		ExpectError             bool
+		ExpectedDomainID        string
+		ExpectedUserProfileName string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "incorrect format",
+			InputID:     "d-abcdefghijkl",
+			ExpectError: true,
+		},
+		{
+			TestName:    "missing user profile name",
+			InputID:     "d-abcdefghijkl:",
+			ExpectError: true,
+		},
+		{
+			TestName:                "valid ID",
+			InputID:                 tfsagemaker.UserProfileCreateID("d-abcdefghijkl", "user"),
+			ExpectedDomainID:        "d-abcdefghijkl",
+			ExpectedUserProfileName: "user",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotDomainID, gotUserProfileName, err := tfsagemaker.UserProfileParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotDomainID != testCase.ExpectedDomainID {
+				t.Errorf("got domain ID %s, expected %s", gotDomainID, testCase.ExpectedDomainID)
+			}
+
+			if gotUserProfileName != testCase.ExpectedUserProfileName {
+				t.Errorf("got user profile name %s, expected %s", gotUserProfileName, testCase.ExpectedUserProfileName)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+)
+
+const (
+	// AppStatus NotFound
+	AppStatusNotFound = "NotFound"
+
+	// AppStatus Unknown
+	AppStatusUnknown = "Unknown"
+
+	// DomainStatus NotFound
+	DomainStatusNotFound = "NotFound"
+
+	// DomainStatus Unknown
+	DomainStatusUnknown = "Unknown"
+
+	// DomainStatus Updating, which is not yet part of the DomainStatus enum
+	DomainStatusUpdating = "Updating"
+
+	// UserProfileStatus NotFound
+	UserProfileStatusNotFound = "NotFound"
+
+	// UserProfileStatus Unknown
+	UserProfileStatusUnknown = "Unknown"
+
+	// UserProfileStatus Updating, which is not yet part of the UserProfileStatus enum
+	UserProfileStatusUpdating = "Updating"
+)
+
+// AppStatus fetches the Studio app and its Status
+func AppStatus(conn *sagemaker.SageMaker, domainID, userProfileName, appType, appName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.AppByName(conn, domainID, userProfileName, appType, appName)
+
+		if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+			return nil, AppStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, AppStatusUnknown, err
+		}
+
+		// Deleted apps remain visible for some time after deletion.
+		if output == nil || aws.StringValue(output.Status) == sagemaker.AppStatusDeleted {
+			return nil, AppStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// DomainStatus fetches the Studio domain and its Status
+func DomainStatus(conn *sagemaker.SageMaker, domainID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.DomainByID(conn, domainID)
+
+		if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+			return nil, DomainStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, DomainStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, DomainStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// UserProfileStatus fetches the Studio user profile and its Status
+func UserProfileStatus(conn *sagemaker.SageMaker, domainID, userProfileName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.UserProfileByName(conn, domainID, userProfileName)
+
+		if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+			return nil, UserProfileStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, UserProfileStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, UserProfileStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,162 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for an App to return InService
+	AppInServiceTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for an App to be deleted
+	AppDeletedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Domain to return InService
+	DomainInServiceTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a Domain to be deleted
+	DomainDeletedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a UserProfile to return InService
+	UserProfileInServiceTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for a UserProfile to be deleted
+	UserProfileDeletedTimeout = 10 * time.Minute
+)
+
+// AppInService waits for an App to return InService
+func AppInService(conn *sagemaker.SageMaker, domainID, userProfileName, appType, appName string) (*sagemaker.DescribeAppOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.AppStatusPending},
+		Target:  []string{sagemaker.AppStatusInService},
+		Refresh: AppStatus(conn, domainID, userProfileName, appType, appName),
+		Timeout: AppInServiceTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*sagemaker.DescribeAppOutput); ok {
+		if err != nil && v.FailureReason != nil {
+			err = fmt.Errorf("%s: %w", aws.StringValue(v.FailureReason), err)
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// AppDeleted waits for an App to be deleted
+func AppDeleted(conn *sagemaker.SageMaker, domainID, userProfileName, appType, appName string) (*sagemaker.DescribeAppOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.AppStatusDeleting},
+		Target:  []string{},
+		Refresh: AppStatus(conn, domainID, userProfileName, appType, appName),
+		Timeout: AppDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*sagemaker.DescribeAppOutput); ok {
+		if err != nil && v.FailureReason != nil {
+			err = fmt.Errorf("%s: %w", aws.StringValue(v.FailureReason), err)
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// DomainInService waits for a Domain to return InService
+func DomainInService(conn *sagemaker.SageMaker, domainID string) (*sagemaker.DescribeDomainOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.DomainStatusPending, DomainStatusUpdating},
+		Target:  []string{sagemaker.DomainStatusInService},
+		Refresh: DomainStatus(conn, domainID),
+		Timeout: DomainInServiceTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*sagemaker.DescribeDomainOutput); ok {
+		if err != nil && v.FailureReason != nil {
+			err = fmt.Errorf("%s: %w", aws.StringValue(v.FailureReason), err)
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// DomainDeleted waits for a Domain to be deleted
+func DomainDeleted(conn *sagemaker.SageMaker, domainID string) (*sagemaker.DescribeDomainOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.DomainStatusDeleting},
+		Target:  []string{},
+		Refresh: DomainStatus(conn, domainID),
+		Timeout: DomainDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*sagemaker.DescribeDomainOutput); ok {
+		if err != nil && v.FailureReason != nil {
+			err = fmt.Errorf("%s: %w", aws.StringValue(v.FailureReason), err)
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// UserProfileInService waits for a UserProfile to return InService
+func UserProfileInService(conn *sagemaker.SageMaker, domainID, userProfileName string) (*sagemaker.DescribeUserProfileOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.UserProfileStatusPending, UserProfileStatusUpdating},
+		Target:  []string{sagemaker.UserProfileStatusInService},
+		Refresh: UserProfileStatus(conn, domainID, userProfileName),
+		Timeout: UserProfileInServiceTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*sagemaker.DescribeUserProfileOutput); ok {
+		if err != nil && v.FailureReason != nil {
+			err = fmt.Errorf("%s: %w", aws.StringValue(v.FailureReason), err)
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// UserProfileDeleted waits for a UserProfile to be deleted
+func UserProfileDeleted(conn *sagemaker.SageMaker, domainID, userProfileName string) (*sagemaker.DescribeUserProfileOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{sagemaker.UserProfileStatusDeleting},
+		Target:  []string{},
+		Refresh: UserProfileStatus(conn, domainID, userProfileName),
+		Timeout: UserProfileDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*sagemaker.DescribeUserProfileOutput); ok {
+		if err != nil && v.FailureReason != nil {
+			err = fmt.Errorf("%s: %w", aws.StringValue(v.FailureReason), err)
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,19 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	serverlessrepository "github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+)
+
+// Application returns the Serverless Application Repository application corresponding to the specified ID and optional semantic version.
+func Application(conn *serverlessrepository.ServerlessApplicationRepository, applicationID, version string) (*serverlessrepository.GetApplicationOutput, error) {
+	input := &serverlessrepository.GetApplicationInput{
+		ApplicationId: aws.String(applicationID),
+	}
+
+	if version != "" {
+		input.SemanticVersion = aws.String(version)
+	}
+
+	return conn.GetApplication(input)
+}
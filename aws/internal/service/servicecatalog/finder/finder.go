@@ -0,0 +1,247 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+)
+
+// ConstraintByID returns the constraint corresponding to the specified ID.
+// Returns nil if no constraint is found.
+func ConstraintByID(conn *servicecatalog.ServiceCatalog, constraintID string) (*servicecatalog.DescribeConstraintOutput, error) {
+	input := &servicecatalog.DescribeConstraintInput{
+		Id: aws.String(constraintID),
+	}
+
+	output, err := conn.DescribeConstraint(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConstraintDetail == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// PortfolioShare returns the organization node or account share of the specified portfolio.
+// Returns nil if no matching share is found.
+func PortfolioShare(conn *servicecatalog.ServiceCatalog, portfolioID, shareType, principalID string) (*servicecatalog.OrganizationNode, error) {
+	var result *servicecatalog.OrganizationNode
+
+	if shareType == servicecatalog.OrganizationNodeTypeAccount {
+		input := &servicecatalog.ListPortfolioAccessInput{
+			PortfolioId: aws.String(portfolioID),
+		}
+
+		err := conn.ListPortfolioAccessPages(input, func(page *servicecatalog.ListPortfolioAccessOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, accountID := range page.AccountIds {
+				if aws.StringValue(accountID) == principalID {
+					result = &servicecatalog.OrganizationNode{
+						Type:  aws.String(shareType),
+						Value: accountID,
+					}
+					return false
+				}
+			}
+
+			return !lastPage
+		})
+
+		return result, err
+	}
+
+	input := &servicecatalog.ListOrganizationPortfolioAccessInput{
+		OrganizationNodeType: aws.String(shareType),
+		PortfolioId:          aws.String(portfolioID),
+	}
+
+	err := conn.ListOrganizationPortfolioAccessPages(input, func(page *servicecatalog.ListOrganizationPortfolioAccessOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, node := range page.OrganizationNodes {
+			if node == nil {
+				continue
+			}
+
+			if aws.StringValue(node.Value) == principalID {
+				result = node
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// PrincipalPortfolioAssociation returns the principal associated with the specified portfolio.
+// Returns nil if no matching principal is found.
+func PrincipalPortfolioAssociation(conn *servicecatalog.ServiceCatalog, portfolioID, principalARN string) (*servicecatalog.Principal, error) {
+	input := &servicecatalog.ListPrincipalsForPortfolioInput{
+		PortfolioId: aws.String(portfolioID),
+	}
+
+	var result *servicecatalog.Principal
+
+	err := conn.ListPrincipalsForPortfolioPages(input, func(page *servicecatalog.ListPrincipalsForPortfolioOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, principal := range page.Principals {
+			if principal == nil {
+				continue
+			}
+
+			if aws.StringValue(principal.PrincipalARN) == principalARN {
+				result = principal
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// ProductByID returns the product corresponding to the specified ID.
+// Returns nil if no product is found.
+func ProductByID(conn *servicecatalog.ServiceCatalog, productID string) (*servicecatalog.DescribeProductAsAdminOutput, error) {
+	input := &servicecatalog.DescribeProductAsAdminInput{
+		Id: aws.String(productID),
+	}
+
+	output, err := conn.DescribeProductAsAdmin(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ProductViewDetail == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// ProductPortfolioAssociation returns the portfolio with which the specified product is associated.
+// Returns nil if the product is not associated with the portfolio.
+func ProductPortfolioAssociation(conn *servicecatalog.ServiceCatalog, portfolioID, productID string) (*servicecatalog.PortfolioDetail, error) {
+	input := &servicecatalog.ListPortfoliosForProductInput{
+		ProductId: aws.String(productID),
+	}
+
+	var result *servicecatalog.PortfolioDetail
+
+	err := conn.ListPortfoliosForProductPages(input, func(page *servicecatalog.ListPortfoliosForProductOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, portfolio := range page.PortfolioDetails {
+			if portfolio == nil {
+				continue
+			}
+
+			if aws.StringValue(portfolio.Id) == portfolioID {
+				result = portfolio
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// ProvisionedProductByID returns the provisioned product corresponding to the specified ID.
+// Returns nil if no provisioned product is found.
+func ProvisionedProductByID(conn *servicecatalog.ServiceCatalog, provisionedProductID string) (*servicecatalog.ProvisionedProductDetail, error) {
+	input := &servicecatalog.DescribeProvisionedProductInput{
+		Id: aws.String(provisionedProductID),
+	}
+
+	output, err := conn.DescribeProvisionedProduct(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.ProvisionedProductDetail, nil
+}
+
+// ProvisioningArtifactByID returns the provisioning artifact corresponding to the specified product and artifact IDs.
+// Returns nil if no provisioning artifact is found.
+func ProvisioningArtifactByID(conn *servicecatalog.ServiceCatalog, productID, provisioningArtifactID string) (*servicecatalog.DescribeProvisioningArtifactOutput, error) {
+	input := &servicecatalog.DescribeProvisioningArtifactInput{
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(provisioningArtifactID),
+	}
+
+	output, err := conn.DescribeProvisioningArtifact(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ProvisioningArtifactDetail == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// RecordByID returns the record corresponding to the specified ID.
+// Returns nil if no record is found.
+func RecordByID(conn *servicecatalog.ServiceCatalog, recordID string) (*servicecatalog.DescribeRecordOutput, error) {
+	input := &servicecatalog.DescribeRecordInput{
+		Id: aws.String(recordID),
+	}
+
+	output, err := conn.DescribeRecord(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RecordDetail == nil {
+		return nil, nil
+	}
+
+	return output, nil
+}
+
+// TagOptionByID returns the tag option corresponding to the specified ID.
+// Returns nil if no tag option is found.
+func TagOptionByID(conn *servicecatalog.ServiceCatalog, tagOptionID string) (*servicecatalog.TagOptionDetail, error) {
+	input := &servicecatalog.DescribeTagOptionInput{
+		Id: aws.String(tagOptionID),
+	}
+
+	output, err := conn.DescribeTagOption(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.TagOptionDetail, nil
+}
@@ -0,0 +1,82 @@
+package servicecatalog
+
+import (
+	"fmt"
+	"strings"
+)
+
+const portfolioShareIDSeparator = ":"
+
+func PortfolioShareCreateID(portfolioID, shareType, principalID string) string {
+	parts := []string{portfolioID, shareType, principalID}
+	id := strings.Join(parts, portfolioShareIDSeparator)
+	return id
+}
+
+func PortfolioShareParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, portfolioShareIDSeparator)
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected portfolio-id"+portfolioShareIDSeparator+
+			"type"+portfolioShareIDSeparator+"principal-id", id)
+}
+
+const principalPortfolioAssociationIDSeparator = ","
+
+func PrincipalPortfolioAssociationCreateID(portfolioID, principalARN string) string {
+	parts := []string{portfolioID, principalARN}
+	id := strings.Join(parts, principalPortfolioAssociationIDSeparator)
+	return id
+}
+
+func PrincipalPortfolioAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, principalPortfolioAssociationIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected portfolio-id"+principalPortfolioAssociationIDSeparator+
+			"principal-arn", id)
+}
+
+const productPortfolioAssociationIDSeparator = ":"
+
+func ProductPortfolioAssociationCreateID(portfolioID, productID string) string {
+	parts := []string{portfolioID, productID}
+	id := strings.Join(parts, productPortfolioAssociationIDSeparator)
+	return id
+}
+
+func ProductPortfolioAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, productPortfolioAssociationIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected portfolio-id"+productPortfolioAssociationIDSeparator+
+			"product-id", id)
+}
+
+const provisioningArtifactIDSeparator = ":"
+
+func ProvisioningArtifactCreateID(productID, provisioningArtifactID string) string {
+	parts := []string{productID, provisioningArtifactID}
+	id := strings.Join(parts, provisioningArtifactIDSeparator)
+	return id
+}
+
+func ProvisioningArtifactParseID(id string) (string, string, error) {
+	parts := strings.Split(id, provisioningArtifactIDSeparator)
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "",
+		fmt.Errorf("unexpected format for ID (%q), expected product-id"+provisioningArtifactIDSeparator+
+			"provisioning-artifact-id", id)
+}
@@ -0,0 +1,221 @@
+package servicecatalog_test
+
+import (
+	"testing"
+
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+)
+
+func TestPortfolioShareParseID(t *testing.T) {
+	testCases := []struct {
+		TestName            string
+		InputID             string
+		ExpectedError       bool
+		ExpectedPortfolioID string
+		ExpectedType        string
+		ExpectedPrincipalID string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing principal",
+			InputID:       "port-12345678:ACCOUNT",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "empty type",
+			InputID:       "port-12345678::123456789012",
+			ExpectedError: true,
+		},
+		{
+			TestName:            "account",
+			InputID:             "port-12345678:ACCOUNT:123456789012",
+			ExpectedPortfolioID: "port-12345678",
+			ExpectedType:        "ACCOUNT",
+			ExpectedPrincipalID: "123456789012",
+		},
+		{
+			TestName:            "organizational unit",
+			InputID:             "port-12345678:ORGANIZATIONAL_UNIT:ou-abcd-12345678",
+			ExpectedPortfolioID: "port-12345678",
+			ExpectedType:        "ORGANIZATIONAL_UNIT",
+			ExpectedPrincipalID: "ou-abcd-12345678",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPortfolioID, gotType, gotPrincipalID, err := tfservicecatalog.PortfolioShareParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPortfolioID != testCase.ExpectedPortfolioID {
+				t.Errorf("got portfolio ID %s, expected %s", gotPortfolioID, testCase.ExpectedPortfolioID)
+			}
+
+			if gotType != testCase.ExpectedType {
+				t.Errorf("got type %s, expected %s", gotType, testCase.ExpectedType)
+			}
+
+			if gotPrincipalID != testCase.ExpectedPrincipalID {
+				t.Errorf("got principal ID %s, expected %s", gotPrincipalID, testCase.ExpectedPrincipalID)
+			}
+		})
+	}
+}
+
+func TestPrincipalPortfolioAssociationParseID(t *testing.T) {
+	testCases := []struct {
+		TestName             string
+		InputID              string
+		ExpectedError        bool
+		ExpectedPortfolioID  string
+		ExpectedPrincipalARN string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing principal ARN",
+			InputID:       "port-12345678,",
+			ExpectedError: true,
+		},
+		{
+			TestName:             "valid ID",
+			InputID:              "port-12345678,arn:aws:iam::123456789012:role/test",
+			ExpectedPortfolioID:  "port-12345678",
+			ExpectedPrincipalARN: "arn:aws:iam::123456789012:role/test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPortfolioID, gotPrincipalARN, err := tfservicecatalog.PrincipalPortfolioAssociationParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPortfolioID != testCase.ExpectedPortfolioID {
+				t.Errorf("got portfolio ID %s, expected %s", gotPortfolioID, testCase.ExpectedPortfolioID)
+			}
+
+			if gotPrincipalARN != testCase.ExpectedPrincipalARN {
+				t.Errorf("got principal ARN %s, expected %s", gotPrincipalARN, testCase.ExpectedPrincipalARN)
+			}
+		})
+	}
+}
+
+func TestProductPortfolioAssociationParseID(t *testing.T) {
+	testCases := []struct {
+		TestName            string
+		InputID             string
+		ExpectedError       bool
+		ExpectedPortfolioID string
+		ExpectedProductID   string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "too many parts",
+			InputID:       "port-12345678:prod-12345678:extra",
+			ExpectedError: true,
+		},
+		{
+			TestName:            "valid ID",
+			InputID:             "port-12345678:prod-12345678",
+			ExpectedPortfolioID: "port-12345678",
+			ExpectedProductID:   "prod-12345678",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotPortfolioID, gotProductID, err := tfservicecatalog.ProductPortfolioAssociationParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotPortfolioID != testCase.ExpectedPortfolioID {
+				t.Errorf("got portfolio ID %s, expected %s", gotPortfolioID, testCase.ExpectedPortfolioID)
+			}
+
+			if gotProductID != testCase.ExpectedProductID {
+				t.Errorf("got product ID %s, expected %s", gotProductID, testCase.ExpectedProductID)
+			}
+		})
+	}
+}
+
+func TestProvisioningArtifactParseID(t *testing.T) {
+	testCases := []struct {
+		TestName                       string
+		InputID                        string
+		ExpectedError                  bool
+		ExpectedProductID              string
+		ExpectedProvisioningArtifactID string
+	}{
+		{
+			TestName:      "empty ID",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "missing provisioning artifact ID",
+			InputID:       "prod-12345678",
+			ExpectedError: true,
+		},
+		{
+			TestName:                       "valid ID",
+			InputID:                        "prod-12345678:pa-12345678",
+			ExpectedProductID:              "prod-12345678",
+			ExpectedProvisioningArtifactID: "pa-12345678",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotProductID, gotProvisioningArtifactID, err := tfservicecatalog.ProvisioningArtifactParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotProductID != testCase.ExpectedProductID {
+				t.Errorf("got product ID %s, expected %s", gotProductID, testCase.ExpectedProductID)
+			}
+
+			if gotProvisioningArtifactID != testCase.ExpectedProvisioningArtifactID {
+				t.Errorf("got provisioning artifact ID %s, expected %s", gotProvisioningArtifactID, testCase.ExpectedProvisioningArtifactID)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+)
+
+const (
+	// ConstraintStatus NotFound
+	ConstraintStatusNotFound = "NotFound"
+
+	// ConstraintStatus Unknown
+	ConstraintStatusUnknown = "Unknown"
+
+	// PortfolioShareStatus Unknown
+	PortfolioShareStatusUnknown = "Unknown"
+
+	// ProductStatus NotFound
+	ProductStatusNotFound = "NotFound"
+
+	// ProductStatus Unknown
+	ProductStatusUnknown = "Unknown"
+
+	// ProvisionedProductStatus NotFound
+	ProvisionedProductStatusNotFound = "NotFound"
+
+	// ProvisionedProductStatus Unknown
+	ProvisionedProductStatusUnknown = "Unknown"
+
+	// ProvisioningArtifactStatus NotFound
+	ProvisioningArtifactStatusNotFound = "NotFound"
+
+	// ProvisioningArtifactStatus Unknown
+	ProvisioningArtifactStatusUnknown = "Unknown"
+
+	// RecordStatus NotFound
+	RecordStatusNotFound = "NotFound"
+
+	// RecordStatus Unknown
+	RecordStatusUnknown = "Unknown"
+)
+
+// ConstraintStatus fetches the Constraint and its Status
+func ConstraintStatus(conn *servicecatalog.ServiceCatalog, constraintID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ConstraintByID(conn, constraintID)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			return nil, ConstraintStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, ConstraintStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, ConstraintStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// PortfolioShareStatus fetches the status of the portfolio share operation identified by the specified token
+func PortfolioShareStatus(conn *servicecatalog.ServiceCatalog, portfolioShareToken string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &servicecatalog.DescribePortfolioShareStatusInput{
+			PortfolioShareToken: aws.String(portfolioShareToken),
+		}
+
+		output, err := conn.DescribePortfolioShareStatus(input)
+
+		if err != nil {
+			return nil, PortfolioShareStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, PortfolioShareStatusUnknown, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// ProductStatus fetches the Product and its Status
+func ProductStatus(conn *servicecatalog.ServiceCatalog, productID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ProductByID(conn, productID)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			return nil, ProductStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, ProductStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, ProductStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.ProductViewDetail.Status), nil
+	}
+}
+
+// ProvisionedProductStatus fetches the ProvisionedProduct and its Status
+func ProvisionedProductStatus(conn *servicecatalog.ServiceCatalog, provisionedProductID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ProvisionedProductByID(conn, provisionedProductID)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			return nil, ProvisionedProductStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, ProvisionedProductStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, ProvisionedProductStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// ProvisioningArtifactStatus fetches the ProvisioningArtifact and its Status
+func ProvisioningArtifactStatus(conn *servicecatalog.ServiceCatalog, productID, provisioningArtifactID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.ProvisioningArtifactByID(conn, productID, provisioningArtifactID)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			return nil, ProvisioningArtifactStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, ProvisioningArtifactStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, ProvisioningArtifactStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// RecordStatus fetches the Record and its Status
+func RecordStatus(conn *servicecatalog.ServiceCatalog, recordID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.RecordByID(conn, recordID)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			return nil, RecordStatusNotFound, nil
+		}
+
+		if err != nil {
+			return nil, RecordStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, RecordStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.RecordDetail.Status), nil
+	}
+}
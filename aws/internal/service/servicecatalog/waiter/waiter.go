@@ -0,0 +1,169 @@
+package waiter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Constraint to return AVAILABLE
+	ConstraintReadyTimeout = 3 * time.Minute
+
+	// Maximum amount of time to wait for a portfolio share operation to complete
+	PortfolioShareReadyTimeout = 3 * time.Minute
+
+	// Maximum amount of time to wait for a Product to return AVAILABLE
+	ProductReadyTimeout = 3 * time.Minute
+
+	// Maximum amount of time to wait for a ProvisioningArtifact to return AVAILABLE
+	ProvisioningArtifactReadyTimeout = 3 * time.Minute
+)
+
+// ConstraintReady waits for a Constraint to return AVAILABLE
+func ConstraintReady(conn *servicecatalog.ServiceCatalog, constraintID string) (*servicecatalog.DescribeConstraintOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: ConstraintStatus(conn, constraintID),
+		Timeout: ConstraintReadyTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribeConstraintOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// PortfolioShareReady waits for a portfolio share operation to return COMPLETED
+func PortfolioShareReady(conn *servicecatalog.ServiceCatalog, portfolioShareToken string) (*servicecatalog.DescribePortfolioShareStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.ShareStatusNotStarted, servicecatalog.ShareStatusInProgress},
+		Target:  []string{servicecatalog.ShareStatusCompleted},
+		Refresh: PortfolioShareStatus(conn, portfolioShareToken),
+		Timeout: PortfolioShareReadyTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribePortfolioShareStatusOutput); ok {
+		if err != nil && v.ShareDetails != nil {
+			var errs []string
+
+			for _, shareError := range v.ShareDetails.ShareErrors {
+				if shareError == nil {
+					continue
+				}
+
+				errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(shareError.Error), aws.StringValue(shareError.Message)))
+			}
+
+			if len(errs) > 0 {
+				err = fmt.Errorf("%s: %w", strings.Join(errs, ", "), err)
+			}
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ProductReady waits for a Product to return AVAILABLE
+func ProductReady(conn *servicecatalog.ServiceCatalog, productID string) (*servicecatalog.DescribeProductAsAdminOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating, ProductStatusNotFound},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: ProductStatus(conn, productID),
+		Timeout: ProductReadyTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribeProductAsAdminOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ProvisionedProductTerminated waits for a ProvisionedProduct to be deleted
+func ProvisionedProductTerminated(conn *servicecatalog.ServiceCatalog, provisionedProductID string, timeout time.Duration) (*servicecatalog.ProvisionedProductDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: servicecatalog.ProvisionedProductStatus_Values(),
+		Target:  []string{},
+		Refresh: ProvisionedProductStatus(conn, provisionedProductID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.ProvisionedProductDetail); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// ProvisioningArtifactReady waits for a ProvisioningArtifact to return AVAILABLE
+func ProvisioningArtifactReady(conn *servicecatalog.ServiceCatalog, productID, provisioningArtifactID string) (*servicecatalog.DescribeProvisioningArtifactOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating, ProvisioningArtifactStatusNotFound},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: ProvisioningArtifactStatus(conn, productID, provisioningArtifactID),
+		Timeout: ProvisioningArtifactReadyTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribeProvisioningArtifactOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// RecordReady waits for a Record to return SUCCEEDED
+func RecordReady(conn *servicecatalog.ServiceCatalog, recordID string, timeout time.Duration) (*servicecatalog.DescribeRecordOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			servicecatalog.RecordStatusCreated,
+			servicecatalog.RecordStatusInProgress,
+			servicecatalog.RecordStatusInProgressInError,
+		},
+		Target:  []string{servicecatalog.RecordStatusSucceeded},
+		Refresh: RecordStatus(conn, recordID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*servicecatalog.DescribeRecordOutput); ok {
+		if err != nil && v.RecordDetail != nil {
+			var errs []string
+
+			for _, recordError := range v.RecordDetail.RecordErrors {
+				if recordError == nil {
+					continue
+				}
+
+				errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(recordError.Code), aws.StringValue(recordError.Description)))
+			}
+
+			if len(errs) > 0 {
+				err = fmt.Errorf("%s: %w", strings.Join(errs, ", "), err)
+			}
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,58 @@
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+)
+
+// StorediSCSIVolumeByARN returns the stored iSCSI volume corresponding to the specified ARN.
+// Returns nil if no volume is found.
+func StorediSCSIVolumeByARN(conn *storagegateway.StorageGateway, volumeARN string) (*storagegateway.StorediSCSIVolume, error) {
+	input := &storagegateway.DescribeStorediSCSIVolumesInput{
+		VolumeARNs: aws.StringSlice([]string{volumeARN}),
+	}
+
+	output, err := conn.DescribeStorediSCSIVolumes(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, volume := range output.StorediSCSIVolumes {
+		if aws.StringValue(volume.VolumeARN) == volumeARN {
+			return volume, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// TapePoolByARN returns the custom tape pool corresponding to the specified ARN.
+// Returns nil if no tape pool is found.
+func TapePoolByARN(conn *storagegateway.StorageGateway, poolARN string) (*storagegateway.PoolInfo, error) {
+	input := &storagegateway.ListTapePoolsInput{
+		PoolARNs: aws.StringSlice([]string{poolARN}),
+	}
+
+	output, err := conn.ListTapePools(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, pool := range output.PoolInfos {
+		if aws.StringValue(pool.PoolARN) == poolARN {
+			return pool, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,61 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/storagegateway/finder"
+)
+
+const (
+	// StorediSCSIVolume NotFound
+	StorediSCSIVolumeStatusNotFound = "NotFound"
+
+	// StorediSCSIVolume Unknown
+	StorediSCSIVolumeStatusUnknown = "Unknown"
+
+	// SMB settings Unknown
+	SmbActiveDirectorySettingsStatusUnknown = "Unknown"
+)
+
+// Stored iSCSI volume statuses, which are not part of an enum in the API model
+const (
+	StorediSCSIVolumeStatusAvailable     = "AVAILABLE"
+	StorediSCSIVolumeStatusBootstrapping = "BOOTSTRAPPING"
+	StorediSCSIVolumeStatusCreating      = "CREATING"
+	StorediSCSIVolumeStatusRestoring     = "RESTORING"
+)
+
+// StorediSCSIVolumeStatus fetches the stored iSCSI volume and its VolumeStatus
+func StorediSCSIVolumeStatus(conn *storagegateway.StorageGateway, volumeARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.StorediSCSIVolumeByARN(conn, volumeARN)
+
+		if err != nil {
+			return nil, StorediSCSIVolumeStatusUnknown, err
+		}
+
+		if output == nil {
+			return nil, StorediSCSIVolumeStatusNotFound, nil
+		}
+
+		return output, aws.StringValue(output.VolumeStatus), nil
+	}
+}
+
+// SmbActiveDirectorySettingsStatus fetches the gateway SMB settings and its ActiveDirectoryStatus
+func SmbActiveDirectorySettingsStatus(conn *storagegateway.StorageGateway, gatewayARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &storagegateway.DescribeSMBSettingsInput{
+			GatewayARN: aws.String(gatewayARN),
+		}
+
+		output, err := conn.DescribeSMBSettings(input)
+
+		if err != nil {
+			return nil, SmbActiveDirectorySettingsStatusUnknown, err
+		}
+
+		return output, aws.StringValue(output.ActiveDirectoryStatus), nil
+	}
+}
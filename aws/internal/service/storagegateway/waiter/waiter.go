@@ -0,0 +1,57 @@
+package waiter
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a stored iSCSI volume to become available
+	StorediSCSIVolumeAvailableTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a gateway to join an Active Directory domain
+	SmbActiveDirectorySettingsJoinedTimeout = 5 * time.Minute
+)
+
+// StorediSCSIVolumeAvailable waits for a stored iSCSI volume to return Available
+func StorediSCSIVolumeAvailable(conn *storagegateway.StorageGateway, volumeARN string) (*storagegateway.StorediSCSIVolume, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			StorediSCSIVolumeStatusBootstrapping,
+			StorediSCSIVolumeStatusCreating,
+			StorediSCSIVolumeStatusRestoring,
+		},
+		Target:  []string{StorediSCSIVolumeStatusAvailable},
+		Refresh: StorediSCSIVolumeStatus(conn, volumeARN),
+		Timeout: StorediSCSIVolumeAvailableTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*storagegateway.StorediSCSIVolume); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// SmbActiveDirectorySettingsJoined waits for a gateway to finish joining an Active Directory domain.
+// Terminal failures such as ACCESS_DENIED, NETWORK_ERROR or TIMEOUT are returned as errors.
+func SmbActiveDirectorySettingsJoined(conn *storagegateway.StorageGateway, gatewayARN string, timeout time.Duration) (*storagegateway.DescribeSMBSettingsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{storagegateway.ActiveDirectoryStatusJoining},
+		Target:  []string{storagegateway.ActiveDirectoryStatusJoined},
+		Refresh: SmbActiveDirectorySettingsStatus(conn, gatewayARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*storagegateway.DescribeSMBSettingsOutput); ok {
+		return v, err
+	}
+
+	return nil, err
+}
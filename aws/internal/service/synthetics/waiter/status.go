@@ -0,0 +1,38 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/finder"
+)
+
+const (
+	// CanaryState NotFound
+	CanaryStateNotFound = "NotFound"
+
+	// CanaryState Unknown
+	CanaryStateUnknown = "Unknown"
+)
+
+// CanaryState fetches the Canary and its State
+func CanaryState(conn *synthetics.Synthetics, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		canary, err := finder.CanaryByName(conn, name)
+
+		if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+			return nil, CanaryStateNotFound, nil
+		}
+
+		if err != nil {
+			return nil, CanaryStateUnknown, err
+		}
+
+		if canary == nil || canary.Status == nil {
+			return nil, CanaryStateNotFound, nil
+		}
+
+		return canary, aws.StringValue(canary.Status.State), nil
+	}
+}
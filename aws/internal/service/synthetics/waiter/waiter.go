@@ -0,0 +1,114 @@
+package waiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for a Canary to return Ready
+	CanaryReadyTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Canary to return Running
+	CanaryRunningTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Canary to return Stopped
+	CanaryStoppedTimeout = 5 * time.Minute
+
+	// Maximum amount of time to wait for a Canary to be deleted
+	CanaryDeletedTimeout = 5 * time.Minute
+)
+
+// CanaryReady waits for a Canary to return Ready
+func CanaryReady(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			synthetics.CanaryStateCreating,
+			synthetics.CanaryStateUpdating,
+		},
+		Target:  []string{synthetics.CanaryStateReady},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryReadyTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		if err != nil && v.Status != nil && aws.StringValue(v.Status.State) == synthetics.CanaryStateError {
+			err = fmt.Errorf("%s: %w", aws.StringValue(v.Status.StateReason), err)
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// CanaryRunning waits for a Canary to return Running
+func CanaryRunning(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			synthetics.CanaryStateStarting,
+			synthetics.CanaryStateUpdating,
+		},
+		Target:  []string{synthetics.CanaryStateRunning},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryRunningTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		if err != nil && v.Status != nil && aws.StringValue(v.Status.State) == synthetics.CanaryStateError {
+			err = fmt.Errorf("%s: %w", aws.StringValue(v.Status.StateReason), err)
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
+
+// CanaryStopped waits for a Canary to return Stopped
+func CanaryStopped(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			synthetics.CanaryStateRunning,
+			synthetics.CanaryStateStopping,
+			synthetics.CanaryStateUpdating,
+		},
+		Target:  []string{synthetics.CanaryStateStopped},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryStoppedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// CanaryDeleted waits for a Canary to be deleted
+func CanaryDeleted(conn *synthetics.Synthetics, name string) (*synthetics.Canary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{synthetics.CanaryStateDeleting},
+		Target:  []string{},
+		Refresh: CanaryState(conn, name),
+		Timeout: CanaryDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*synthetics.Canary); ok {
+		return v, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,330 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsAppmeshGatewayRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppmeshGatewayRouteCreate,
+		Read:   resourceAwsAppmeshGatewayRouteRead,
+		Update: resourceAwsAppmeshGatewayRouteUpdate,
+		Delete: resourceAwsAppmeshGatewayRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppmeshGatewayRouteImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"mesh_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"virtual_gateway_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"spec": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grpc_route": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MinItems:     0,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"spec.0.grpc_route", "spec.0.http2_route", "spec.0.http_route"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action": appmeshGatewayRouteActionSchema(),
+
+									"match": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"service_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"http2_route": appmeshGatewayRouteHttpRouteSchema(),
+
+						"http_route": appmeshGatewayRouteHttpRouteSchema(),
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+// appmeshGatewayRouteActionSchema returns the schema for a gateway route action, shared by all route types.
+func appmeshGatewayRouteActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"target": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"virtual_service": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"virtual_service_name": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringLenBetween(1, 255),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// appmeshGatewayRouteHttpRouteSchema returns the schema for an HTTP or HTTP/2 gateway route.
+func appmeshGatewayRouteHttpRouteSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MinItems:     0,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"spec.0.grpc_route", "spec.0.http2_route", "spec.0.http_route"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": appmeshGatewayRouteActionSchema(),
+
+				"match": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"prefix": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must start with /"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAppmeshGatewayRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appmeshconn
+
+	req := &appmesh.CreateGatewayRouteInput{
+		GatewayRouteName:   aws.String(d.Get("name").(string)),
+		MeshName:           aws.String(d.Get("mesh_name").(string)),
+		Spec:               expandAppmeshGatewayRouteSpec(d.Get("spec").([]interface{})),
+		Tags:               keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().AppmeshTags(),
+		VirtualGatewayName: aws.String(d.Get("virtual_gateway_name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating App Mesh gateway route: %#v", req)
+	resp, err := conn.CreateGatewayRoute(req)
+	if err != nil {
+		return fmt.Errorf("error creating App Mesh gateway route: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.GatewayRoute.Metadata.Uid))
+
+	return resourceAwsAppmeshGatewayRouteRead(d, meta)
+}
+
+func resourceAwsAppmeshGatewayRouteRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appmeshconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	resp, err := conn.DescribeGatewayRoute(&appmesh.DescribeGatewayRouteInput{
+		GatewayRouteName:   aws.String(d.Get("name").(string)),
+		MeshName:           aws.String(d.Get("mesh_name").(string)),
+		VirtualGatewayName: aws.String(d.Get("virtual_gateway_name").(string)),
+	})
+	if isAWSErr(err, appmesh.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] App Mesh gateway route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading App Mesh gateway route: %s", err)
+	}
+	if aws.StringValue(resp.GatewayRoute.Status.Status) == appmesh.GatewayRouteStatusCodeDeleted {
+		log.Printf("[WARN] App Mesh gateway route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(resp.GatewayRoute.Metadata.Arn)
+	d.Set("name", resp.GatewayRoute.GatewayRouteName)
+	d.Set("mesh_name", resp.GatewayRoute.MeshName)
+	d.Set("virtual_gateway_name", resp.GatewayRoute.VirtualGatewayName)
+	d.Set("arn", arn)
+	d.Set("created_date", resp.GatewayRoute.Metadata.CreatedAt.Format(time.RFC3339))
+	d.Set("last_updated_date", resp.GatewayRoute.Metadata.LastUpdatedAt.Format(time.RFC3339))
+	err = d.Set("spec", flattenAppmeshGatewayRouteSpec(resp.GatewayRoute.Spec))
+	if err != nil {
+		return fmt.Errorf("error setting spec: %s", err)
+	}
+
+	tags, err := keyvaluetags.AppmeshListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for App Mesh gateway route (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppmeshGatewayRouteUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appmeshconn
+
+	if d.HasChange("spec") {
+		_, v := d.GetChange("spec")
+		req := &appmesh.UpdateGatewayRouteInput{
+			GatewayRouteName:   aws.String(d.Get("name").(string)),
+			MeshName:           aws.String(d.Get("mesh_name").(string)),
+			Spec:               expandAppmeshGatewayRouteSpec(v.([]interface{})),
+			VirtualGatewayName: aws.String(d.Get("virtual_gateway_name").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating App Mesh gateway route: %#v", req)
+		_, err := conn.UpdateGatewayRoute(req)
+		if err != nil {
+			return fmt.Errorf("error updating App Mesh gateway route: %s", err)
+		}
+	}
+
+	arn := d.Get("arn").(string)
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.AppmeshUpdateTags(conn, arn, o, n); err != nil {
+			return fmt.Errorf("error updating App Mesh gateway route (%s) tags: %s", arn, err)
+		}
+	}
+
+	return resourceAwsAppmeshGatewayRouteRead(d, meta)
+}
+
+func resourceAwsAppmeshGatewayRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appmeshconn
+
+	log.Printf("[DEBUG] Deleting App Mesh gateway route: %s", d.Id())
+	_, err := conn.DeleteGatewayRoute(&appmesh.DeleteGatewayRouteInput{
+		GatewayRouteName:   aws.String(d.Get("name").(string)),
+		MeshName:           aws.String(d.Get("mesh_name").(string)),
+		VirtualGatewayName: aws.String(d.Get("virtual_gateway_name").(string)),
+	})
+	if isAWSErr(err, appmesh.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting App Mesh gateway route: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppmeshGatewayRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return []*schema.ResourceData{}, fmt.Errorf("Wrong format of resource: %s. Please follow 'mesh-name/virtual-gateway-name/gateway-route-name'", d.Id())
+	}
+
+	mesh := parts[0]
+	vgName := parts[1]
+	name := parts[2]
+	log.Printf("[DEBUG] Importing App Mesh gateway route %s from mesh %s/virtual gateway %s ", name, mesh, vgName)
+
+	conn := meta.(*AWSClient).appmeshconn
+
+	resp, err := conn.DescribeGatewayRoute(&appmesh.DescribeGatewayRouteInput{
+		GatewayRouteName:   aws.String(name),
+		MeshName:           aws.String(mesh),
+		VirtualGatewayName: aws.String(vgName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(aws.StringValue(resp.GatewayRoute.Metadata.Uid))
+	d.Set("name", resp.GatewayRoute.GatewayRouteName)
+	d.Set("mesh_name", resp.GatewayRoute.MeshName)
+	d.Set("virtual_gateway_name", resp.GatewayRoute.VirtualGatewayName)
+
+	return []*schema.ResourceData{d}, nil
+}
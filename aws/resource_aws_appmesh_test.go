@@ -6,6 +6,14 @@ import (
 
 func TestAccAWSAppmesh_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
+		"GatewayRoute": {
+			"basic":      testAccAwsAppmeshGatewayRoute_basic,
+			"disappears": testAccAwsAppmeshGatewayRoute_disappears,
+			"grpcRoute":  testAccAwsAppmeshGatewayRoute_GrpcRoute,
+			"httpRoute":  testAccAwsAppmeshGatewayRoute_HttpRoute,
+			"http2Route": testAccAwsAppmeshGatewayRoute_Http2Route,
+			"tags":       testAccAwsAppmeshGatewayRoute_Tags,
+		},
 		"Mesh": {
 			"basic":        testAccAwsAppmeshMesh_basic,
 			"egressFilter": testAccAwsAppmeshMesh_egressFilter,
@@ -18,6 +26,15 @@ func TestAccAWSAppmesh_serial(t *testing.T) {
 			"routePriority": testAccAwsAppmeshRoute_routePriority,
 			"tags":          testAccAwsAppmeshRoute_tags,
 		},
+		"VirtualGateway": {
+			"basic":                testAccAwsAppmeshVirtualGateway_basic,
+			"disappears":           testAccAwsAppmeshVirtualGateway_disappears,
+			"backendDefaults":      testAccAwsAppmeshVirtualGateway_BackendDefaults,
+			"listenerHealthChecks": testAccAwsAppmeshVirtualGateway_ListenerHealthChecks,
+			"listenerTls":          testAccAwsAppmeshVirtualGateway_ListenerTls,
+			"logging":              testAccAwsAppmeshVirtualGateway_Logging,
+			"tags":                 testAccAwsAppmeshVirtualGateway_Tags,
+		},
 		"VirtualNode": {
 			"basic":                    testAccAwsAppmeshVirtualNode_basic,
 			"cloudMapServiceDiscovery": testAccAwsAppmeshVirtualNode_cloudMapServiceDiscovery,
@@ -0,0 +1,500 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appmesh"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+)
+
+func resourceAwsAppmeshVirtualGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAppmeshVirtualGatewayCreate,
+		Read:   resourceAwsAppmeshVirtualGatewayRead,
+		Update: resourceAwsAppmeshVirtualGatewayUpdate,
+		Delete: resourceAwsAppmeshVirtualGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppmeshVirtualGatewayImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"mesh_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"spec": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backend_defaults": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 0,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MinItems: 0,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tls": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 0,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"enforce": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																Default:  true,
+															},
+
+															"ports": {
+																Type:     schema.TypeSet,
+																Optional: true,
+																Elem: &schema.Schema{
+																	Type:         schema.TypeInt,
+																	ValidateFunc: validation.IsPortNumber,
+																},
+															},
+
+															"validation": {
+																Type:     schema.TypeList,
+																Required: true,
+																MinItems: 1,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"trust": {
+																			Type:     schema.TypeList,
+																			Required: true,
+																			MinItems: 1,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"acm": {
+																						Type:         schema.TypeList,
+																						Optional:     true,
+																						MinItems:     0,
+																						MaxItems:     1,
+																						ExactlyOneOf: []string{"spec.0.backend_defaults.0.client_policy.0.tls.0.validation.0.trust.0.acm", "spec.0.backend_defaults.0.client_policy.0.tls.0.validation.0.trust.0.file"},
+																						Elem: &schema.Resource{
+																							Schema: map[string]*schema.Schema{
+																								"certificate_authority_arns": {
+																									Type:     schema.TypeSet,
+																									Required: true,
+																									MinItems: 1,
+																									Elem: &schema.Schema{
+																										Type:         schema.TypeString,
+																										ValidateFunc: validateArn,
+																									},
+																								},
+																							},
+																						},
+																					},
+
+																					"file": {
+																						Type:         schema.TypeList,
+																						Optional:     true,
+																						MinItems:     0,
+																						MaxItems:     1,
+																						ExactlyOneOf: []string{"spec.0.backend_defaults.0.client_policy.0.tls.0.validation.0.trust.0.acm", "spec.0.backend_defaults.0.client_policy.0.tls.0.validation.0.trust.0.file"},
+																						Elem: &schema.Resource{
+																							Schema: map[string]*schema.Schema{
+																								"certificate_chain": {
+																									Type:         schema.TypeString,
+																									Required:     true,
+																									ValidateFunc: validation.StringLenBetween(1, 255),
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"listener": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"health_check": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MinItems: 0,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"healthy_threshold": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(2, 10),
+												},
+
+												"interval_millis": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(5000, 300000),
+												},
+
+												"path": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+
+												"port": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+
+												"protocol": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(appmesh.VirtualGatewayPortProtocol_Values(), false),
+												},
+
+												"timeout_millis": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(2000, 60000),
+												},
+
+												"unhealthy_threshold": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(2, 10),
+												},
+											},
+										},
+									},
+
+									"port_mapping": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+
+												"protocol": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(appmesh.VirtualGatewayPortProtocol_Values(), false),
+												},
+											},
+										},
+									},
+
+									"tls": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MinItems: 0,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"certificate": {
+													Type:     schema.TypeList,
+													Required: true,
+													MinItems: 1,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"acm": {
+																Type:         schema.TypeList,
+																Optional:     true,
+																MinItems:     0,
+																MaxItems:     1,
+																ExactlyOneOf: []string{"spec.0.listener.0.tls.0.certificate.0.acm", "spec.0.listener.0.tls.0.certificate.0.file"},
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"certificate_arn": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validateArn,
+																		},
+																	},
+																},
+															},
+
+															"file": {
+																Type:         schema.TypeList,
+																Optional:     true,
+																MinItems:     0,
+																MaxItems:     1,
+																ExactlyOneOf: []string{"spec.0.listener.0.tls.0.certificate.0.acm", "spec.0.listener.0.tls.0.certificate.0.file"},
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"certificate_chain": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validation.StringLenBetween(1, 255),
+																		},
+
+																		"private_key": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validation.StringLenBetween(1, 255),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+
+												"mode": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(appmesh.VirtualGatewayListenerTlsMode_Values(), false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"logging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 0,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_log": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MinItems: 0,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"file": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 0,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"path": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 255),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsAppmeshVirtualGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appmeshconn
+
+	req := &appmesh.CreateVirtualGatewayInput{
+		MeshName:           aws.String(d.Get("mesh_name").(string)),
+		VirtualGatewayName: aws.String(d.Get("name").(string)),
+		Spec:               expandAppmeshVirtualGatewaySpec(d.Get("spec").([]interface{})),
+		Tags:               keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().AppmeshTags(),
+	}
+
+	log.Printf("[DEBUG] Creating App Mesh virtual gateway: %#v", req)
+	resp, err := conn.CreateVirtualGateway(req)
+	if err != nil {
+		return fmt.Errorf("error creating App Mesh virtual gateway: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.VirtualGateway.Metadata.Uid))
+
+	return resourceAwsAppmeshVirtualGatewayRead(d, meta)
+}
+
+func resourceAwsAppmeshVirtualGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appmeshconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	resp, err := conn.DescribeVirtualGateway(&appmesh.DescribeVirtualGatewayInput{
+		MeshName:           aws.String(d.Get("mesh_name").(string)),
+		VirtualGatewayName: aws.String(d.Get("name").(string)),
+	})
+	if isAWSErr(err, appmesh.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] App Mesh virtual gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading App Mesh virtual gateway: %s", err)
+	}
+	if aws.StringValue(resp.VirtualGateway.Status.Status) == appmesh.VirtualGatewayStatusCodeDeleted {
+		log.Printf("[WARN] App Mesh virtual gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(resp.VirtualGateway.Metadata.Arn)
+	d.Set("name", resp.VirtualGateway.VirtualGatewayName)
+	d.Set("mesh_name", resp.VirtualGateway.MeshName)
+	d.Set("arn", arn)
+	d.Set("created_date", resp.VirtualGateway.Metadata.CreatedAt.Format(time.RFC3339))
+	d.Set("last_updated_date", resp.VirtualGateway.Metadata.LastUpdatedAt.Format(time.RFC3339))
+	err = d.Set("spec", flattenAppmeshVirtualGatewaySpec(resp.VirtualGateway.Spec))
+	if err != nil {
+		return fmt.Errorf("error setting spec: %s", err)
+	}
+
+	tags, err := keyvaluetags.AppmeshListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for App Mesh virtual gateway (%s): %s", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppmeshVirtualGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appmeshconn
+
+	if d.HasChange("spec") {
+		_, v := d.GetChange("spec")
+		req := &appmesh.UpdateVirtualGatewayInput{
+			MeshName:           aws.String(d.Get("mesh_name").(string)),
+			VirtualGatewayName: aws.String(d.Get("name").(string)),
+			Spec:               expandAppmeshVirtualGatewaySpec(v.([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating App Mesh virtual gateway: %#v", req)
+		_, err := conn.UpdateVirtualGateway(req)
+		if err != nil {
+			return fmt.Errorf("error updating App Mesh virtual gateway: %s", err)
+		}
+	}
+
+	arn := d.Get("arn").(string)
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.AppmeshUpdateTags(conn, arn, o, n); err != nil {
+			return fmt.Errorf("error updating App Mesh virtual gateway (%s) tags: %s", arn, err)
+		}
+	}
+
+	return resourceAwsAppmeshVirtualGatewayRead(d, meta)
+}
+
+func resourceAwsAppmeshVirtualGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).appmeshconn
+
+	log.Printf("[DEBUG] Deleting App Mesh virtual gateway: %s", d.Id())
+	_, err := conn.DeleteVirtualGateway(&appmesh.DeleteVirtualGatewayInput{
+		MeshName:           aws.String(d.Get("mesh_name").(string)),
+		VirtualGatewayName: aws.String(d.Get("name").(string)),
+	})
+	if isAWSErr(err, appmesh.ErrCodeNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting App Mesh virtual gateway: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAppmeshVirtualGatewayImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return []*schema.ResourceData{}, fmt.Errorf("Wrong format of resource: %s. Please follow 'mesh-name/virtual-gateway-name'", d.Id())
+	}
+
+	mesh := parts[0]
+	name := parts[1]
+	log.Printf("[DEBUG] Importing App Mesh virtual gateway %s from mesh %s", name, mesh)
+
+	conn := meta.(*AWSClient).appmeshconn
+
+	resp, err := conn.DescribeVirtualGateway(&appmesh.DescribeVirtualGatewayInput{
+		MeshName:           aws.String(mesh),
+		VirtualGatewayName: aws.String(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(aws.StringValue(resp.VirtualGateway.Metadata.Uid))
+	d.Set("name", resp.VirtualGateway.VirtualGatewayName)
+	d.Set("mesh_name", resp.VirtualGateway.MeshName)
+
+	return []*schema.ResourceData{d}, nil
+}
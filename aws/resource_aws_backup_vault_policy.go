@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsBackupVaultPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsBackupVaultPolicyPut,
+		Read:   resourceAwsBackupVaultPolicyRead,
+		Update: resourceAwsBackupVaultPolicyPut,
+		Delete: resourceAwsBackupVaultPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backup_vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9\-\_\.]{1,50}$`), "must consist of lowercase letters, numbers, and hyphens."),
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"backup_vault_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsBackupVaultPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	name := d.Get("backup_vault_name").(string)
+	input := &backup.PutBackupVaultAccessPolicyInput{
+		BackupVaultName: aws.String(name),
+		Policy:          aws.String(d.Get("policy").(string)),
+	}
+
+	log.Printf("[DEBUG] Putting Backup Vault Policy: %s", input)
+	_, err := conn.PutBackupVaultAccessPolicy(input)
+	if err != nil {
+		return fmt.Errorf("error putting Backup Vault Policy (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsBackupVaultPolicyRead(d, meta)
+}
+
+func resourceAwsBackupVaultPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	input := &backup.GetBackupVaultAccessPolicyInput{
+		BackupVaultName: aws.String(d.Id()),
+	}
+
+	resp, err := conn.GetBackupVaultAccessPolicy(input)
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Backup Vault Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Backup Vault Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("backup_vault_name", resp.BackupVaultName)
+	d.Set("policy", resp.Policy)
+	d.Set("backup_vault_arn", resp.BackupVaultArn)
+
+	return nil
+}
+
+func resourceAwsBackupVaultPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).backupconn
+
+	input := &backup.DeleteBackupVaultAccessPolicyInput{
+		BackupVaultName: aws.String(d.Id()),
+	}
+
+	_, err := conn.DeleteBackupVaultAccessPolicy(input)
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Backup Vault Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -32,13 +32,31 @@ func resourceAwsCloudFormationStackSet() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"administration_role_arn": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validateArn,
 			},
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"auto_deployment": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"retain_stacks_on_account_removal": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"capabilities": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -59,7 +77,7 @@ func resourceAwsCloudFormationStackSet() *schema.Resource {
 			"execution_role_name": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "AWSCloudFormationStackSetExecutionRole",
+				Computed: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -71,11 +89,19 @@ func resourceAwsCloudFormationStackSet() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]+$`), "must contain only alphanumeric and hyphen characters"),
 				),
 			},
+			"operation_preferences": cloudFormationStackSetOperationPreferencesSchema(),
 			"parameters": {
 				Type:     schema.TypeMap,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"permission_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cloudformation.PermissionModelsSelfManaged,
+				ValidateFunc: validation.StringInSlice(cloudformation.PermissionModels_Values(), false),
+			},
 			"stack_set_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -103,10 +129,17 @@ func resourceAwsCloudFormationStackSetCreate(d *schema.ResourceData, meta interf
 	name := d.Get("name").(string)
 
 	input := &cloudformation.CreateStackSetInput{
-		AdministrationRoleARN: aws.String(d.Get("administration_role_arn").(string)),
-		ClientRequestToken:    aws.String(resource.UniqueId()),
-		ExecutionRoleName:     aws.String(d.Get("execution_role_name").(string)),
-		StackSetName:          aws.String(name),
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		PermissionModel:    aws.String(d.Get("permission_model").(string)),
+		StackSetName:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("administration_role_arn"); ok {
+		input.AdministrationRoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("auto_deployment"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AutoDeployment = expandCloudFormationAutoDeployment(v.([]interface{})[0].(map[string]interface{}))
 	}
 
 	if v, ok := d.GetOk("capabilities"); ok {
@@ -117,6 +150,10 @@ func resourceAwsCloudFormationStackSetCreate(d *schema.ResourceData, meta interf
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("execution_role_name"); ok {
+		input.ExecutionRoleName = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
 	}
@@ -175,6 +212,13 @@ func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interfac
 	d.Set("administration_role_arn", stackSet.AdministrationRoleARN)
 	d.Set("arn", stackSet.StackSetARN)
 
+	// Disabled auto-deployment is equivalent to an unconfigured block
+	if autoDeployment := stackSet.AutoDeployment; autoDeployment != nil && !aws.BoolValue(autoDeployment.Enabled) && len(d.Get("auto_deployment").([]interface{})) == 0 {
+		d.Set("auto_deployment", nil)
+	} else if err := d.Set("auto_deployment", flattenCloudFormationAutoDeployment(autoDeployment)); err != nil {
+		return fmt.Errorf("error setting auto_deployment: %s", err)
+	}
+
 	if err := d.Set("capabilities", aws.StringValueSlice(stackSet.Capabilities)); err != nil {
 		return fmt.Errorf("error setting capabilities: %s", err)
 	}
@@ -182,6 +226,7 @@ func resourceAwsCloudFormationStackSetRead(d *schema.ResourceData, meta interfac
 	d.Set("description", stackSet.Description)
 	d.Set("execution_role_name", stackSet.ExecutionRoleName)
 	d.Set("name", stackSet.StackSetName)
+	d.Set("permission_model", stackSet.PermissionModel)
 
 	if err := d.Set("parameters", flattenAllCloudFormationParameters(stackSet.Parameters)); err != nil {
 		return fmt.Errorf("error setting parameters: %s", err)
@@ -202,12 +247,23 @@ func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interf
 	conn := meta.(*AWSClient).cfconn
 
 	input := &cloudformation.UpdateStackSetInput{
-		AdministrationRoleARN: aws.String(d.Get("administration_role_arn").(string)),
-		ExecutionRoleName:     aws.String(d.Get("execution_role_name").(string)),
-		OperationId:           aws.String(resource.UniqueId()),
-		StackSetName:          aws.String(d.Id()),
-		Tags:                  []*cloudformation.Tag{},
-		TemplateBody:          aws.String(d.Get("template_body").(string)),
+		OperationId:  aws.String(resource.UniqueId()),
+		StackSetName: aws.String(d.Id()),
+		Tags:         []*cloudformation.Tag{},
+		TemplateBody: aws.String(d.Get("template_body").(string)),
+	}
+
+	if v, ok := d.GetOk("administration_role_arn"); ok {
+		input.AdministrationRoleARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("auto_deployment"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AutoDeployment = expandCloudFormationAutoDeployment(v.([]interface{})[0].(map[string]interface{}))
+	} else if d.HasChange("auto_deployment") {
+		// Removing the configuration block disables auto-deployment
+		input.AutoDeployment = &cloudformation.AutoDeployment{
+			Enabled: aws.Bool(false),
+		}
 	}
 
 	if v, ok := d.GetOk("capabilities"); ok {
@@ -218,6 +274,14 @@ func resourceAwsCloudFormationStackSetUpdate(d *schema.ResourceData, meta interf
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("execution_role_name"); ok {
+		input.ExecutionRoleName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OperationPreferences = expandCloudFormationOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("parameters"); ok {
 		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
 	}
@@ -292,3 +356,101 @@ func listCloudFormationStackSets(conn *cloudformation.CloudFormation) ([]*cloudf
 
 	return result, nil
 }
+
+func cloudFormationStackSetOperationPreferencesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"failure_tolerance_count": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"failure_tolerance_percentage": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+				"max_concurrent_count": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"max_concurrent_percentage": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 100),
+				},
+				"region_order": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandCloudFormationAutoDeployment(tfMap map[string]interface{}) *cloudformation.AutoDeployment {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudformation.AutoDeployment{}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["retain_stacks_on_account_removal"].(bool); ok {
+		apiObject.RetainStacksOnAccountRemoval = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenCloudFormationAutoDeployment(apiObject *cloudformation.AutoDeployment) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":                          aws.BoolValue(apiObject.Enabled),
+		"retain_stacks_on_account_removal": aws.BoolValue(apiObject.RetainStacksOnAccountRemoval),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandCloudFormationOperationPreferences(tfMap map[string]interface{}) *cloudformation.StackSetOperationPreferences {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudformation.StackSetOperationPreferences{}
+
+	if v, ok := tfMap["failure_tolerance_count"].(int); ok && v != 0 {
+		apiObject.FailureToleranceCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["failure_tolerance_percentage"].(int); ok && v != 0 {
+		apiObject.FailureTolerancePercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_concurrent_count"].(int); ok && v != 0 {
+		apiObject.MaxConcurrentCount = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_concurrent_percentage"].(int); ok && v != 0 {
+		apiObject.MaxConcurrentPercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["region_order"].([]interface{}); ok && len(v) > 0 {
+		apiObject.RegionOrder = expandStringList(v)
+	}
+
+	return apiObject
+}
@@ -3,6 +3,8 @@ package aws
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -32,12 +34,35 @@ func resourceAwsCloudFormationStackSetInstance() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"account_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				ForceNew:     true,
-				ValidateFunc: validateAwsAccountId,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateAwsAccountId,
+				ConflictsWith: []string{"deployment_targets"},
+			},
+			"deployment_targets": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"account_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"organizational_unit_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringMatch(regexp.MustCompile(`^(ou-[a-z0-9]{4,32}-[a-z0-9]{8,32}|r-[a-z0-9]{4,32})$`), "must be a valid organizational unit or root ID"),
+							},
+						},
+					},
+				},
 			},
+			"operation_preferences": cloudFormationStackSetOperationPreferencesSchema(),
 			"parameter_overrides": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -58,6 +83,26 @@ func resourceAwsCloudFormationStackSetInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"stack_instance_summaries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"organizational_unit_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stack_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"stack_set_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -90,6 +135,24 @@ func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, met
 		StackSetName: aws.String(stackSetName),
 	}
 
+	// Organizational unit targets are identified by their sorted IDs in place of the account ID
+	accountOrOrgID := accountID
+
+	if v, ok := d.GetOk("deployment_targets"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		deploymentTargets := expandCloudFormationDeploymentTargets(v.([]interface{})[0].(map[string]interface{}))
+
+		input.Accounts = nil
+		input.DeploymentTargets = deploymentTargets
+
+		orgIDs := aws.StringValueSlice(deploymentTargets.OrganizationalUnitIds)
+		sort.Strings(orgIDs)
+		accountOrOrgID = strings.Join(orgIDs, "/")
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OperationPreferences = expandCloudFormationOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("parameter_overrides"); ok {
 		input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
 	}
@@ -101,7 +164,7 @@ func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, met
 		return fmt.Errorf("error creating CloudFormation StackSet Instance: %s", err)
 	}
 
-	d.SetId(fmt.Sprintf("%s,%s,%s", stackSetName, accountID, region))
+	d.SetId(fmt.Sprintf("%s,%s,%s", stackSetName, accountOrOrgID, region))
 
 	if err := waitForCloudFormationStackSetOperation(conn, stackSetName, aws.StringValue(output.OperationId), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("error waiting for CloudFormation StackSet Instance (%s) creation: %s", d.Id(), err)
@@ -113,12 +176,52 @@ func resourceAwsCloudFormationStackSetInstanceCreate(d *schema.ResourceData, met
 func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cfconn
 
-	stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
+	stackSetName, accountOrOrgID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
 
 	if err != nil {
 		return err
 	}
 
+	accountID := accountOrOrgID
+	var orgIDs []string
+
+	if !cloudFormationStackSetInstanceAccountIDRegexp.MatchString(accountOrOrgID) {
+		orgIDs = strings.Split(accountOrOrgID, "/")
+
+		summaries, err := listCloudFormationStackSetInstancesByRegionAndOrgIDs(conn, stackSetName, region, orgIDs)
+
+		if !d.IsNewResource() && isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+			log.Printf("[WARN] CloudFormation StackSet (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudFormation StackSet Instances (%s): %s", d.Id(), err)
+		}
+
+		if len(summaries) == 0 {
+			if d.IsNewResource() {
+				return fmt.Errorf("error reading CloudFormation StackSet Instance (%s): not found", d.Id())
+			}
+
+			log.Printf("[WARN] CloudFormation StackSet Instance (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		if err := d.Set("deployment_targets", []interface{}{map[string]interface{}{"organizational_unit_ids": flattenStringSet(aws.StringSlice(orgIDs))}}); err != nil {
+			return fmt.Errorf("error setting deployment_targets: %s", err)
+		}
+
+		if err := d.Set("stack_instance_summaries", flattenCloudFormationStackInstanceSummaries(summaries)); err != nil {
+			return fmt.Errorf("error setting stack_instance_summaries: %s", err)
+		}
+
+		// Parameter overrides are shared by every stack instance deployed to the targets
+		accountID = aws.StringValue(summaries[0].Account)
+	}
+
 	input := &cloudformation.DescribeStackInstanceInput{
 		StackInstanceAccount: aws.String(accountID),
 		StackInstanceRegion:  aws.String(region),
@@ -150,14 +253,27 @@ func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta
 
 	stackInstance := output.StackInstance
 
-	d.Set("account_id", stackInstance.Account)
+	if len(orgIDs) == 0 {
+		d.Set("account_id", stackInstance.Account)
+
+		if err := d.Set("stack_instance_summaries", []interface{}{map[string]interface{}{
+			"account_id":             aws.StringValue(stackInstance.Account),
+			"organizational_unit_id": aws.StringValue(stackInstance.OrganizationalUnitId),
+			"stack_id":               aws.StringValue(stackInstance.StackId),
+		}}); err != nil {
+			return fmt.Errorf("error setting stack_instance_summaries: %s", err)
+		}
+	}
 
 	if err := d.Set("parameter_overrides", flattenAllCloudFormationParameters(stackInstance.ParameterOverrides)); err != nil {
 		return fmt.Errorf("error setting parameters: %s", err)
 	}
 
 	d.Set("region", stackInstance.Region)
-	d.Set("stack_id", stackInstance.StackId)
+	// stack_id is only meaningful when the instance targets a single account
+	if len(orgIDs) == 0 {
+		d.Set("stack_id", stackInstance.StackId)
+	}
 	d.Set("stack_set_name", stackSetName)
 
 	return nil
@@ -166,21 +282,32 @@ func resourceAwsCloudFormationStackSetInstanceRead(d *schema.ResourceData, meta
 func resourceAwsCloudFormationStackSetInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cfconn
 
-	if d.HasChange("parameter_overrides") {
-		stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
+	if d.HasChanges("parameter_overrides", "operation_preferences") {
+		stackSetName, accountOrOrgID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
 
 		if err != nil {
 			return err
 		}
 
 		input := &cloudformation.UpdateStackInstancesInput{
-			Accounts:           aws.StringSlice([]string{accountID}),
 			OperationId:        aws.String(resource.UniqueId()),
 			ParameterOverrides: []*cloudformation.Parameter{},
 			Regions:            aws.StringSlice([]string{region}),
 			StackSetName:       aws.String(stackSetName),
 		}
 
+		if cloudFormationStackSetInstanceAccountIDRegexp.MatchString(accountOrOrgID) {
+			input.Accounts = aws.StringSlice([]string{accountOrOrgID})
+		} else {
+			input.DeploymentTargets = &cloudformation.DeploymentTargets{
+				OrganizationalUnitIds: aws.StringSlice(strings.Split(accountOrOrgID, "/")),
+			}
+		}
+
+		if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.OperationPreferences = expandCloudFormationOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v, ok := d.GetOk("parameter_overrides"); ok {
 			input.ParameterOverrides = expandCloudFormationParameters(v.(map[string]interface{}))
 		}
@@ -203,20 +330,31 @@ func resourceAwsCloudFormationStackSetInstanceUpdate(d *schema.ResourceData, met
 func resourceAwsCloudFormationStackSetInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cfconn
 
-	stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
+	stackSetName, accountOrOrgID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(d.Id())
 
 	if err != nil {
 		return err
 	}
 
 	input := &cloudformation.DeleteStackInstancesInput{
-		Accounts:     aws.StringSlice([]string{accountID}),
 		OperationId:  aws.String(resource.UniqueId()),
 		Regions:      aws.StringSlice([]string{region}),
 		RetainStacks: aws.Bool(d.Get("retain_stack").(bool)),
 		StackSetName: aws.String(stackSetName),
 	}
 
+	if cloudFormationStackSetInstanceAccountIDRegexp.MatchString(accountOrOrgID) {
+		input.Accounts = aws.StringSlice([]string{accountOrOrgID})
+	} else {
+		input.DeploymentTargets = &cloudformation.DeploymentTargets{
+			OrganizationalUnitIds: aws.StringSlice(strings.Split(accountOrOrgID, "/")),
+		}
+	}
+
+	if v, ok := d.GetOk("operation_preferences"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OperationPreferences = expandCloudFormationOperationPreferences(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	log.Printf("[DEBUG] Deleting CloudFormation StackSet Instance: %s", d.Id())
 	output, err := conn.DeleteStackInstances(input)
 
@@ -239,8 +377,10 @@ func resourceAwsCloudFormationStackSetInstanceDelete(d *schema.ResourceData, met
 	return nil
 }
 
+var cloudFormationStackSetInstanceAccountIDRegexp = regexp.MustCompile(`^\d{12}$`)
+
 func resourceAwsCloudFormationStackSetInstanceParseId(id string) (string, string, string, error) {
-	idFormatErr := fmt.Errorf("unexpected format of ID (%s), expected NAME,ACCOUNT,REGION", id)
+	idFormatErr := fmt.Errorf("unexpected format of ID (%s), expected NAME,ACCOUNT,REGION or NAME,OU_IDS,REGION", id)
 
 	parts := strings.SplitN(id, ",", 3)
 	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
@@ -275,6 +415,79 @@ func listCloudFormationStackSetInstances(conn *cloudformation.CloudFormation, st
 	return result, nil
 }
 
+func listCloudFormationStackSetInstancesByRegionAndOrgIDs(conn *cloudformation.CloudFormation, stackSetName, region string, orgIDs []string) ([]*cloudformation.StackInstanceSummary, error) {
+	input := &cloudformation.ListStackInstancesInput{
+		StackInstanceRegion: aws.String(region),
+		StackSetName:        aws.String(stackSetName),
+	}
+	result := make([]*cloudformation.StackInstanceSummary, 0)
+
+	for {
+		output, err := conn.ListStackInstances(input)
+
+		if err != nil {
+			return result, err
+		}
+
+		for _, summary := range output.Summaries {
+			if summary == nil {
+				continue
+			}
+
+			for _, orgID := range orgIDs {
+				if aws.StringValue(summary.OrganizationalUnitId) == orgID {
+					result = append(result, summary)
+					break
+				}
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return result, nil
+}
+
+func expandCloudFormationDeploymentTargets(tfMap map[string]interface{}) *cloudformation.DeploymentTargets {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudformation.DeploymentTargets{}
+
+	if v, ok := tfMap["organizational_unit_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.OrganizationalUnitIds = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenCloudFormationStackInstanceSummaries(apiObjects []*cloudformation.StackInstanceSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"account_id":             aws.StringValue(apiObject.Account),
+			"organizational_unit_id": aws.StringValue(apiObject.OrganizationalUnitId),
+			"stack_id":               aws.StringValue(apiObject.StackId),
+		})
+	}
+
+	return tfList
+}
+
 func refreshCloudformationStackSetOperation(conn *cloudformation.CloudFormation, stackSetName, operationID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &cloudformation.DescribeStackSetOperationInput{
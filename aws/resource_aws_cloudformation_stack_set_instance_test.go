@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
 )
 
 func init() {
@@ -116,7 +118,11 @@ func TestAccAWSCloudFormationStackSetInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "parameter_overrides.%", "0"),
 					resource.TestCheckResourceAttr(resourceName, "region", testAccGetRegion()),
 					resource.TestCheckResourceAttr(resourceName, "retain_stack", "false"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.#", "0"),
 					resource.TestCheckResourceAttrSet(resourceName, "stack_id"),
+					resource.TestCheckResourceAttr(resourceName, "stack_instance_summaries.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "stack_instance_summaries.0.stack_id", resourceName, "stack_id"),
 					resource.TestCheckResourceAttrPair(resourceName, "stack_set_name", cloudformationStackSetResourceName, "name"),
 				),
 			},
@@ -180,6 +186,85 @@ func TestAccAWSCloudFormationStackSetInstance_disappears_StackSet(t *testing.T)
 	})
 }
 
+func TestAccAWSCloudFormationStackSetInstance_DeploymentTargets(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	organizationResourceName := "data.aws_organizations_organization.test"
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSCloudFormationStackSet(t)
+			testAccOrganizationsEnabledPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigDeploymentTargets(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "account_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_targets.0.organizational_unit_ids.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttrPair(resourceName, "deployment_targets.0.organizational_unit_ids.*", organizationResourceName, "roots.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "region", testAccGetRegion()),
+					resource.TestCheckResourceAttr(resourceName, "stack_id", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"retain_stack",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationStackSetInstance_OperationPreferences(t *testing.T) {
+	var stackInstance1, stackInstance2 cloudformation.StackInstance
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFormationStackSet(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationStackSetInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigOperationPreferences(rName, 10, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetInstanceExists(resourceName, &stackInstance1),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.failure_tolerance_percentage", "10"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.max_concurrent_percentage", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"operation_preferences",
+					"retain_stack",
+				},
+			},
+			{
+				Config: testAccAWSCloudFormationStackSetInstanceConfigOperationPreferences(rName, 20, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationStackSetInstanceExists(resourceName, &stackInstance2),
+					testAccCheckCloudFormationStackSetInstanceNotRecreated(&stackInstance1, &stackInstance2),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.failure_tolerance_percentage", "20"),
+					resource.TestCheckResourceAttr(resourceName, "operation_preferences.0.max_concurrent_percentage", "50"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSCloudFormationStackSetInstance_ParameterOverrides(t *testing.T) {
 	var stackInstance1, stackInstance2, stackInstance3, stackInstance4 cloudformation.StackInstance
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -366,14 +451,32 @@ func testAccCheckAWSCloudFormationStackSetInstanceDestroy(s *terraform.State) er
 			continue
 		}
 
-		stackSetName, accountID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(rs.Primary.ID)
+		stackSetName, accountOrOrgID, region, err := resourceAwsCloudFormationStackSetInstanceParseId(rs.Primary.ID)
 
 		if err != nil {
 			return err
 		}
 
+		if !cloudFormationStackSetInstanceAccountIDRegexp.MatchString(accountOrOrgID) {
+			summaries, err := listCloudFormationStackSetInstancesByRegionAndOrgIDs(conn, stackSetName, region, strings.Split(accountOrOrgID, "/"))
+
+			if isAWSErr(err, cloudformation.ErrCodeStackSetNotFoundException, "") {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(summaries) > 0 {
+				return fmt.Errorf("CloudFormation StackSet Instance (%s) still exists", rs.Primary.ID)
+			}
+
+			continue
+		}
+
 		input := &cloudformation.DescribeStackInstanceInput{
-			StackInstanceAccount: aws.String(accountID),
+			StackInstanceAccount: aws.String(accountOrOrgID),
 			StackInstanceRegion:  aws.String(region),
 			StackSetName:         aws.String(stackSetName),
 		}
@@ -571,6 +674,56 @@ resource "aws_cloudformation_stack_set_instance" "test" {
 `
 }
 
+func testAccAWSCloudFormationStackSetInstanceConfigDeploymentTargets(rName string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "test" {}
+
+resource "aws_cloudformation_stack_set" "test" {
+  name             = %[1]q
+  permission_model = "SERVICE_MANAGED"
+
+  auto_deployment {
+    enabled                          = true
+    retain_stacks_on_account_removal = false
+  }
+
+  template_body = <<TEMPLATE
+Resources:
+  TestVpc:
+    Type: AWS::EC2::VPC
+    Properties:
+      CidrBlock: 10.0.0.0/16
+      Tags:
+        - Key: Name
+          Value: %[1]q
+TEMPLATE
+}
+
+resource "aws_cloudformation_stack_set_instance" "test" {
+  deployment_targets {
+    organizational_unit_ids = [data.aws_organizations_organization.test.roots[0].id]
+  }
+
+  stack_set_name = aws_cloudformation_stack_set.test.name
+}
+`, rName)
+}
+
+func testAccAWSCloudFormationStackSetInstanceConfigOperationPreferences(rName string, failureTolerancePercentage, maxConcurrentPercentage int) string {
+	return testAccAWSCloudFormationStackSetInstanceConfigBase(rName) + fmt.Sprintf(`
+resource "aws_cloudformation_stack_set_instance" "test" {
+  depends_on = [aws_iam_role_policy.Administration, aws_iam_role_policy.Execution]
+
+  operation_preferences {
+    failure_tolerance_percentage = %[1]d
+    max_concurrent_percentage    = %[2]d
+  }
+
+  stack_set_name = aws_cloudformation_stack_set.test.name
+}
+`, failureTolerancePercentage, maxConcurrentPercentage)
+}
+
 func testAccAWSCloudFormationStackSetInstanceConfigParameterOverrides1(rName, value1 string) string {
 	return testAccAWSCloudFormationStackSetInstanceConfigBase(rName) + fmt.Sprintf(`
 resource "aws_cloudformation_stack_set_instance" "test" {
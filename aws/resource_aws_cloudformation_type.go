@@ -0,0 +1,351 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfcloudformation "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/waiter"
+)
+
+func resourceAwsCloudFormationType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFormationTypeCreate,
+		Read:   resourceAwsCloudFormationTypeRead,
+		Update: resourceAwsCloudFormationTypeUpdate,
+		Delete: resourceAwsCloudFormationTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deprecated_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"documentation_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"is_default_version": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"logging_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_group_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateLogGroupName,
+						},
+						"log_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"provisioning_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema_handler_package": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 4096),
+					validation.StringMatch(regexp.MustCompile(`^s3://`), "must begin with s3://"),
+				),
+			},
+			"set_as_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"source_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cloudformation.RegistryTypeResource,
+				ValidateFunc: validation.StringInSlice(cloudformation.RegistryType_Values(), false),
+			},
+			"type_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(10, 204),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}$`), "three alphanumeric character sections separated by double colons (::)"),
+				),
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"visibility": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFormationTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+	typeName := d.Get("type_name").(string)
+
+	input := &cloudformation.RegisterTypeInput{
+		SchemaHandlerPackage: aws.String(d.Get("schema_handler_package").(string)),
+		Type:                 aws.String(d.Get("type").(string)),
+		TypeName:             aws.String(typeName),
+	}
+
+	if v, ok := d.GetOk("execution_role_arn"); ok {
+		input.ExecutionRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("logging_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LoggingConfig = expandCloudFormationTypeLoggingConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Registering CloudFormation Type: %s", input)
+	output, err := conn.RegisterType(input)
+
+	if err != nil {
+		return fmt.Errorf("error registering CloudFormation Type (%s): %w", typeName, err)
+	}
+
+	if output == nil || output.RegistrationToken == nil {
+		return fmt.Errorf("error registering CloudFormation Type (%s): empty result", typeName)
+	}
+
+	registrationOutput, err := waiter.TypeRegistrationProgressStatusComplete(conn, aws.StringValue(output.RegistrationToken))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for CloudFormation Type (%s) registration: %w", typeName, err)
+	}
+
+	// Type Version ARN is not available until after registration is complete
+	d.SetId(aws.StringValue(registrationOutput.TypeVersionArn))
+
+	if d.Get("set_as_default").(bool) {
+		if err := resourceAwsCloudFormationTypeSetDefaultVersion(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsCloudFormationTypeRead(d, meta)
+}
+
+func resourceAwsCloudFormationTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	typeARN, versionID, err := tfcloudformation.TypeVersionARNToTypeARNAndVersionID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing CloudFormation Type (%s) ARN: %w", d.Id(), err)
+	}
+
+	output, err := finder.TypeByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeTypeNotFoundException) {
+		log.Printf("[WARN] CloudFormation Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFormation Type (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading CloudFormation Type (%s): empty response", d.Id())
+	}
+
+	if !d.IsNewResource() && aws.StringValue(output.DeprecatedStatus) == cloudformation.DeprecatedStatusDeprecated {
+		log.Printf("[WARN] CloudFormation Type (%s) deprecated, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("default_version_id", output.DefaultVersionId)
+	d.Set("deprecated_status", output.DeprecatedStatus)
+	d.Set("description", output.Description)
+	d.Set("documentation_url", output.DocumentationUrl)
+	d.Set("execution_role_arn", output.ExecutionRoleArn)
+	d.Set("is_default_version", output.IsDefaultVersion)
+
+	if output.LoggingConfig != nil {
+		if err := d.Set("logging_config", []interface{}{flattenCloudFormationTypeLoggingConfig(output.LoggingConfig)}); err != nil {
+			return fmt.Errorf("error setting logging_config: %w", err)
+		}
+	} else {
+		d.Set("logging_config", nil)
+	}
+
+	d.Set("provisioning_type", output.ProvisioningType)
+	d.Set("schema", output.Schema)
+	d.Set("source_url", output.SourceUrl)
+	d.Set("type", output.Type)
+	d.Set("type_arn", typeARN)
+	d.Set("type_name", output.TypeName)
+	d.Set("version_id", versionID)
+	d.Set("visibility", output.Visibility)
+
+	return nil
+}
+
+func resourceAwsCloudFormationTypeUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	// The default version can only be moved to another version, never unset
+	if d.HasChange("set_as_default") && d.Get("set_as_default").(bool) {
+		if err := resourceAwsCloudFormationTypeSetDefaultVersion(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsCloudFormationTypeRead(d, meta)
+}
+
+func resourceAwsCloudFormationTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cfconn
+
+	typeARN, _, err := tfcloudformation.TypeVersionARNToTypeARNAndVersionID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing CloudFormation Type (%s) ARN: %w", d.Id(), err)
+	}
+
+	input := &cloudformation.DeregisterTypeInput{
+		Arn: aws.String(d.Id()),
+	}
+
+	// The default version cannot be deregistered while other versions remain,
+	// and the last remaining version can only be removed by deregistering the type.
+	versions, err := finder.TypeVersionsByTypeARN(conn, typeARN)
+
+	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeTypeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing CloudFormation Type (%s) versions: %w", typeARN, err)
+	}
+
+	if len(versions) <= 1 {
+		input.Arn = aws.String(typeARN)
+	}
+
+	log.Printf("[DEBUG] Deregistering CloudFormation Type: %s", input)
+	_, err = conn.DeregisterType(input)
+
+	if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeTypeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering CloudFormation Type (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudFormationTypeSetDefaultVersion(conn *cloudformation.CloudFormation, typeVersionARN string) error {
+	input := &cloudformation.SetTypeDefaultVersionInput{
+		Arn: aws.String(typeVersionARN),
+	}
+
+	log.Printf("[DEBUG] Setting CloudFormation Type default version: %s", input)
+	_, err := conn.SetTypeDefaultVersion(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting CloudFormation Type (%s) as default version: %w", typeVersionARN, err)
+	}
+
+	return nil
+}
+
+func expandCloudFormationTypeLoggingConfig(tfMap map[string]interface{}) *cloudformation.LoggingConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudformation.LoggingConfig{}
+
+	if v, ok := tfMap["log_group_name"].(string); ok && v != "" {
+		apiObject.LogGroupName = aws.String(v)
+	}
+
+	if v, ok := tfMap["log_role_arn"].(string); ok && v != "" {
+		apiObject.LogRoleArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenCloudFormationTypeLoggingConfig(apiObject *cloudformation.LoggingConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LogGroupName; v != nil {
+		tfMap["log_group_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.LogRoleArn; v != nil {
+		tfMap["log_role_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/finder"
+)
+
+func TestAccAWSCloudFormationType_basic(t *testing.T) {
+	var typeVersion cloudformation.DescribeTypeOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	schemaHandlerPackage, typeName := testAccAWSCloudFormationTypeFromEnv(t)
+	resourceName := "aws_cloudformation_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationTypeConfigTypeName(rName, schemaHandlerPackage, typeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationTypeExists(resourceName, &typeVersion),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "cloudformation", regexp.MustCompile(`type/resource/.+/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", "00000001"),
+					resource.TestCheckResourceAttr(resourceName, "deprecated_status", cloudformation.DeprecatedStatusLive),
+					resource.TestCheckResourceAttr(resourceName, "execution_role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "is_default_version", "true"),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "provisioning_type"),
+					resource.TestCheckResourceAttrSet(resourceName, "schema"),
+					resource.TestCheckResourceAttr(resourceName, "set_as_default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "type", cloudformation.RegistryTypeResource),
+					testAccMatchResourceAttrRegionalARN(resourceName, "type_arn", "cloudformation", regexp.MustCompile(`type/resource/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "type_name", typeName),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "00000001"),
+					resource.TestCheckResourceAttr(resourceName, "visibility", cloudformation.VisibilityPrivate),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"schema_handler_package",
+					"set_as_default",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationType_disappears(t *testing.T) {
+	var typeVersion cloudformation.DescribeTypeOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	schemaHandlerPackage, typeName := testAccAWSCloudFormationTypeFromEnv(t)
+	resourceName := "aws_cloudformation_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationTypeConfigTypeName(rName, schemaHandlerPackage, typeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationTypeExists(resourceName, &typeVersion),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudFormationType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFormationType_LoggingConfig(t *testing.T) {
+	var typeVersion cloudformation.DescribeTypeOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	schemaHandlerPackage, typeName := testAccAWSCloudFormationTypeFromEnv(t)
+	cloudwatchLogGroupResourceName := "aws_cloudwatch_log_group.test"
+	iamRoleResourceName := "aws_iam_role.test"
+	resourceName := "aws_cloudformation_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudFormationTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFormationTypeConfigLoggingConfig(rName, schemaHandlerPackage, typeName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFormationTypeExists(resourceName, &typeVersion),
+					resource.TestCheckResourceAttr(resourceName, "logging_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_config.0.log_group_name", cloudwatchLogGroupResourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "logging_config.0.log_role_arn", iamRoleResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"schema_handler_package",
+					"set_as_default",
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudFormationTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudformation_type" {
+			continue
+		}
+
+		output, err := finder.TypeByARN(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, cloudformation.ErrCodeTypeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && aws.StringValue(output.DeprecatedStatus) != cloudformation.DeprecatedStatusDeprecated {
+			return fmt.Errorf("CloudFormation Type (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudFormationTypeExists(resourceName string, typeVersion *cloudformation.DescribeTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFormation Type ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+		output, err := finder.TypeByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("CloudFormation Type (%s) not found", rs.Primary.ID)
+		}
+
+		*typeVersion = *output
+
+		return nil
+	}
+}
+
+// testAccAWSCloudFormationTypeFromEnv returns the local path of a schema handler
+// package built with the CloudFormation CLI and the type name it was built for.
+func testAccAWSCloudFormationTypeFromEnv(t *testing.T) (string, string) {
+	schemaHandlerPackage := os.Getenv("CLOUDFORMATION_TYPE_SCHEMA_HANDLER_PACKAGE")
+	typeName := os.Getenv("CLOUDFORMATION_TYPE_NAME")
+
+	if schemaHandlerPackage == "" || typeName == "" {
+		t.Skip(
+			"Environment variables CLOUDFORMATION_TYPE_SCHEMA_HANDLER_PACKAGE and CLOUDFORMATION_TYPE_NAME must be set. " +
+				"CLOUDFORMATION_TYPE_SCHEMA_HANDLER_PACKAGE is the local path of a resource type schema handler package, " +
+				"e.g. generated with cfn submit --dry-run, and CLOUDFORMATION_TYPE_NAME is its type name.")
+	}
+
+	return schemaHandlerPackage, typeName
+}
+
+func testAccAWSCloudFormationTypeConfigBase(rName, schemaHandlerPackage string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test.zip"
+  source = %[2]q
+}
+`, rName, schemaHandlerPackage)
+}
+
+func testAccAWSCloudFormationTypeConfigTypeName(rName, schemaHandlerPackage, typeName string) string {
+	return composeConfig(
+		testAccAWSCloudFormationTypeConfigBase(rName, schemaHandlerPackage),
+		fmt.Sprintf(`
+resource "aws_cloudformation_type" "test" {
+  schema_handler_package = "s3://${aws_s3_bucket_object.test.bucket}/${aws_s3_bucket_object.test.key}"
+  type                   = "RESOURCE"
+  type_name              = %[1]q
+}
+`, typeName))
+}
+
+func testAccAWSCloudFormationTypeConfigLoggingConfig(rName, schemaHandlerPackage, typeName string) string {
+	return composeConfig(
+		testAccAWSCloudFormationTypeConfigBase(rName, schemaHandlerPackage),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "cloudformation.${data.aws_partition.current.dns_suffix}",
+          "resources.cloudformation.${data.aws_partition.current.dns_suffix}"
+        ]
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_cloudformation_type" "test" {
+  schema_handler_package = "s3://${aws_s3_bucket_object.test.bucket}/${aws_s3_bucket_object.test.key}"
+  type                   = "RESOURCE"
+  type_name              = %[2]q
+
+  logging_config {
+    log_group_name = aws_cloudwatch_log_group.test.name
+    log_role_arn   = aws_iam_role.test.arn
+  }
+}
+`, rName, typeName))
+}
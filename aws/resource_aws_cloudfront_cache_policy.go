@@ -0,0 +1,325 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+)
+
+func resourceAwsCloudFrontCachePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontCachePolicyCreate,
+		Read:   resourceAwsCloudFrontCachePolicyRead,
+		Update: resourceAwsCloudFrontCachePolicyUpdate,
+		Delete: resourceAwsCloudFrontCachePolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      86400,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"max_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      31536000,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"parameters_in_cache_key_and_forwarded_to_origin": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cookies_config": cloudFrontPolicyItemsConfigSchema("cookie_behavior", "cookies", cloudfront.CachePolicyCookieBehavior_Values()),
+						"enable_accept_encoding_gzip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"headers_config":       cloudFrontPolicyItemsConfigSchema("header_behavior", "headers", cloudfront.CachePolicyHeaderBehavior_Values()),
+						"query_strings_config": cloudFrontPolicyItemsConfigSchema("query_string_behavior", "query_strings", cloudfront.CachePolicyQueryStringBehavior_Values()),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFrontCachePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateCachePolicyInput{
+		CachePolicyConfig: expandCloudFrontCachePolicyConfig(d),
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Cache Policy: %s", input)
+	output, err := conn.CreateCachePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Cache Policy (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CachePolicy.Id))
+
+	return resourceAwsCloudFrontCachePolicyRead(d, meta)
+}
+
+func resourceAwsCloudFrontCachePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	output, err := finder.CachePolicyByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchCachePolicy) {
+		log.Printf("[WARN] CloudFront Cache Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Cache Policy (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading CloudFront Cache Policy (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] CloudFront Cache Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("etag", output.ETag)
+
+	return flattenCloudFrontCachePolicyConfig(d, output.CachePolicy.CachePolicyConfig)
+}
+
+func resourceAwsCloudFrontCachePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.UpdateCachePolicyInput{
+		CachePolicyConfig: expandCloudFrontCachePolicyConfig(d),
+		Id:                aws.String(d.Id()),
+		IfMatch:           aws.String(d.Get("etag").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Cache Policy: %s", input)
+	_, err := conn.UpdateCachePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Cache Policy (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudFrontCachePolicyRead(d, meta)
+}
+
+func resourceAwsCloudFrontCachePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	log.Printf("[DEBUG] Deleting CloudFront Cache Policy (%s)", d.Id())
+	_, err := conn.DeleteCachePolicy(&cloudfront.DeleteCachePolicyInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchCachePolicy) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Cache Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// cloudFrontPolicyItemsConfigSchema returns the schema shared by the cookies, headers and query strings
+// configurations of cache policies and origin request policies.
+func cloudFrontPolicyItemsConfigSchema(behaviorKey, itemsKey string, behaviors []string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				behaviorKey: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(behaviors, false),
+				},
+				itemsKey: {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"items": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandCloudFrontCachePolicyConfig(d *schema.ResourceData) *cloudfront.CachePolicyConfig {
+	cachePolicyConfig := &cloudfront.CachePolicyConfig{
+		DefaultTTL: aws.Int64(int64(d.Get("default_ttl").(int))),
+		MaxTTL:     aws.Int64(int64(d.Get("max_ttl").(int))),
+		MinTTL:     aws.Int64(int64(d.Get("min_ttl").(int))),
+		Name:       aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		cachePolicyConfig.Comment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters_in_cache_key_and_forwarded_to_origin"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		m := v.([]interface{})[0].(map[string]interface{})
+
+		parameters := &cloudfront.ParametersInCacheKeyAndForwardedToOrigin{
+			EnableAcceptEncodingGzip: aws.Bool(m["enable_accept_encoding_gzip"].(bool)),
+		}
+
+		if behavior, items := expandCloudFrontPolicyItemsConfig(m["cookies_config"], "cookie_behavior", "cookies"); behavior != nil {
+			parameters.CookiesConfig = &cloudfront.CachePolicyCookiesConfig{
+				CookieBehavior: behavior,
+				Cookies:        &cloudfront.CookieNames{Items: items, Quantity: aws.Int64(int64(len(items)))},
+			}
+		}
+
+		if behavior, items := expandCloudFrontPolicyItemsConfig(m["headers_config"], "header_behavior", "headers"); behavior != nil {
+			parameters.HeadersConfig = &cloudfront.CachePolicyHeadersConfig{
+				HeaderBehavior: behavior,
+				Headers:        &cloudfront.Headers{Items: items, Quantity: aws.Int64(int64(len(items)))},
+			}
+		}
+
+		if behavior, items := expandCloudFrontPolicyItemsConfig(m["query_strings_config"], "query_string_behavior", "query_strings"); behavior != nil {
+			parameters.QueryStringsConfig = &cloudfront.CachePolicyQueryStringsConfig{
+				QueryStringBehavior: behavior,
+				QueryStrings:        &cloudfront.QueryStringNames{Items: items, Quantity: aws.Int64(int64(len(items)))},
+			}
+		}
+
+		cachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin = parameters
+	}
+
+	return cachePolicyConfig
+}
+
+// expandCloudFrontPolicyItemsConfig returns the behavior and items of a cookies, headers or query strings configuration.
+func expandCloudFrontPolicyItemsConfig(v interface{}, behaviorKey, itemsKey string) (*string, []*string) {
+	l, ok := v.([]interface{})
+
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	var items []*string
+
+	if vItems, ok := m[itemsKey].([]interface{}); ok && len(vItems) > 0 && vItems[0] != nil {
+		items = expandStringSet(vItems[0].(map[string]interface{})["items"].(*schema.Set))
+	}
+
+	return aws.String(m[behaviorKey].(string)), items
+}
+
+func flattenCloudFrontCachePolicyConfig(d *schema.ResourceData, cachePolicyConfig *cloudfront.CachePolicyConfig) error {
+	d.Set("comment", cachePolicyConfig.Comment)
+	d.Set("default_ttl", cachePolicyConfig.DefaultTTL)
+	d.Set("max_ttl", cachePolicyConfig.MaxTTL)
+	d.Set("min_ttl", cachePolicyConfig.MinTTL)
+	d.Set("name", cachePolicyConfig.Name)
+
+	var parameters []interface{}
+
+	if v := cachePolicyConfig.ParametersInCacheKeyAndForwardedToOrigin; v != nil {
+		m := map[string]interface{}{
+			"enable_accept_encoding_gzip": aws.BoolValue(v.EnableAcceptEncodingGzip),
+		}
+
+		if v := v.CookiesConfig; v != nil {
+			var items []*string
+			if v.Cookies != nil {
+				items = v.Cookies.Items
+			}
+			m["cookies_config"] = flattenCloudFrontPolicyItemsConfig(v.CookieBehavior, items, "cookie_behavior", "cookies")
+		}
+
+		if v := v.HeadersConfig; v != nil {
+			var items []*string
+			if v.Headers != nil {
+				items = v.Headers.Items
+			}
+			m["headers_config"] = flattenCloudFrontPolicyItemsConfig(v.HeaderBehavior, items, "header_behavior", "headers")
+		}
+
+		if v := v.QueryStringsConfig; v != nil {
+			var items []*string
+			if v.QueryStrings != nil {
+				items = v.QueryStrings.Items
+			}
+			m["query_strings_config"] = flattenCloudFrontPolicyItemsConfig(v.QueryStringBehavior, items, "query_string_behavior", "query_strings")
+		}
+
+		parameters = []interface{}{m}
+	}
+
+	if err := d.Set("parameters_in_cache_key_and_forwarded_to_origin", parameters); err != nil {
+		return fmt.Errorf("error setting parameters_in_cache_key_and_forwarded_to_origin: %w", err)
+	}
+
+	return nil
+}
+
+func flattenCloudFrontPolicyItemsConfig(behavior *string, items []*string, behaviorKey, itemsKey string) []interface{} {
+	m := map[string]interface{}{
+		behaviorKey: aws.StringValue(behavior),
+	}
+
+	if len(items) > 0 {
+		m[itemsKey] = []interface{}{map[string]interface{}{
+			"items": flattenStringSet(items),
+		}}
+	}
+
+	return []interface{}{m}
+}
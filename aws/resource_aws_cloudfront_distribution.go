@@ -1,8 +1,10 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -32,6 +34,8 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 		MigrateState:  resourceAwsCloudFrontDistributionMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: resourceAwsCloudFrontDistributionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -58,15 +62,20 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Required: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"cache_policy_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"compress": {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Default:  false,
 						},
 						"default_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  86400,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          86400,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
 						},
 						"field_level_encryption_id": {
 							Type:     schema.TypeString,
@@ -74,7 +83,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 						},
 						"forwarded_values": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -142,19 +151,30 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Set: lambdaFunctionAssociationHash,
 						},
 						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  31536000,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          31536000,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
 						},
 						"min_ttl": {
-							Type:     schema.TypeInt,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          0,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
+						},
+						"origin_request_policy_id": {
+							Type:     schema.TypeString,
 							Optional: true,
-							Default:  0,
 						},
 						"path_pattern": {
 							Type:     schema.TypeString,
 							Required: true,
 						},
+						"realtime_log_config_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
 						"smooth_streaming": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -220,15 +240,20 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Required: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"cache_policy_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 						"compress": {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Default:  false,
 						},
 						"default_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  86400,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          86400,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
 						},
 						"field_level_encryption_id": {
 							Type:     schema.TypeString,
@@ -236,7 +261,7 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 						},
 						"forwarded_values": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -304,14 +329,25 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 							Set: lambdaFunctionAssociationHash,
 						},
 						"max_ttl": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Default:  31536000,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          31536000,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
 						},
 						"min_ttl": {
-							Type:     schema.TypeInt,
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          0,
+							DiffSuppressFunc: suppressCloudFrontCacheBehaviorTTLWithCachePolicy,
+						},
+						"origin_request_policy_id": {
+							Type:     schema.TypeString,
 							Optional: true,
-							Default:  0,
+						},
+						"realtime_log_config_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
 						},
 						"smooth_streaming": {
 							Type:     schema.TypeBool,
@@ -1008,3 +1044,44 @@ func resourceAwsCloudFrontWebDistributionStateRefreshFunc(id string, meta interf
 		return resp.Distribution, *resp.Distribution.Status, nil
 	}
 }
+
+// resourceAwsCloudFrontDistributionCustomizeDiff ensures every cache behavior references
+// a cache policy or configures the legacy forwarded values, as the API requires one of them.
+func resourceAwsCloudFrontDistributionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if err := validateCloudFrontCacheBehaviorCachePolicyOrForwardedValues(diff, "default_cache_behavior"); err != nil {
+		return err
+	}
+
+	return validateCloudFrontCacheBehaviorCachePolicyOrForwardedValues(diff, "ordered_cache_behavior")
+}
+
+func validateCloudFrontCacheBehaviorCachePolicyOrForwardedValues(diff *schema.ResourceDiff, key string) error {
+	for i := range diff.Get(key).([]interface{}) {
+		prefix := fmt.Sprintf("%s.%d", key, i)
+
+		// Values may be unknown until apply, e.g. when referencing an aws_cloudfront_cache_policy
+		if !diff.NewValueKnown(prefix+".cache_policy_id") || !diff.NewValueKnown(prefix+".forwarded_values") {
+			continue
+		}
+
+		if diff.Get(prefix+".cache_policy_id").(string) != "" {
+			continue
+		}
+
+		if len(diff.Get(prefix+".forwarded_values").([]interface{})) > 0 {
+			continue
+		}
+
+		return fmt.Errorf("%s: one of cache_policy_id or forwarded_values must be configured", prefix)
+	}
+
+	return nil
+}
+
+// suppressCloudFrontCacheBehaviorTTLWithCachePolicy suppresses differences in the legacy TTL attributes
+// of a cache behavior that references a cache policy, as the TTLs are then defined by the cache policy.
+func suppressCloudFrontCacheBehaviorTTLWithCachePolicy(k, old, new string, d *schema.ResourceData) bool {
+	prefix := k[:strings.LastIndex(k, ".")]
+
+	return d.Get(prefix+".cache_policy_id").(string) != ""
+}
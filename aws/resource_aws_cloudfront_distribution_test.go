@@ -315,6 +315,20 @@ func TestAccAWSCloudFrontDistribution_Origin_EmptyOriginID(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudFrontDistribution_DefaultCacheBehavior_NoCachePolicyOrForwardedValues(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudFrontDistributionConfigDefaultCacheBehaviorNoCachePolicyOrForwardedValues(),
+				ExpectError: regexp.MustCompile(`default_cache_behavior.0: one of cache_policy_id or forwarded_values must be configured`),
+			},
+		},
+	})
+}
+
 // TestAccAWSCloudFrontDistribution_noOptionalItemsConfig runs an
 // aws_cloudfront_distribution acceptance test with no optional items set.
 //
@@ -865,6 +879,51 @@ func TestAccAWSCloudFrontDistribution_WaitForDeployment(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudFrontDistribution_DefaultCacheBehavior_CachePolicy(t *testing.T) {
+	var distribution cloudfront.Distribution
+	resourceName := "aws_cloudfront_distribution.test"
+	cachePolicyResourceName := "aws_cloudfront_cache_policy.test"
+	originRequestPolicyResourceName := "aws_cloudfront_origin_request_policy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontDistributionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontDistributionConfigEnabled(false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExists(resourceName, &distribution),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.cache_policy_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSCloudFrontDistributionConfigDefaultCacheBehaviorCachePolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontDistributionExists(resourceName, &distribution),
+					resource.TestCheckResourceAttrPair(resourceName, "default_cache_behavior.0.cache_policy_id", cachePolicyResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "default_cache_behavior.0.forwarded_values.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_cache_behavior.0.origin_request_policy_id", originRequestPolicyResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"default_cache_behavior.0.default_ttl",
+					"default_cache_behavior.0.max_ttl",
+					"default_cache_behavior.0.min_ttl",
+					"retain_on_delete",
+					"wait_for_deployment",
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckCloudFrontDistributionDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
 
@@ -2240,6 +2299,87 @@ resource "aws_cloudfront_distribution" "test" {
 `, enabled, retainOnDelete)
 }
 
+func testAccAWSCloudFrontDistributionConfigDefaultCacheBehaviorCachePolicy(rName string) string {
+	return composeConfig(
+		testAccAWSCloudFrontCachePolicyConfig(rName),
+		testAccAWSCloudFrontOriginRequestPolicyConfig(rName),
+		`
+resource "aws_cloudfront_distribution" "test" {
+  enabled          = false
+  retain_on_delete = false
+
+  default_cache_behavior {
+    allowed_methods          = ["GET", "HEAD"]
+    cached_methods           = ["GET", "HEAD"]
+    cache_policy_id          = aws_cloudfront_cache_policy.test.id
+    origin_request_policy_id = aws_cloudfront_origin_request_policy.test.id
+    target_origin_id         = "test"
+    viewer_protocol_policy   = "allow-all"
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`)
+}
+
+func testAccAWSCloudFrontDistributionConfigDefaultCacheBehaviorNoCachePolicyOrForwardedValues() string {
+	return `
+resource "aws_cloudfront_distribution" "test" {
+  enabled          = false
+  retain_on_delete = false
+
+  default_cache_behavior {
+    allowed_methods        = ["GET", "HEAD"]
+    cached_methods         = ["GET", "HEAD"]
+    target_origin_id       = "test"
+    viewer_protocol_policy = "allow-all"
+  }
+
+  origin {
+    domain_name = "www.example.com"
+    origin_id   = "test"
+
+    custom_origin_config {
+      http_port              = 80
+      https_port             = 443
+      origin_protocol_policy = "https-only"
+      origin_ssl_protocols   = ["TLSv1.2"]
+    }
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+}
+`
+}
+
 func testAccAWSCloudFrontDistributionConfigOrderedCacheBehaviorForwardedValuesCookiesWhitelistedNamesUnordered2(retainOnDelete bool) string {
 	return fmt.Sprintf(`
 resource "aws_cloudfront_distribution" "test" {
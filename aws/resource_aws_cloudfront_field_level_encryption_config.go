@@ -0,0 +1,362 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+)
+
+func resourceAwsCloudFrontFieldLevelEncryptionConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontFieldLevelEncryptionConfigCreate,
+		Read:   resourceAwsCloudFrontFieldLevelEncryptionConfigRead,
+		Update: resourceAwsCloudFrontFieldLevelEncryptionConfigUpdate,
+		Delete: resourceAwsCloudFrontFieldLevelEncryptionConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"caller_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content_type_profile_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_type_profiles": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"items": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"content_type": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"format": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(cloudfront.Format_Values(), false),
+												},
+												"profile_id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"forward_when_content_type_is_unknown": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_arg_profile_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"forward_when_query_arg_profile_is_unknown": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"query_arg_profiles": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"items": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"profile_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"query_arg": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	encryptionConfig := expandCloudFrontFieldLevelEncryptionConfig(d)
+	encryptionConfig.CallerReference = aws.String(resource.UniqueId())
+
+	input := &cloudfront.CreateFieldLevelEncryptionConfigInput{
+		FieldLevelEncryptionConfig: encryptionConfig,
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Field-level Encryption Config: %s", input)
+	output, err := conn.CreateFieldLevelEncryptionConfig(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Field-level Encryption Config: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.FieldLevelEncryption.Id))
+
+	return resourceAwsCloudFrontFieldLevelEncryptionConfigRead(d, meta)
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionConfigRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	output, err := finder.FieldLevelEncryptionConfigByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchFieldLevelEncryptionConfig) {
+		log.Printf("[WARN] CloudFront Field-level Encryption Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Field-level Encryption Config (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading CloudFront Field-level Encryption Config (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] CloudFront Field-level Encryption Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	encryptionConfig := output.FieldLevelEncryptionConfig
+
+	d.Set("caller_reference", encryptionConfig.CallerReference)
+	d.Set("comment", encryptionConfig.Comment)
+	if err := d.Set("content_type_profile_config", flattenCloudFrontContentTypeProfileConfig(encryptionConfig.ContentTypeProfileConfig)); err != nil {
+		return fmt.Errorf("error setting content_type_profile_config: %w", err)
+	}
+	d.Set("etag", output.ETag)
+	if err := d.Set("query_arg_profile_config", flattenCloudFrontQueryArgProfileConfig(encryptionConfig.QueryArgProfileConfig)); err != nil {
+		return fmt.Errorf("error setting query_arg_profile_config: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	encryptionConfig := expandCloudFrontFieldLevelEncryptionConfig(d)
+	encryptionConfig.CallerReference = aws.String(d.Get("caller_reference").(string))
+
+	input := &cloudfront.UpdateFieldLevelEncryptionConfigInput{
+		FieldLevelEncryptionConfig: encryptionConfig,
+		Id:                         aws.String(d.Id()),
+		IfMatch:                    aws.String(d.Get("etag").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Field-level Encryption Config: %s", input)
+	_, err := conn.UpdateFieldLevelEncryptionConfig(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Field-level Encryption Config (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudFrontFieldLevelEncryptionConfigRead(d, meta)
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	log.Printf("[DEBUG] Deleting CloudFront Field-level Encryption Config (%s)", d.Id())
+	_, err := conn.DeleteFieldLevelEncryptionConfig(&cloudfront.DeleteFieldLevelEncryptionConfigInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchFieldLevelEncryptionConfig) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Field-level Encryption Config (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudFrontFieldLevelEncryptionConfig(d *schema.ResourceData) *cloudfront.FieldLevelEncryptionConfig {
+	encryptionConfig := &cloudfront.FieldLevelEncryptionConfig{}
+
+	if v, ok := d.GetOk("comment"); ok {
+		encryptionConfig.Comment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("content_type_profile_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		m := v.([]interface{})[0].(map[string]interface{})
+
+		contentTypeProfileConfig := &cloudfront.ContentTypeProfileConfig{
+			ContentTypeProfiles:             &cloudfront.ContentTypeProfiles{Quantity: aws.Int64(0)},
+			ForwardWhenContentTypeIsUnknown: aws.Bool(m["forward_when_content_type_is_unknown"].(bool)),
+		}
+
+		if vProfiles, ok := m["content_type_profiles"].([]interface{}); ok && len(vProfiles) > 0 && vProfiles[0] != nil {
+			for _, vItem := range vProfiles[0].(map[string]interface{})["items"].(*schema.Set).List() {
+				mItem, ok := vItem.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				contentTypeProfile := &cloudfront.ContentTypeProfile{
+					ContentType: aws.String(mItem["content_type"].(string)),
+					Format:      aws.String(mItem["format"].(string)),
+				}
+
+				if v, ok := mItem["profile_id"].(string); ok && v != "" {
+					contentTypeProfile.ProfileId = aws.String(v)
+				}
+
+				contentTypeProfileConfig.ContentTypeProfiles.Items = append(contentTypeProfileConfig.ContentTypeProfiles.Items, contentTypeProfile)
+			}
+
+			contentTypeProfileConfig.ContentTypeProfiles.Quantity = aws.Int64(int64(len(contentTypeProfileConfig.ContentTypeProfiles.Items)))
+		}
+
+		encryptionConfig.ContentTypeProfileConfig = contentTypeProfileConfig
+	}
+
+	if v, ok := d.GetOk("query_arg_profile_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		m := v.([]interface{})[0].(map[string]interface{})
+
+		queryArgProfileConfig := &cloudfront.QueryArgProfileConfig{
+			ForwardWhenQueryArgProfileIsUnknown: aws.Bool(m["forward_when_query_arg_profile_is_unknown"].(bool)),
+			QueryArgProfiles:                    &cloudfront.QueryArgProfiles{Quantity: aws.Int64(0)},
+		}
+
+		if vProfiles, ok := m["query_arg_profiles"].([]interface{}); ok && len(vProfiles) > 0 && vProfiles[0] != nil {
+			for _, vItem := range vProfiles[0].(map[string]interface{})["items"].(*schema.Set).List() {
+				mItem, ok := vItem.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				queryArgProfileConfig.QueryArgProfiles.Items = append(queryArgProfileConfig.QueryArgProfiles.Items, &cloudfront.QueryArgProfile{
+					ProfileId: aws.String(mItem["profile_id"].(string)),
+					QueryArg:  aws.String(mItem["query_arg"].(string)),
+				})
+			}
+
+			queryArgProfileConfig.QueryArgProfiles.Quantity = aws.Int64(int64(len(queryArgProfileConfig.QueryArgProfiles.Items)))
+		}
+
+		encryptionConfig.QueryArgProfileConfig = queryArgProfileConfig
+	}
+
+	return encryptionConfig
+}
+
+func flattenCloudFrontContentTypeProfileConfig(contentTypeProfileConfig *cloudfront.ContentTypeProfileConfig) []interface{} {
+	if contentTypeProfileConfig == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"forward_when_content_type_is_unknown": aws.BoolValue(contentTypeProfileConfig.ForwardWhenContentTypeIsUnknown),
+	}
+
+	if contentTypeProfiles := contentTypeProfileConfig.ContentTypeProfiles; contentTypeProfiles != nil {
+		vItems := []interface{}{}
+
+		for _, contentTypeProfile := range contentTypeProfiles.Items {
+			if contentTypeProfile == nil {
+				continue
+			}
+
+			vItems = append(vItems, map[string]interface{}{
+				"content_type": aws.StringValue(contentTypeProfile.ContentType),
+				"format":       aws.StringValue(contentTypeProfile.Format),
+				"profile_id":   aws.StringValue(contentTypeProfile.ProfileId),
+			})
+		}
+
+		m["content_type_profiles"] = []interface{}{map[string]interface{}{
+			"items": vItems,
+		}}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenCloudFrontQueryArgProfileConfig(queryArgProfileConfig *cloudfront.QueryArgProfileConfig) []interface{} {
+	if queryArgProfileConfig == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"forward_when_query_arg_profile_is_unknown": aws.BoolValue(queryArgProfileConfig.ForwardWhenQueryArgProfileIsUnknown),
+	}
+
+	if queryArgProfiles := queryArgProfileConfig.QueryArgProfiles; queryArgProfiles != nil && len(queryArgProfiles.Items) > 0 {
+		vItems := []interface{}{}
+
+		for _, queryArgProfile := range queryArgProfiles.Items {
+			if queryArgProfile == nil {
+				continue
+			}
+
+			vItems = append(vItems, map[string]interface{}{
+				"profile_id": aws.StringValue(queryArgProfile.ProfileId),
+				"query_arg":  aws.StringValue(queryArgProfile.QueryArg),
+			})
+		}
+
+		m["query_arg_profiles"] = []interface{}{map[string]interface{}{
+			"items": vItems,
+		}}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
+)
+
+func TestAccAWSCloudFrontFieldLevelEncryptionConfig_basic(t *testing.T) {
+	var config cloudfront.GetFieldLevelEncryptionConfigOutput
+	resourceName := "aws_cloudfront_field_level_encryption_config.test"
+	profileResourceName := "aws_cloudfront_field_level_encryption_profile.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontFieldLevelEncryptionConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontFieldLevelEncryptionConfigConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudFrontFieldLevelEncryptionConfigExists(resourceName, &config),
+					resource.TestCheckResourceAttrSet(resourceName, "caller_reference"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "content_type_profile_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content_type_profile_config.0.forward_when_content_type_is_unknown", "true"),
+					resource.TestCheckResourceAttr(resourceName, "content_type_profile_config.0.content_type_profiles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "content_type_profile_config.0.content_type_profiles.0.items.#", "1"),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "content_type_profile_config.0.content_type_profiles.0.items.*", map[string]string{
+						"content_type": "application/x-www-form-urlencoded",
+						"format":       "URLEncoded",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "query_arg_profile_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "query_arg_profile_config.0.forward_when_query_arg_profile_is_unknown", "true"),
+					resource.TestCheckResourceAttr(resourceName, "query_arg_profile_config.0.query_arg_profiles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "query_arg_profile_config.0.query_arg_profiles.0.items.#", "1"),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "query_arg_profile_config.0.query_arg_profiles.0.items.*", map[string]string{
+						"query_arg": "Arg1",
+					}),
+					tfawsresource.TestCheckTypeSetElemAttrPair(resourceName, "query_arg_profile_config.0.query_arg_profiles.0.items.*.profile_id", profileResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudFrontFieldLevelEncryptionConfigConfigUpdated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudFrontFieldLevelEncryptionConfigExists(resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some other comment"),
+					resource.TestCheckResourceAttr(resourceName, "content_type_profile_config.0.forward_when_content_type_is_unknown", "false"),
+					tfawsresource.TestCheckTypeSetElemAttrPair(resourceName, "content_type_profile_config.0.content_type_profiles.0.items.*.profile_id", profileResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "query_arg_profile_config.0.forward_when_query_arg_profile_is_unknown", "false"),
+					resource.TestCheckResourceAttr(resourceName, "query_arg_profile_config.0.query_arg_profiles.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontFieldLevelEncryptionConfig_disappears(t *testing.T) {
+	var config cloudfront.GetFieldLevelEncryptionConfigOutput
+	resourceName := "aws_cloudfront_field_level_encryption_config.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontFieldLevelEncryptionConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontFieldLevelEncryptionConfigConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontFieldLevelEncryptionConfigExists(resourceName, &config),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudFrontFieldLevelEncryptionConfig(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFrontFieldLevelEncryptionConfigDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_field_level_encryption_config" {
+			continue
+		}
+
+		output, err := finder.FieldLevelEncryptionConfigByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchFieldLevelEncryptionConfig) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading CloudFront Field-level Encryption Config (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output != nil {
+			return fmt.Errorf("CloudFront Field-level Encryption Config (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudFrontFieldLevelEncryptionConfigExists(n string, v *cloudfront.GetFieldLevelEncryptionConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Field-level Encryption Config ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+		output, err := finder.FieldLevelEncryptionConfigByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("CloudFront Field-level Encryption Config (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSCloudFrontFieldLevelEncryptionConfigConfig(rName string) string {
+	return composeConfig(
+		testAccAWSCloudFrontFieldLevelEncryptionProfileConfig(rName),
+		`
+resource "aws_cloudfront_field_level_encryption_config" "test" {
+  comment = "some comment"
+
+  content_type_profile_config {
+    forward_when_content_type_is_unknown = true
+
+    content_type_profiles {
+      items {
+        content_type = "application/x-www-form-urlencoded"
+        format       = "URLEncoded"
+      }
+    }
+  }
+
+  query_arg_profile_config {
+    forward_when_query_arg_profile_is_unknown = true
+
+    query_arg_profiles {
+      items {
+        profile_id = aws_cloudfront_field_level_encryption_profile.test.id
+        query_arg  = "Arg1"
+      }
+    }
+  }
+}
+`)
+}
+
+func testAccAWSCloudFrontFieldLevelEncryptionConfigConfigUpdated(rName string) string {
+	return composeConfig(
+		testAccAWSCloudFrontFieldLevelEncryptionProfileConfig(rName),
+		`
+resource "aws_cloudfront_field_level_encryption_config" "test" {
+  comment = "some other comment"
+
+  content_type_profile_config {
+    forward_when_content_type_is_unknown = false
+
+    content_type_profiles {
+      items {
+        content_type = "application/x-www-form-urlencoded"
+        format       = "URLEncoded"
+        profile_id   = aws_cloudfront_field_level_encryption_profile.test.id
+      }
+    }
+  }
+
+  query_arg_profile_config {
+    forward_when_query_arg_profile_is_unknown = false
+  }
+}
+`)
+}
@@ -0,0 +1,270 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+)
+
+func resourceAwsCloudFrontFieldLevelEncryptionProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontFieldLevelEncryptionProfileCreate,
+		Read:   resourceAwsCloudFrontFieldLevelEncryptionProfileRead,
+		Update: resourceAwsCloudFrontFieldLevelEncryptionProfileUpdate,
+		Delete: resourceAwsCloudFrontFieldLevelEncryptionProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"caller_reference": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"encryption_entities": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"items": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field_patterns": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"items": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"provider_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"public_key_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	name := d.Get("name").(string)
+	profileConfig := expandCloudFrontFieldLevelEncryptionProfileConfig(d)
+	profileConfig.CallerReference = aws.String(resource.UniqueId())
+
+	input := &cloudfront.CreateFieldLevelEncryptionProfileInput{
+		FieldLevelEncryptionProfileConfig: profileConfig,
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Field-level Encryption Profile: %s", input)
+	output, err := conn.CreateFieldLevelEncryptionProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Field-level Encryption Profile (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.FieldLevelEncryptionProfile.Id))
+
+	return resourceAwsCloudFrontFieldLevelEncryptionProfileRead(d, meta)
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionProfileRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	output, err := finder.FieldLevelEncryptionProfileByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchFieldLevelEncryptionProfile) {
+		log.Printf("[WARN] CloudFront Field-level Encryption Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Field-level Encryption Profile (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading CloudFront Field-level Encryption Profile (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] CloudFront Field-level Encryption Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	profileConfig := output.FieldLevelEncryptionProfile.FieldLevelEncryptionProfileConfig
+
+	d.Set("caller_reference", profileConfig.CallerReference)
+	d.Set("comment", profileConfig.Comment)
+	if err := d.Set("encryption_entities", flattenCloudFrontEncryptionEntities(profileConfig.EncryptionEntities)); err != nil {
+		return fmt.Errorf("error setting encryption_entities: %w", err)
+	}
+	d.Set("etag", output.ETag)
+	d.Set("name", profileConfig.Name)
+
+	return nil
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	profileConfig := expandCloudFrontFieldLevelEncryptionProfileConfig(d)
+	profileConfig.CallerReference = aws.String(d.Get("caller_reference").(string))
+
+	input := &cloudfront.UpdateFieldLevelEncryptionProfileInput{
+		FieldLevelEncryptionProfileConfig: profileConfig,
+		Id:                                aws.String(d.Id()),
+		IfMatch:                           aws.String(d.Get("etag").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Field-level Encryption Profile: %s", input)
+	_, err := conn.UpdateFieldLevelEncryptionProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Field-level Encryption Profile (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudFrontFieldLevelEncryptionProfileRead(d, meta)
+}
+
+func resourceAwsCloudFrontFieldLevelEncryptionProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	log.Printf("[DEBUG] Deleting CloudFront Field-level Encryption Profile (%s)", d.Id())
+	_, err := conn.DeleteFieldLevelEncryptionProfile(&cloudfront.DeleteFieldLevelEncryptionProfileInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchFieldLevelEncryptionProfile) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Field-level Encryption Profile (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudFrontFieldLevelEncryptionProfileConfig(d *schema.ResourceData) *cloudfront.FieldLevelEncryptionProfileConfig {
+	profileConfig := &cloudfront.FieldLevelEncryptionProfileConfig{
+		EncryptionEntities: expandCloudFrontEncryptionEntities(d.Get("encryption_entities").([]interface{})),
+		Name:               aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		profileConfig.Comment = aws.String(v.(string))
+	}
+
+	return profileConfig
+}
+
+func expandCloudFrontEncryptionEntities(l []interface{}) *cloudfront.EncryptionEntities {
+	encryptionEntities := &cloudfront.EncryptionEntities{
+		Quantity: aws.Int64(0),
+	}
+
+	if len(l) == 0 || l[0] == nil {
+		return encryptionEntities
+	}
+
+	m := l[0].(map[string]interface{})
+
+	for _, vItem := range m["items"].(*schema.Set).List() {
+		mItem, ok := vItem.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		encryptionEntity := &cloudfront.EncryptionEntity{
+			FieldPatterns: &cloudfront.FieldPatterns{Quantity: aws.Int64(0)},
+			ProviderId:    aws.String(mItem["provider_id"].(string)),
+			PublicKeyId:   aws.String(mItem["public_key_id"].(string)),
+		}
+
+		if vFieldPatterns, ok := mItem["field_patterns"].([]interface{}); ok && len(vFieldPatterns) > 0 && vFieldPatterns[0] != nil {
+			items := expandStringSet(vFieldPatterns[0].(map[string]interface{})["items"].(*schema.Set))
+
+			encryptionEntity.FieldPatterns.Items = items
+			encryptionEntity.FieldPatterns.Quantity = aws.Int64(int64(len(items)))
+		}
+
+		encryptionEntities.Items = append(encryptionEntities.Items, encryptionEntity)
+	}
+
+	encryptionEntities.Quantity = aws.Int64(int64(len(encryptionEntities.Items)))
+
+	return encryptionEntities
+}
+
+func flattenCloudFrontEncryptionEntities(encryptionEntities *cloudfront.EncryptionEntities) []interface{} {
+	if encryptionEntities == nil {
+		return []interface{}{}
+	}
+
+	vItems := []interface{}{}
+
+	for _, encryptionEntity := range encryptionEntities.Items {
+		if encryptionEntity == nil {
+			continue
+		}
+
+		mItem := map[string]interface{}{
+			"provider_id":   aws.StringValue(encryptionEntity.ProviderId),
+			"public_key_id": aws.StringValue(encryptionEntity.PublicKeyId),
+		}
+
+		if fieldPatterns := encryptionEntity.FieldPatterns; fieldPatterns != nil {
+			mItem["field_patterns"] = []interface{}{map[string]interface{}{
+				"items": flattenStringSet(fieldPatterns.Items),
+			}}
+		}
+
+		vItems = append(vItems, mItem)
+	}
+
+	return []interface{}{map[string]interface{}{
+		"items": vItems,
+	}}
+}
@@ -0,0 +1,198 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
+)
+
+func TestAccAWSCloudFrontFieldLevelEncryptionProfile_basic(t *testing.T) {
+	var profile cloudfront.GetFieldLevelEncryptionProfileOutput
+	resourceName := "aws_cloudfront_field_level_encryption_profile.test"
+	keyResourceName := "aws_cloudfront_public_key.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontFieldLevelEncryptionProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontFieldLevelEncryptionProfileConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudFrontFieldLevelEncryptionProfileExists(resourceName, &profile),
+					resource.TestCheckResourceAttrSet(resourceName, "caller_reference"),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some comment"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_entities.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_entities.0.items.#", "1"),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "encryption_entities.0.items.*", map[string]string{
+						"provider_id":              rName,
+						"field_patterns.#":         "1",
+						"field_patterns.0.items.#": "1",
+					}),
+					tfawsresource.TestCheckTypeSetElemAttrPair(resourceName, "encryption_entities.0.items.*.public_key_id", keyResourceName, "id"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "encryption_entities.0.items.*.field_patterns.0.items.*", "DateOfBirth"),
+					resource.TestCheckResourceAttrSet(resourceName, "etag"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudFrontFieldLevelEncryptionProfileConfigUpdated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudFrontFieldLevelEncryptionProfileExists(resourceName, &profile),
+					resource.TestCheckResourceAttr(resourceName, "comment", "some other comment"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_entities.0.items.#", "1"),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "encryption_entities.0.items.*", map[string]string{
+						"provider_id":              rName,
+						"field_patterns.#":         "1",
+						"field_patterns.0.items.#": "2",
+					}),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "encryption_entities.0.items.*.field_patterns.0.items.*", "FirstName"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontFieldLevelEncryptionProfile_disappears(t *testing.T) {
+	var profile cloudfront.GetFieldLevelEncryptionProfileOutput
+	resourceName := "aws_cloudfront_field_level_encryption_profile.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontFieldLevelEncryptionProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontFieldLevelEncryptionProfileConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontFieldLevelEncryptionProfileExists(resourceName, &profile),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudFrontFieldLevelEncryptionProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFrontFieldLevelEncryptionProfileDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_field_level_encryption_profile" {
+			continue
+		}
+
+		output, err := finder.FieldLevelEncryptionProfileByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchFieldLevelEncryptionProfile) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading CloudFront Field-level Encryption Profile (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output != nil {
+			return fmt.Errorf("CloudFront Field-level Encryption Profile (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudFrontFieldLevelEncryptionProfileExists(n string, v *cloudfront.GetFieldLevelEncryptionProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Field-level Encryption Profile ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+		output, err := finder.FieldLevelEncryptionProfileByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("CloudFront Field-level Encryption Profile (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSCloudFrontFieldLevelEncryptionProfileConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_public_key" "test" {
+  comment     = "test key"
+  encoded_key = file("test-fixtures/cloudfront-public-key.pem")
+  name        = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSCloudFrontFieldLevelEncryptionProfileConfig(rName string) string {
+	return composeConfig(
+		testAccAWSCloudFrontFieldLevelEncryptionProfileConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cloudfront_field_level_encryption_profile" "test" {
+  comment = "some comment"
+  name    = %[1]q
+
+  encryption_entities {
+    items {
+      public_key_id = aws_cloudfront_public_key.test.id
+      provider_id   = %[1]q
+
+      field_patterns {
+        items = ["DateOfBirth"]
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSCloudFrontFieldLevelEncryptionProfileConfigUpdated(rName string) string {
+	return composeConfig(
+		testAccAWSCloudFrontFieldLevelEncryptionProfileConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cloudfront_field_level_encryption_profile" "test" {
+  comment = "some other comment"
+  name    = %[1]q
+
+  encryption_entities {
+    items {
+      public_key_id = aws_cloudfront_public_key.test.id
+      provider_id   = %[1]q
+
+      field_patterns {
+        items = ["FirstName", "DateOfBirth"]
+      }
+    }
+  }
+}
+`, rName))
+}
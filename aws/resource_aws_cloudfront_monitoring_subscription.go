@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfcloudfront "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+)
+
+func resourceAwsCloudFrontMonitoringSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontMonitoringSubscriptionCreate,
+		Read:   resourceAwsCloudFrontMonitoringSubscriptionRead,
+		Update: resourceAwsCloudFrontMonitoringSubscriptionCreate,
+		Delete: resourceAwsCloudFrontMonitoringSubscriptionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCloudFrontMonitoringSubscriptionImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"distribution_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"monitoring_subscription": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"realtime_metrics_subscription_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"realtime_metrics_subscription_status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(cloudfront.RealtimeMetricsSubscriptionStatus_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceAwsCloudFrontMonitoringSubscriptionCreate creates or replaces the monitoring subscription of a distribution.
+// The CloudFront API has no separate update operation, so it is also used to update the resource.
+func resourceAwsCloudFrontMonitoringSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	distributionID := d.Get("distribution_id").(string)
+	input := &cloudfront.CreateMonitoringSubscriptionInput{
+		DistributionId:         aws.String(distributionID),
+		MonitoringSubscription: expandCloudFrontMonitoringSubscription(d.Get("monitoring_subscription").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Putting CloudFront Monitoring Subscription: %s", input)
+	_, err := conn.CreateMonitoringSubscription(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting CloudFront Monitoring Subscription (%s): %w", distributionID, err)
+	}
+
+	d.SetId(distributionID)
+
+	return resourceAwsCloudFrontMonitoringSubscriptionRead(d, meta)
+}
+
+func resourceAwsCloudFrontMonitoringSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	subscription, err := finder.MonitoringSubscriptionByDistributionID(conn, d.Id())
+
+	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchDistribution) || tfawserr.ErrCodeEquals(err, tfcloudfront.ErrCodeNoSuchMonitoringSubscription)) {
+		log.Printf("[WARN] CloudFront Monitoring Subscription (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Monitoring Subscription (%s): %w", d.Id(), err)
+	}
+
+	if subscription == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading CloudFront Monitoring Subscription (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] CloudFront Monitoring Subscription (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("distribution_id", d.Id())
+	if err := d.Set("monitoring_subscription", flattenCloudFrontMonitoringSubscription(subscription)); err != nil {
+		return fmt.Errorf("error setting monitoring_subscription: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudFrontMonitoringSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	log.Printf("[DEBUG] Deleting CloudFront Monitoring Subscription (%s)", d.Id())
+	_, err := conn.DeleteMonitoringSubscription(&cloudfront.DeleteMonitoringSubscriptionInput{
+		DistributionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchDistribution) || tfawserr.ErrCodeEquals(err, tfcloudfront.ErrCodeNoSuchMonitoringSubscription) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Monitoring Subscription (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudFrontMonitoringSubscriptionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("distribution_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandCloudFrontMonitoringSubscription(l []interface{}) *cloudfront.MonitoringSubscription {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	subscription := &cloudfront.MonitoringSubscription{}
+
+	if v, ok := m["realtime_metrics_subscription_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		subscription.RealtimeMetricsSubscriptionConfig = &cloudfront.RealtimeMetricsSubscriptionConfig{
+			RealtimeMetricsSubscriptionStatus: aws.String(v[0].(map[string]interface{})["realtime_metrics_subscription_status"].(string)),
+		}
+	}
+
+	return subscription
+}
+
+func flattenCloudFrontMonitoringSubscription(subscription *cloudfront.MonitoringSubscription) []interface{} {
+	if subscription == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := subscription.RealtimeMetricsSubscriptionConfig; v != nil {
+		m["realtime_metrics_subscription_config"] = []interface{}{map[string]interface{}{
+			"realtime_metrics_subscription_status": aws.StringValue(v.RealtimeMetricsSubscriptionStatus),
+		}}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfcloudfront "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+)
+
+func TestAccAWSCloudFrontMonitoringSubscription_basic(t *testing.T) {
+	var v cloudfront.MonitoringSubscription
+	resourceName := "aws_cloudfront_monitoring_subscription.test"
+	distributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontMonitoringSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontMonitoringSubscriptionConfig("Enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudFrontMonitoringSubscriptionExists(resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_id", distributionResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_subscription.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_subscription.0.realtime_metrics_subscription_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_subscription.0.realtime_metrics_subscription_config.0.realtime_metrics_subscription_status", "Enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudFrontMonitoringSubscriptionConfig("Disabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCloudFrontMonitoringSubscriptionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "monitoring_subscription.0.realtime_metrics_subscription_config.0.realtime_metrics_subscription_status", "Disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudFrontMonitoringSubscription_disappears(t *testing.T) {
+	var v cloudfront.MonitoringSubscription
+	resourceName := "aws_cloudfront_monitoring_subscription.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCloudFront(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFrontMonitoringSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudFrontMonitoringSubscriptionConfig("Enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudFrontMonitoringSubscriptionExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudFrontMonitoringSubscription(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudFrontMonitoringSubscriptionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudfront_monitoring_subscription" {
+			continue
+		}
+
+		subscription, err := finder.MonitoringSubscriptionByDistributionID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchDistribution) || tfawserr.ErrCodeEquals(err, tfcloudfront.ErrCodeNoSuchMonitoringSubscription) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading CloudFront Monitoring Subscription (%s): %w", rs.Primary.ID, err)
+		}
+
+		if subscription != nil {
+			return fmt.Errorf("CloudFront Monitoring Subscription (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudFrontMonitoringSubscriptionExists(n string, v *cloudfront.MonitoringSubscription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudFront Monitoring Subscription ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudfrontconn
+
+		subscription, err := finder.MonitoringSubscriptionByDistributionID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if subscription == nil {
+			return fmt.Errorf("CloudFront Monitoring Subscription (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *subscription
+
+		return nil
+	}
+}
+
+func testAccAWSCloudFrontMonitoringSubscriptionConfig(status string) string {
+	return composeConfig(
+		testAccAWSCloudFrontDistributionConfigEnabled(false, false),
+		fmt.Sprintf(`
+resource "aws_cloudfront_monitoring_subscription" "test" {
+  distribution_id = aws_cloudfront_distribution.test.id
+
+  monitoring_subscription {
+    realtime_metrics_subscription_config {
+      realtime_metrics_subscription_status = %[1]q
+    }
+  }
+}
+`, status))
+}
@@ -0,0 +1,210 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudfront/finder"
+)
+
+func resourceAwsCloudFrontOriginRequestPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudFrontOriginRequestPolicyCreate,
+		Read:   resourceAwsCloudFrontOriginRequestPolicyRead,
+		Update: resourceAwsCloudFrontOriginRequestPolicyUpdate,
+		Delete: resourceAwsCloudFrontOriginRequestPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cookies_config": cloudFrontPolicyItemsConfigSchema("cookie_behavior", "cookies", cloudfront.OriginRequestPolicyCookieBehavior_Values()),
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"headers_config": cloudFrontPolicyItemsConfigSchema("header_behavior", "headers", cloudfront.OriginRequestPolicyHeaderBehavior_Values()),
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"query_strings_config": cloudFrontPolicyItemsConfigSchema("query_string_behavior", "query_strings", cloudfront.OriginRequestPolicyQueryStringBehavior_Values()),
+		},
+	}
+}
+
+func resourceAwsCloudFrontOriginRequestPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	name := d.Get("name").(string)
+	input := &cloudfront.CreateOriginRequestPolicyInput{
+		OriginRequestPolicyConfig: expandCloudFrontOriginRequestPolicyConfig(d),
+	}
+
+	log.Printf("[DEBUG] Creating CloudFront Origin Request Policy: %s", input)
+	output, err := conn.CreateOriginRequestPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudFront Origin Request Policy (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.OriginRequestPolicy.Id))
+
+	return resourceAwsCloudFrontOriginRequestPolicyRead(d, meta)
+}
+
+func resourceAwsCloudFrontOriginRequestPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	output, err := finder.OriginRequestPolicyByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchOriginRequestPolicy) {
+		log.Printf("[WARN] CloudFront Origin Request Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudFront Origin Request Policy (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading CloudFront Origin Request Policy (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] CloudFront Origin Request Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("etag", output.ETag)
+
+	return flattenCloudFrontOriginRequestPolicyConfig(d, output.OriginRequestPolicy.OriginRequestPolicyConfig)
+}
+
+func resourceAwsCloudFrontOriginRequestPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	input := &cloudfront.UpdateOriginRequestPolicyInput{
+		Id:                        aws.String(d.Id()),
+		IfMatch:                   aws.String(d.Get("etag").(string)),
+		OriginRequestPolicyConfig: expandCloudFrontOriginRequestPolicyConfig(d),
+	}
+
+	log.Printf("[DEBUG] Updating CloudFront Origin Request Policy: %s", input)
+	_, err := conn.UpdateOriginRequestPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating CloudFront Origin Request Policy (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsCloudFrontOriginRequestPolicyRead(d, meta)
+}
+
+func resourceAwsCloudFrontOriginRequestPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudfrontconn
+
+	log.Printf("[DEBUG] Deleting CloudFront Origin Request Policy (%s)", d.Id())
+	_, err := conn.DeleteOriginRequestPolicy(&cloudfront.DeleteOriginRequestPolicyInput{
+		Id:      aws.String(d.Id()),
+		IfMatch: aws.String(d.Get("etag").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudfront.ErrCodeNoSuchOriginRequestPolicy) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudFront Origin Request Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudFrontOriginRequestPolicyConfig(d *schema.ResourceData) *cloudfront.OriginRequestPolicyConfig {
+	originRequestPolicyConfig := &cloudfront.OriginRequestPolicyConfig{
+		Name: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		originRequestPolicyConfig.Comment = aws.String(v.(string))
+	}
+
+	if behavior, items := expandCloudFrontPolicyItemsConfig(d.Get("cookies_config"), "cookie_behavior", "cookies"); behavior != nil {
+		originRequestPolicyConfig.CookiesConfig = &cloudfront.OriginRequestPolicyCookiesConfig{
+			CookieBehavior: behavior,
+			Cookies:        &cloudfront.CookieNames{Items: items, Quantity: aws.Int64(int64(len(items)))},
+		}
+	}
+
+	if behavior, items := expandCloudFrontPolicyItemsConfig(d.Get("headers_config"), "header_behavior", "headers"); behavior != nil {
+		originRequestPolicyConfig.HeadersConfig = &cloudfront.OriginRequestPolicyHeadersConfig{
+			HeaderBehavior: behavior,
+			Headers:        &cloudfront.Headers{Items: items, Quantity: aws.Int64(int64(len(items)))},
+		}
+	}
+
+	if behavior, items := expandCloudFrontPolicyItemsConfig(d.Get("query_strings_config"), "query_string_behavior", "query_strings"); behavior != nil {
+		originRequestPolicyConfig.QueryStringsConfig = &cloudfront.OriginRequestPolicyQueryStringsConfig{
+			QueryStringBehavior: behavior,
+			QueryStrings:        &cloudfront.QueryStringNames{Items: items, Quantity: aws.Int64(int64(len(items)))},
+		}
+	}
+
+	return originRequestPolicyConfig
+}
+
+func flattenCloudFrontOriginRequestPolicyConfig(d *schema.ResourceData, originRequestPolicyConfig *cloudfront.OriginRequestPolicyConfig) error {
+	d.Set("comment", originRequestPolicyConfig.Comment)
+	d.Set("name", originRequestPolicyConfig.Name)
+
+	var cookiesConfig, headersConfig, queryStringsConfig []interface{}
+
+	if v := originRequestPolicyConfig.CookiesConfig; v != nil {
+		var items []*string
+		if v.Cookies != nil {
+			items = v.Cookies.Items
+		}
+		cookiesConfig = flattenCloudFrontPolicyItemsConfig(v.CookieBehavior, items, "cookie_behavior", "cookies")
+	}
+
+	if err := d.Set("cookies_config", cookiesConfig); err != nil {
+		return fmt.Errorf("error setting cookies_config: %w", err)
+	}
+
+	if v := originRequestPolicyConfig.HeadersConfig; v != nil {
+		var items []*string
+		if v.Headers != nil {
+			items = v.Headers.Items
+		}
+		headersConfig = flattenCloudFrontPolicyItemsConfig(v.HeaderBehavior, items, "header_behavior", "headers")
+	}
+
+	if err := d.Set("headers_config", headersConfig); err != nil {
+		return fmt.Errorf("error setting headers_config: %w", err)
+	}
+
+	if v := originRequestPolicyConfig.QueryStringsConfig; v != nil {
+		var items []*string
+		if v.QueryStrings != nil {
+			items = v.QueryStrings.Items
+		}
+		queryStringsConfig = flattenCloudFrontPolicyItemsConfig(v.QueryStringBehavior, items, "query_string_behavior", "query_strings")
+	}
+
+	if err := d.Set("query_strings_config", queryStringsConfig); err != nil {
+		return fmt.Errorf("error setting query_strings_config: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatch/finder"
+)
+
+func resourceAwsCloudWatchCompositeAlarm() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchCompositeAlarmCreate,
+		Read:   resourceAwsCloudWatchCompositeAlarmRead,
+		Update: resourceAwsCloudWatchCompositeAlarmUpdate,
+		Delete: resourceAwsCloudWatchCompositeAlarmDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"actions_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"alarm_actions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+				Set: schema.HashString,
+			},
+			"alarm_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"alarm_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"alarm_rule": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10240),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"insufficient_data_actions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+				Set: schema.HashString,
+			},
+			"ok_actions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+				Set: schema.HashString,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsCloudWatchCompositeAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+	name := d.Get("alarm_name").(string)
+
+	input := expandCloudWatchPutCompositeAlarmInput(d)
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().CloudwatchTags()
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Composite Alarm: %s", input)
+	_, err := conn.PutCompositeAlarm(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating CloudWatch Composite Alarm (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsCloudWatchCompositeAlarmRead(d, meta)
+}
+
+func resourceAwsCloudWatchCompositeAlarmRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	alarm, err := finder.CompositeAlarmByName(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Composite Alarm (%s): %w", d.Id(), err)
+	}
+
+	if alarm == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading CloudWatch Composite Alarm (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] CloudWatch Composite Alarm (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(alarm.AlarmArn)
+
+	d.Set("actions_enabled", alarm.ActionsEnabled)
+	if err := d.Set("alarm_actions", flattenStringSet(alarm.AlarmActions)); err != nil {
+		return fmt.Errorf("error setting alarm_actions: %w", err)
+	}
+	d.Set("alarm_description", alarm.AlarmDescription)
+	d.Set("alarm_name", alarm.AlarmName)
+	d.Set("alarm_rule", alarm.AlarmRule)
+	d.Set("arn", arn)
+	if err := d.Set("insufficient_data_actions", flattenStringSet(alarm.InsufficientDataActions)); err != nil {
+		return fmt.Errorf("error setting insufficient_data_actions: %w", err)
+	}
+	if err := d.Set("ok_actions", flattenStringSet(alarm.OKActions)); err != nil {
+		return fmt.Errorf("error setting ok_actions: %w", err)
+	}
+
+	tags, err := keyvaluetags.CloudwatchListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for CloudWatch Composite Alarm (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchCompositeAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	// PutCompositeAlarm replaces the whole alarm definition in place, keeping its
+	// current state, so suppression via actions_enabled or the alarm rule does not
+	// require the alarm to be recreated.
+	if d.HasChanges("actions_enabled", "alarm_actions", "alarm_description", "alarm_rule", "insufficient_data_actions", "ok_actions") {
+		input := expandCloudWatchPutCompositeAlarmInput(d)
+
+		log.Printf("[DEBUG] Updating CloudWatch Composite Alarm: %s", input)
+		_, err := conn.PutCompositeAlarm(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating CloudWatch Composite Alarm (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		arn := d.Get("arn").(string)
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.CloudwatchUpdateTags(conn, arn, o, n); err != nil {
+			return fmt.Errorf("error updating CloudWatch Composite Alarm (%s) tags: %w", arn, err)
+		}
+	}
+
+	return resourceAwsCloudWatchCompositeAlarmRead(d, meta)
+}
+
+func resourceAwsCloudWatchCompositeAlarmDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Composite Alarm: %s", d.Id())
+	_, err := conn.DeleteAlarms(&cloudwatch.DeleteAlarmsInput{
+		AlarmNames: aws.StringSlice([]string{d.Id()}),
+	})
+
+	if isAWSErr(err, cloudwatch.ErrCodeResourceNotFound, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Composite Alarm (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCloudWatchPutCompositeAlarmInput(d *schema.ResourceData) *cloudwatch.PutCompositeAlarmInput {
+	input := &cloudwatch.PutCompositeAlarmInput{
+		ActionsEnabled: aws.Bool(d.Get("actions_enabled").(bool)),
+		AlarmName:      aws.String(d.Get("alarm_name").(string)),
+		AlarmRule:      aws.String(d.Get("alarm_rule").(string)),
+	}
+
+	if v, ok := d.GetOk("alarm_actions"); ok {
+		input.AlarmActions = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("alarm_description"); ok {
+		input.AlarmDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("insufficient_data_actions"); ok {
+		input.InsufficientDataActions = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("ok_actions"); ok {
+		input.OKActions = expandStringSet(v.(*schema.Set))
+	}
+
+	return input
+}
@@ -0,0 +1,410 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatch/finder"
+)
+
+func TestAccAWSCloudWatchCompositeAlarm_basic(t *testing.T) {
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_actions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_description", ""),
+					resource.TestCheckResourceAttr(resourceName, "alarm_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule", fmt.Sprintf("ALARM(%[1]s-0) OR ALARM(%[1]s-1)", rName)),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "cloudwatch", fmt.Sprintf("alarm:%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "insufficient_data_actions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ok_actions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchCompositeAlarm_disappears(t *testing.T) {
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudWatchCompositeAlarm(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchCompositeAlarm_ActionsEnabled(t *testing.T) {
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfig_actionsEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfig_actionsEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "actions_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchCompositeAlarm_Actions(t *testing.T) {
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfig_actions(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_actions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "insufficient_data_actions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ok_actions.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfig_actions(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "insufficient_data_actions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ok_actions.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_actions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "insufficient_data_actions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ok_actions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchCompositeAlarm_AlarmRule(t *testing.T) {
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule", fmt.Sprintf("ALARM(%[1]s-0) OR ALARM(%[1]s-1)", rName)),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfig_alarmRuleSuppressed(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule", fmt.Sprintf("ALARM(%[1]s-0) AND NOT ALARM(%[1]s-1)", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchCompositeAlarm_Description(t *testing.T) {
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfig_description(rName, "Test 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_description", "Test 1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfig_description(rName, "Test Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_description", "Test Updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchCompositeAlarm_Tags(t *testing.T) {
+	resourceName := "aws_cloudwatch_composite_alarm.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchCompositeAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchCompositeAlarmConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudWatchCompositeAlarmDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_composite_alarm" {
+			continue
+		}
+
+		alarm, err := finder.CompositeAlarmByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading CloudWatch Composite Alarm (%s): %w", rs.Primary.ID, err)
+		}
+
+		if alarm != nil {
+			return fmt.Errorf("CloudWatch Composite Alarm (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCloudWatchCompositeAlarmExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+		alarm, err := finder.CompositeAlarmByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading CloudWatch Composite Alarm (%s): %w", rs.Primary.ID, err)
+		}
+
+		if alarm == nil {
+			return fmt.Errorf("CloudWatch Composite Alarm (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSCloudWatchCompositeAlarmBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  count = 2
+
+  alarm_name          = "%[1]s-${count.index}"
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 2
+  metric_name         = "CPUUtilization"
+  namespace           = "AWS/EC2"
+  period              = 120
+  statistic           = "Average"
+  threshold           = 80
+
+  dimensions = {
+    InstanceId = "i-abc123"
+  }
+}
+`, rName)
+}
+
+func testAccAWSCloudWatchCompositeAlarmConfig_basic(rName string) string {
+	return composeConfig(
+		testAccAWSCloudWatchCompositeAlarmBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = %[1]q
+  alarm_rule = join(" OR ", formatlist("ALARM(%%s)", aws_cloudwatch_metric_alarm.test[*].alarm_name))
+}
+`, rName))
+}
+
+func testAccAWSCloudWatchCompositeAlarmConfig_alarmRuleSuppressed(rName string) string {
+	return composeConfig(
+		testAccAWSCloudWatchCompositeAlarmBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = %[1]q
+  alarm_rule = "ALARM(${aws_cloudwatch_metric_alarm.test[0].alarm_name}) AND NOT ALARM(${aws_cloudwatch_metric_alarm.test[1].alarm_name})"
+}
+`, rName))
+}
+
+func testAccAWSCloudWatchCompositeAlarmConfig_actionsEnabled(rName string, enabled bool) string {
+	return composeConfig(
+		testAccAWSCloudWatchCompositeAlarmBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  actions_enabled = %[2]t
+  alarm_name      = %[1]q
+  alarm_rule      = join(" OR ", formatlist("ALARM(%%s)", aws_cloudwatch_metric_alarm.test[*].alarm_name))
+}
+`, rName, enabled))
+}
+
+func testAccAWSCloudWatchCompositeAlarmConfig_actions(rName string, count int) string {
+	return composeConfig(
+		testAccAWSCloudWatchCompositeAlarmBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  count = %[2]d
+  name  = "%[1]s-${count.index}"
+}
+
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_actions             = aws_sns_topic.test[*].arn
+  alarm_name                = %[1]q
+  alarm_rule                = join(" OR ", formatlist("ALARM(%%s)", aws_cloudwatch_metric_alarm.test[*].alarm_name))
+  insufficient_data_actions = aws_sns_topic.test[*].arn
+  ok_actions                = aws_sns_topic.test[*].arn
+}
+`, rName, count))
+}
+
+func testAccAWSCloudWatchCompositeAlarmConfig_description(rName, description string) string {
+	return composeConfig(
+		testAccAWSCloudWatchCompositeAlarmBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_description = %[2]q
+  alarm_name        = %[1]q
+  alarm_rule        = join(" OR ", formatlist("ALARM(%%s)", aws_cloudwatch_metric_alarm.test[*].alarm_name))
+}
+`, rName, description))
+}
+
+func testAccAWSCloudWatchCompositeAlarmConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSCloudWatchCompositeAlarmBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = %[1]q
+  alarm_rule = join(" OR ", formatlist("ALARM(%%s)", aws_cloudwatch_metric_alarm.test[*].alarm_name))
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSCloudWatchCompositeAlarmConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSCloudWatchCompositeAlarmBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_composite_alarm" "test" {
+  alarm_name = %[1]q
+  alarm_rule = join(" OR ", formatlist("ALARM(%%s)", aws_cloudwatch_metric_alarm.test[*].alarm_name))
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
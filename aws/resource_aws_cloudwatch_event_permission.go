@@ -11,9 +11,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfevents "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents"
 )
 
 func resourceAwsCloudWatchEventPermission() *schema.Resource {
@@ -57,6 +59,13 @@ func resourceAwsCloudWatchEventPermission() *schema.Resource {
 					},
 				},
 			},
+			"event_bus_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudWatchEventBusName,
+				Default:      tfevents.DefaultEventBusName,
+			},
 			"principal": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -75,13 +84,15 @@ func resourceAwsCloudWatchEventPermission() *schema.Resource {
 func resourceAwsCloudWatchEventPermissionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	eventBusName := d.Get("event_bus_name").(string)
 	statementID := d.Get("statement_id").(string)
 
 	input := events.PutPermissionInput{
-		Action:      aws.String(d.Get("action").(string)),
-		Condition:   expandCloudWatchEventsCondition(d.Get("condition").([]interface{})),
-		Principal:   aws.String(d.Get("principal").(string)),
-		StatementId: aws.String(statementID),
+		Action:       aws.String(d.Get("action").(string)),
+		Condition:    expandCloudWatchEventsCondition(d.Get("condition").([]interface{})),
+		EventBusName: aws.String(eventBusName),
+		Principal:    aws.String(d.Get("principal").(string)),
+		StatementId:  aws.String(statementID),
 	}
 
 	log.Printf("[DEBUG] Creating CloudWatch Events permission: %s", input)
@@ -90,7 +101,7 @@ func resourceAwsCloudWatchEventPermissionCreate(d *schema.ResourceData, meta int
 		return fmt.Errorf("Creating CloudWatch Events permission failed: %s", err.Error())
 	}
 
-	d.SetId(statementID)
+	d.SetId(tfevents.PermissionCreateID(eventBusName, statementID))
 
 	return resourceAwsCloudWatchEventPermissionRead(d, meta)
 }
@@ -98,19 +109,27 @@ func resourceAwsCloudWatchEventPermissionCreate(d *schema.ResourceData, meta int
 // See also: https://docs.aws.amazon.com/AmazonCloudWatchEvents/latest/APIReference/API_DescribeEventBus.html
 func resourceAwsCloudWatchEventPermissionRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
-	input := events.DescribeEventBusInput{}
+
+	eventBusName, statementID, err := tfevents.PermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := events.DescribeEventBusInput{
+		Name: aws.String(eventBusName),
+	}
 	var output *events.DescribeEventBusOutput
 	var policyStatement *CloudWatchEventPermissionPolicyStatement
 
 	// Especially with concurrent PutPermission calls there can be a slight delay
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		log.Printf("[DEBUG] Reading CloudWatch Events bus: %s", input)
 		output, err := conn.DescribeEventBus(&input)
 		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("Reading CloudWatch Events permission '%s' failed: %s", d.Id(), err.Error()))
+			return resource.NonRetryableError(err)
 		}
 
-		policyStatement, err = getPolicyStatement(output, d.Id())
+		policyStatement, err = getPolicyStatement(output, statementID)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -120,10 +139,15 @@ func resourceAwsCloudWatchEventPermissionRead(d *schema.ResourceData, meta inter
 	if isResourceTimeoutError(err) {
 		output, err = conn.DescribeEventBus(&input)
 		if output != nil {
-			policyStatement, err = getPolicyStatement(output, d.Id())
+			policyStatement, err = getPolicyStatement(output, statementID)
 		}
 	}
 
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, events.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] CloudWatch Events bus (%s) not found, removing CloudWatch Events permission (%s) from state", eventBusName, d.Id())
+		d.SetId("")
+		return nil
+	}
 	if isResourceNotFoundError(err) {
 		log.Printf("[WARN] %s", err)
 		d.SetId("")
@@ -131,7 +155,7 @@ func resourceAwsCloudWatchEventPermissionRead(d *schema.ResourceData, meta inter
 	}
 	if err != nil {
 		// Missing statement inside valid policy
-		return err
+		return fmt.Errorf("Reading CloudWatch Events permission '%s' failed: %w", d.Id(), err)
 	}
 
 	d.Set("action", policyStatement.Action)
@@ -151,6 +175,7 @@ func resourceAwsCloudWatchEventPermissionRead(d *schema.ResourceData, meta inter
 		}
 		d.Set("principal", policyARN.AccountID)
 	}
+	d.Set("event_bus_name", eventBusName)
 	d.Set("statement_id", policyStatement.Sid)
 
 	return nil
@@ -179,10 +204,11 @@ func resourceAwsCloudWatchEventPermissionUpdate(d *schema.ResourceData, meta int
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
 	input := events.PutPermissionInput{
-		Action:      aws.String(d.Get("action").(string)),
-		Condition:   expandCloudWatchEventsCondition(d.Get("condition").([]interface{})),
-		Principal:   aws.String(d.Get("principal").(string)),
-		StatementId: aws.String(d.Get("statement_id").(string)),
+		Action:       aws.String(d.Get("action").(string)),
+		Condition:    expandCloudWatchEventsCondition(d.Get("condition").([]interface{})),
+		EventBusName: aws.String(d.Get("event_bus_name").(string)),
+		Principal:    aws.String(d.Get("principal").(string)),
+		StatementId:  aws.String(d.Get("statement_id").(string)),
 	}
 
 	log.Printf("[DEBUG] Update CloudWatch Events permission: %s", input)
@@ -201,12 +227,19 @@ func resourceAwsCloudWatchEventPermissionUpdate(d *schema.ResourceData, meta int
 
 func resourceAwsCloudWatchEventPermissionDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	eventBusName, statementID, err := tfevents.PermissionParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
 	input := events.RemovePermissionInput{
-		StatementId: aws.String(d.Id()),
+		EventBusName: aws.String(eventBusName),
+		StatementId:  aws.String(statementID),
 	}
 
 	log.Printf("[DEBUG] Delete CloudWatch Events permission: %s", input)
-	_, err := conn.RemovePermission(&input)
+	_, err = conn.RemovePermission(&input)
 	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
 		return nil
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfevents "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents"
 )
 
 func init() {
@@ -132,6 +133,38 @@ func TestAccAWSCloudWatchEventPermission_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchEventPermission_EventBusName(t *testing.T) {
+	principal1 := "111111111111"
+	statementID := acctest.RandomWithPrefix(t.Name())
+	busName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_permission.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudWatchEventPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsCloudWatchEventPermissionResourceConfigEventBusName(principal1, busName, statementID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventPermissionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "events:PutEvents"),
+					resource.TestCheckResourceAttr(resourceName, "condition.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "event_bus_name", busName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s/%s", busName, statementID)),
+					resource.TestCheckResourceAttr(resourceName, "principal", principal1),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", statementID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSCloudWatchEventPermission_Action(t *testing.T) {
 	principal := "111111111111"
 	statementID := acctest.RandomWithPrefix(t.Name())
@@ -270,6 +303,30 @@ func TestAccAWSCloudWatchEventPermission_Disappears(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchEventPermission_Disappears_EventBus(t *testing.T) {
+	principal := "111111111111"
+	statementID := acctest.RandomWithPrefix(t.Name())
+	busName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_permission.test1"
+	eventBusResourceName := "aws_cloudwatch_event_bus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudWatchEventPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsCloudWatchEventPermissionResourceConfigEventBusName(principal, busName, statementID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventPermissionExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudWatchEventBus(), eventBusResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccCheckCloudWatchEventPermissionDisappears(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -282,10 +339,17 @@ func testAccCheckCloudWatchEventPermissionDisappears(resourceName string) resour
 		}
 
 		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+		eventBusName, statementID, err := tfevents.PermissionParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
 		input := events.RemovePermissionInput{
-			StatementId: aws.String(rs.Primary.ID),
+			EventBusName: aws.String(eventBusName),
+			StatementId:  aws.String(statementID),
 		}
-		_, err := conn.RemovePermission(&input)
+		_, err = conn.RemovePermission(&input)
 		return err
 	}
 }
@@ -302,7 +366,14 @@ func testAccCheckCloudWatchEventPermissionExists(pr string) resource.TestCheckFu
 			return fmt.Errorf("No ID is set")
 		}
 
-		debo, err := conn.DescribeEventBus(&events.DescribeEventBusInput{})
+		eventBusName, statementID, err := tfevents.PermissionParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		debo, err := conn.DescribeEventBus(&events.DescribeEventBusInput{
+			Name: aws.String(eventBusName),
+		})
 		if err != nil {
 			return fmt.Errorf("Reading CloudWatch Events bus policy for '%s' failed: %s", pr, err.Error())
 		}
@@ -317,7 +388,7 @@ func testAccCheckCloudWatchEventPermissionExists(pr string) resource.TestCheckFu
 			return fmt.Errorf("Reading CloudWatch Events bus policy for '%s' failed: %s", pr, err.Error())
 		}
 
-		_, err = findCloudWatchEventPermissionPolicyStatementByID(&policyDoc, rs.Primary.ID)
+		_, err = findCloudWatchEventPermissionPolicyStatementByID(&policyDoc, statementID)
 		return err
 	}
 }
@@ -330,10 +401,20 @@ func testAccCheckCloudWatchEventPermissionDestroy(s *terraform.State) error {
 			continue
 		}
 
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			input := events.DescribeEventBusInput{}
+		eventBusName, statementID, err := tfevents.PermissionParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+			input := events.DescribeEventBusInput{
+				Name: aws.String(eventBusName),
+			}
 
 			debo, err := conn.DescribeEventBus(&input)
+			if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+				return nil
+			}
 			if err != nil {
 				return resource.NonRetryableError(err)
 			}
@@ -347,7 +428,7 @@ func testAccCheckCloudWatchEventPermissionDestroy(s *terraform.State) error {
 				return resource.NonRetryableError(fmt.Errorf("Reading CloudWatch Events permission '%s' failed: %s", rs.Primary.ID, err.Error()))
 			}
 
-			_, err = findCloudWatchEventPermissionPolicyStatementByID(&policyDoc, rs.Primary.ID)
+			_, err = findCloudWatchEventPermissionPolicyStatementByID(&policyDoc, statementID)
 			if err == nil {
 				return resource.RetryableError(fmt.Errorf("CloudWatch Events permission exists: %s", rs.Primary.ID))
 			}
@@ -372,6 +453,20 @@ resource "aws_cloudwatch_event_permission" "test1" {
 `, principal, statementID)
 }
 
+func testAccCheckAwsCloudWatchEventPermissionResourceConfigEventBusName(principal, busName, statementID string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[2]q
+}
+
+resource "aws_cloudwatch_event_permission" "test1" {
+  principal      = %[1]q
+  statement_id   = %[3]q
+  event_bus_name = aws_cloudwatch_event_bus.test.name
+}
+`, principal, busName, statementID)
+}
+
 func testAccCheckAwsCloudWatchEventPermissionResourceConfigAction(action, principal, statementID string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_permission" "test1" {
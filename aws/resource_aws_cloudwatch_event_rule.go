@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfevents "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents"
 )
 
 const (
@@ -63,6 +64,13 @@ func resourceAwsCloudWatchEventRule() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 512),
 			},
+			"event_bus_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudWatchEventBusName,
+				Default:      tfevents.DefaultEventBusName,
+			},
 			"role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -123,7 +131,7 @@ func resourceAwsCloudWatchEventRuleCreate(d *schema.ResourceData, meta interface
 	}
 
 	d.Set("arn", out.RuleArn)
-	d.SetId(*input.Name)
+	d.SetId(tfevents.RuleCreateID(aws.StringValue(input.EventBusName), aws.StringValue(input.Name)))
 
 	log.Printf("[INFO] CloudWatch Event Rule %q created", *out.RuleArn)
 
@@ -134,8 +142,14 @@ func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
 
+	eventBusName, ruleName, err := tfevents.RuleParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
 	input := events.DescribeRuleInput{
-		Name: aws.String(d.Id()),
+		EventBusName: aws.String(eventBusName),
+		Name:         aws.String(ruleName),
 	}
 	log.Printf("[DEBUG] Reading CloudWatch Event Rule: %s", input)
 	out, err := conn.DescribeRule(&input)
@@ -154,6 +168,7 @@ func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}
 	arn := *out.Arn
 	d.Set("arn", arn)
 	d.Set("description", out.Description)
+	d.Set("event_bus_name", eventBusName)
 	if out.EventPattern != nil {
 		pattern, err := structure.NormalizeJsonString(*out.EventPattern)
 		if err != nil {
@@ -188,7 +203,12 @@ func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}
 func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
-	input, err := buildPutRuleInputStruct(d, d.Id())
+	_, ruleName, err := tfevents.RuleParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input, err := buildPutRuleInputStruct(d, ruleName)
 	if err != nil {
 		return fmt.Errorf("Updating CloudWatch Event Rule failed: %s", err)
 	}
@@ -230,11 +250,17 @@ func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface
 func resourceAwsCloudWatchEventRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	eventBusName, ruleName, err := tfevents.RuleParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
 	input := &events.DeleteRuleInput{
-		Name: aws.String(d.Id()),
+		EventBusName: aws.String(eventBusName),
+		Name:         aws.String(ruleName),
 	}
 
-	err := resource.Retry(cloudWatchEventRuleDeleteRetryTimeout, func() *resource.RetryError {
+	err = resource.Retry(cloudWatchEventRuleDeleteRetryTimeout, func() *resource.RetryError {
 		_, err := conn.DeleteRule(input)
 
 		if isAWSErr(err, "ValidationException", "Rule can't be deleted since it has targets") {
@@ -266,6 +292,9 @@ func buildPutRuleInputStruct(d *schema.ResourceData, name string) (*events.PutRu
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
+	if v, ok := d.GetOk("event_bus_name"); ok {
+		input.EventBusName = aws.String(v.(string))
+	}
 	if v, ok := d.GetOk("event_pattern"); ok {
 		pattern, err := structure.NormalizeJsonString(v)
 		if err != nil {
@@ -5,7 +5,6 @@ import (
 	"log"
 	"math"
 	"regexp"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfevents "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchevents"
 )
 
 func resourceAwsCloudWatchEventTarget() *schema.Resource {
@@ -28,6 +28,14 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"event_bus_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudWatchEventBusName,
+				Default:      tfevents.DefaultEventBusName,
+			},
+
 			"rule": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -255,8 +263,7 @@ func resourceAwsCloudWatchEventTargetCreate(d *schema.ResourceData, meta interfa
 			out.FailedEntries)
 	}
 
-	id := rule + "-" + targetId
-	d.SetId(id)
+	d.SetId(tfevents.TargetCreateID(d.Get("event_bus_name").(string), rule, targetId))
 
 	log.Printf("[INFO] CloudWatch Event Target %q created", d.Id())
 
@@ -266,9 +273,16 @@ func resourceAwsCloudWatchEventTargetCreate(d *schema.ResourceData, meta interfa
 func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	// Targets created before event_bus_name was introduced have no value in state
+	eventBusName := d.Get("event_bus_name").(string)
+	if eventBusName == "" {
+		eventBusName = tfevents.DefaultEventBusName
+	}
+
 	t, err := findEventTargetById(
 		d.Get("target_id").(string),
 		d.Get("rule").(string),
+		eventBusName,
 		nil, conn)
 	if err != nil {
 		if regexp.MustCompile(" not found$").MatchString(err.Error()) {
@@ -297,6 +311,7 @@ func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Found Event Target: %s", t)
 
 	d.Set("arn", t.Arn)
+	d.Set("event_bus_name", eventBusName)
 	d.Set("target_id", t.Id)
 	d.Set("input", t.Input)
 	d.Set("input_path", t.InputPath)
@@ -341,12 +356,15 @@ func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func findEventTargetById(id, rule string, nextToken *string, conn *events.CloudWatchEvents) (*events.Target, error) {
+func findEventTargetById(id, rule, eventBusName string, nextToken *string, conn *events.CloudWatchEvents) (*events.Target, error) {
 	input := events.ListTargetsByRuleInput{
 		Rule:      aws.String(rule),
 		NextToken: nextToken,
 		Limit:     aws.Int64(100), // Set limit to allowed maximum to prevent API throttling
 	}
+	if eventBusName != "" {
+		input.EventBusName = aws.String(eventBusName)
+	}
 	log.Printf("[DEBUG] Reading CloudWatch Event Target: %s", input)
 	out, err := conn.ListTargetsByRule(&input)
 	if err != nil {
@@ -360,7 +378,7 @@ func findEventTargetById(id, rule string, nextToken *string, conn *events.CloudW
 	}
 
 	if out.NextToken != nil {
-		return findEventTargetById(id, rule, nextToken, conn)
+		return findEventTargetById(id, rule, eventBusName, out.NextToken, conn)
 	}
 
 	return nil, fmt.Errorf("CloudWatch Event Target %q (%q) not found", id, rule)
@@ -388,6 +406,10 @@ func resourceAwsCloudWatchEventTargetDelete(d *schema.ResourceData, meta interfa
 		Rule: aws.String(d.Get("rule").(string)),
 	}
 
+	if v, ok := d.GetOk("event_bus_name"); ok {
+		input.EventBusName = aws.String(v.(string))
+	}
+
 	output, err := conn.RemoveTargets(input)
 
 	if err != nil {
@@ -446,6 +468,10 @@ func buildPutTargetInputStruct(d *schema.ResourceData) *events.PutTargetsInput {
 		Targets: []*events.Target{e},
 	}
 
+	if v, ok := d.GetOk("event_bus_name"); ok {
+		input.EventBusName = aws.String(v.(string))
+	}
+
 	return &input
 }
 
@@ -665,17 +691,15 @@ func flattenAwsCloudWatchInputTransformer(inputTransformer *events.InputTransfor
 }
 
 func resourceAwsCloudWatchEventTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	idParts := strings.SplitN(d.Id(), "/", 2)
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		return nil, fmt.Errorf("unexpected format (%q), expected <rule-name>/<target-id>", d.Id())
+	eventBusName, ruleName, targetName, err := tfevents.TargetParseImportID(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
-	ruleName := idParts[0]
-	targetName := idParts[1]
-
+	d.Set("event_bus_name", eventBusName)
 	d.Set("target_id", targetName)
 	d.Set("rule", ruleName)
-	d.SetId(ruleName + "-" + targetName)
+	d.SetId(tfevents.TargetCreateID(eventBusName, ruleName, targetName))
 
 	return []*schema.ResourceData{d}, nil
 }
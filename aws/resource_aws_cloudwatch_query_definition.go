@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchlogs/finder"
+)
+
+func resourceAwsCloudWatchQueryDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchQueryDefinitionPut,
+		Read:   resourceAwsCloudWatchQueryDefinitionRead,
+		Update: resourceAwsCloudWatchQueryDefinitionPut,
+		Delete: resourceAwsCloudWatchQueryDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"log_group_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateLogGroupName,
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"query_definition_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"query_string": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10000),
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchQueryDefinitionPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+	name := d.Get("name").(string)
+
+	input := &cloudwatchlogs.PutQueryDefinitionInput{
+		Name:        aws.String(name),
+		QueryString: aws.String(d.Get("query_string").(string)),
+	}
+
+	if v, ok := d.GetOk("log_group_names"); ok && len(v.([]interface{})) > 0 {
+		input.LogGroupNames = expandStringList(v.([]interface{}))
+	}
+
+	if !d.IsNewResource() {
+		input.QueryDefinitionId = aws.String(d.Id())
+	}
+
+	log.Printf("[DEBUG] Putting CloudWatch Logs Insights Query Definition: %s", input)
+	output, err := conn.PutQueryDefinition(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting CloudWatch Logs Insights Query Definition (%s): %w", name, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(aws.StringValue(output.QueryDefinitionId))
+	}
+
+	return resourceAwsCloudWatchQueryDefinitionRead(d, meta)
+}
+
+func resourceAwsCloudWatchQueryDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+
+	// The name is unknown on import, in which case all query definitions are searched.
+	queryDefinition, err := finder.QueryDefinitionByID(conn, d.Get("name").(string), d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Logs Insights Query Definition (%s): %w", d.Id(), err)
+	}
+
+	if queryDefinition == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading CloudWatch Logs Insights Query Definition (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] CloudWatch Logs Insights Query Definition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("log_group_names", aws.StringValueSlice(queryDefinition.LogGroupNames)); err != nil {
+		return fmt.Errorf("error setting log_group_names: %w", err)
+	}
+	d.Set("name", queryDefinition.Name)
+	d.Set("query_definition_id", queryDefinition.QueryDefinitionId)
+	d.Set("query_string", queryDefinition.QueryString)
+
+	return nil
+}
+
+func resourceAwsCloudWatchQueryDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchlogsconn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Logs Insights Query Definition: %s", d.Id())
+	_, err := conn.DeleteQueryDefinition(&cloudwatchlogs.DeleteQueryDefinitionInput{
+		QueryDefinitionId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, cloudwatchlogs.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Logs Insights Query Definition (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudwatchlogs/finder"
+)
+
+func TestAccAWSCloudWatchQueryDefinition_basic(t *testing.T) {
+	resourceName := "aws_cloudwatch_query_definition.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchQueryDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchQueryDefinitionConfig_basic(rName, "fields @timestamp, @message | sort @timestamp desc | limit 20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_group_names.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "query_definition_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "fields @timestamp, @message | sort @timestamp desc | limit 20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchQueryDefinition_disappears(t *testing.T) {
+	resourceName := "aws_cloudwatch_query_definition.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchQueryDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchQueryDefinitionConfig_basic(rName, "fields @timestamp, @message"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCloudWatchQueryDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchQueryDefinition_Update(t *testing.T) {
+	resourceName := "aws_cloudwatch_query_definition.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	rNameUpdated := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchQueryDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchQueryDefinitionConfig_basic(rName, "fields @timestamp, @message"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "fields @timestamp, @message"),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchQueryDefinitionConfig_basic(rNameUpdated, "fields @timestamp, @message | limit 10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "query_string", "fields @timestamp, @message | limit 10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchQueryDefinition_LogGroupNames(t *testing.T) {
+	resourceName := "aws_cloudwatch_query_definition.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchQueryDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchQueryDefinitionConfig_logGroupNames(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_group_names.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_names.0", "aws_cloudwatch_log_group.test.0", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCloudWatchQueryDefinitionConfig_logGroupNames(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log_group_names.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_names.0", "aws_cloudwatch_log_group.test.0", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "log_group_names.1", "aws_cloudwatch_log_group.test.1", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudWatchQueryDefinitionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_query_definition" {
+			continue
+		}
+
+		queryDefinition, err := finder.QueryDefinitionByID(conn, rs.Primary.Attributes["name"], rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading CloudWatch Logs Insights Query Definition (%s): %w", rs.Primary.ID, err)
+		}
+
+		if queryDefinition != nil {
+			return fmt.Errorf("CloudWatch Logs Insights Query Definition (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCloudWatchQueryDefinitionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchlogsconn
+
+		queryDefinition, err := finder.QueryDefinitionByID(conn, rs.Primary.Attributes["name"], rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading CloudWatch Logs Insights Query Definition (%s): %w", rs.Primary.ID, err)
+		}
+
+		if queryDefinition == nil {
+			return fmt.Errorf("CloudWatch Logs Insights Query Definition (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSCloudWatchQueryDefinitionConfig_basic(rName, query string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_query_definition" "test" {
+  name         = %[1]q
+  query_string = %[2]q
+}
+`, rName, query)
+}
+
+func testAccAWSCloudWatchQueryDefinitionConfig_logGroupNames(rName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  count = %[2]d
+  name  = "%[1]s-${count.index}"
+}
+
+resource "aws_cloudwatch_query_definition" "test" {
+  name            = %[1]q
+  log_group_names = aws_cloudwatch_log_group.test[*].name
+  query_string    = "fields @timestamp, @message | sort @timestamp desc | limit 20"
+}
+`, rName, count)
+}
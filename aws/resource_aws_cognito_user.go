@@ -0,0 +1,509 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfcognitoidentityprovider "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider/finder"
+)
+
+const (
+	cognitoUserAttributeNameEmailVerified       = "email_verified"
+	cognitoUserAttributeNamePhoneNumberVerified = "phone_number_verified"
+	cognitoUserAttributeNameSub                 = "sub"
+)
+
+func resourceAwsCognitoUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserCreate,
+		Read:   resourceAwsCognitoUserRead,
+		Update: resourceAwsCognitoUserUpdate,
+		Delete: resourceAwsCognitoUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_AdminCreateUser.html
+		Schema: map[string]*schema.Schema{
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"client_metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"desired_delivery_mediums": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						cognitoidentityprovider.DeliveryMediumTypeSms,
+						cognitoidentityprovider.DeliveryMediumTypeEmail,
+					}, false),
+				},
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"force_alias_creation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCognitoUserGroupName,
+				},
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message_action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					cognitoidentityprovider.MessageActionTypeResend,
+					cognitoidentityprovider.MessageActionTypeSuppress,
+				}, false),
+			},
+			"mfa_setting_list": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringLenBetween(6, 256),
+				ConflictsWith: []string{"temporary_password"},
+			},
+			"preferred_mfa_setting": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sub": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"temporary_password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringLenBetween(6, 256),
+				ConflictsWith: []string{"password"},
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID := d.Get("user_pool_id").(string)
+	username := d.Get("username").(string)
+
+	input := &cognitoidentityprovider.AdminCreateUserInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String(username),
+	}
+
+	if v, ok := d.GetOk("attributes"); ok && len(v.(map[string]interface{})) > 0 {
+		input.UserAttributes = expandCognitoUserAttributes(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("client_metadata"); ok && len(v.(map[string]interface{})) > 0 {
+		input.ClientMetadata = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("desired_delivery_mediums"); ok && v.(*schema.Set).Len() > 0 {
+		input.DesiredDeliveryMediums = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("force_alias_creation"); ok {
+		input.ForceAliasCreation = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("message_action"); ok {
+		input.MessageAction = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("temporary_password"); ok {
+		input.TemporaryPassword = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Cognito User (%s) in User Pool (%s)", username, userPoolID)
+	_, err := conn.AdminCreateUser(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Cognito User (%s) in User Pool (%s): %w", username, userPoolID, err)
+	}
+
+	d.SetId(tfcognitoidentityprovider.UserCreateID(userPoolID, username))
+
+	if v, ok := d.GetOk("password"); ok {
+		if err := cognitoUserSetPassword(conn, userPoolID, username, v.(string), true); err != nil {
+			return fmt.Errorf("error setting Cognito User (%s) password: %w", d.Id(), err)
+		}
+	}
+
+	if !d.Get("enabled").(bool) {
+		if err := cognitoUserSetEnabled(conn, userPoolID, username, false); err != nil {
+			return fmt.Errorf("error disabling Cognito User (%s): %w", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("groups"); ok && v.(*schema.Set).Len() > 0 {
+		if err := cognitoUserUpdateGroups(conn, userPoolID, username, nil, expandStringSet(v.(*schema.Set))); err != nil {
+			return fmt.Errorf("error adding Cognito User (%s) to groups: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCognitoUserRead(d, meta)
+}
+
+func resourceAwsCognitoUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, username, err := tfcognitoidentityprovider.UserParseID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing Cognito User ID: %w", err)
+	}
+
+	user, err := finder.UserByUserPoolIDAndUsername(conn, userPoolID, username)
+
+	if !d.IsNewResource() && (isAWSErr(err, cognitoidentityprovider.ErrCodeUserNotFoundException, "") || isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "")) {
+		log.Printf("[WARN] Cognito User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cognito User (%s): %w", d.Id(), err)
+	}
+
+	if user == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Cognito User (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Cognito User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("attributes", flattenCognitoUserAttributes(user.UserAttributes, d.Get("attributes").(map[string]interface{}))); err != nil {
+		return fmt.Errorf("error setting attributes: %w", err)
+	}
+
+	d.Set("enabled", user.Enabled)
+
+	if err := d.Set("mfa_setting_list", flattenStringSet(user.UserMFASettingList)); err != nil {
+		return fmt.Errorf("error setting mfa_setting_list: %w", err)
+	}
+
+	d.Set("preferred_mfa_setting", user.PreferredMfaSetting)
+	d.Set("status", user.UserStatus)
+	d.Set("sub", cognitoUserAttributeValue(user.UserAttributes, cognitoUserAttributeNameSub))
+	d.Set("user_pool_id", userPoolID)
+	// In user pools with username_attributes, the API returns the generated sub in place of the configured username
+	d.Set("username", username)
+
+	if user.UserCreateDate != nil {
+		d.Set("creation_date", aws.TimeValue(user.UserCreateDate).Format(time.RFC3339))
+	}
+
+	if user.UserLastModifiedDate != nil {
+		d.Set("last_modified_date", aws.TimeValue(user.UserLastModifiedDate).Format(time.RFC3339))
+	}
+
+	groupNames, err := finder.GroupNamesByUserPoolIDAndUsername(conn, userPoolID, username)
+
+	if err != nil {
+		return fmt.Errorf("error listing groups for Cognito User (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("groups", groupNames); err != nil {
+		return fmt.Errorf("error setting groups: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, username, err := tfcognitoidentityprovider.UserParseID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing Cognito User ID: %w", err)
+	}
+
+	if d.HasChange("attributes") {
+		o, n := d.GetChange("attributes")
+		upsert, remove := diffCognitoUserAttributes(o.(map[string]interface{}), n.(map[string]interface{}))
+
+		if len(upsert) > 0 {
+			input := &cognitoidentityprovider.AdminUpdateUserAttributesInput{
+				UserAttributes: upsert,
+				UserPoolId:     aws.String(userPoolID),
+				Username:       aws.String(username),
+			}
+
+			if v, ok := d.GetOk("client_metadata"); ok && len(v.(map[string]interface{})) > 0 {
+				input.ClientMetadata = stringMapToPointers(v.(map[string]interface{}))
+			}
+
+			log.Printf("[DEBUG] Updating Cognito User (%s) attributes: %s", d.Id(), input)
+			if _, err := conn.AdminUpdateUserAttributes(input); err != nil {
+				return fmt.Errorf("error updating Cognito User (%s) attributes: %w", d.Id(), err)
+			}
+		}
+
+		if len(remove) > 0 {
+			input := &cognitoidentityprovider.AdminDeleteUserAttributesInput{
+				UserAttributeNames: remove,
+				UserPoolId:         aws.String(userPoolID),
+				Username:           aws.String(username),
+			}
+
+			log.Printf("[DEBUG] Deleting Cognito User (%s) attributes: %s", d.Id(), input)
+			if _, err := conn.AdminDeleteUserAttributes(input); err != nil {
+				return fmt.Errorf("error deleting Cognito User (%s) attributes: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if err := cognitoUserSetEnabled(conn, userPoolID, username, d.Get("enabled").(bool)); err != nil {
+			return fmt.Errorf("error updating Cognito User (%s) enabled state: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("temporary_password") {
+		if v, ok := d.GetOk("temporary_password"); ok {
+			if err := cognitoUserSetPassword(conn, userPoolID, username, v.(string), false); err != nil {
+				return fmt.Errorf("error setting Cognito User (%s) temporary password: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("password") {
+		if v, ok := d.GetOk("password"); ok {
+			if err := cognitoUserSetPassword(conn, userPoolID, username, v.(string), true); err != nil {
+				return fmt.Errorf("error setting Cognito User (%s) password: %w", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("groups") {
+		o, n := d.GetChange("groups")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		if err := cognitoUserUpdateGroups(conn, userPoolID, username, expandStringSet(os.Difference(ns)), expandStringSet(ns.Difference(os))); err != nil {
+			return fmt.Errorf("error updating Cognito User (%s) groups: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsCognitoUserRead(d, meta)
+}
+
+func resourceAwsCognitoUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, username, err := tfcognitoidentityprovider.UserParseID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing Cognito User ID: %w", err)
+	}
+
+	log.Printf("[DEBUG] Deleting Cognito User: %s", d.Id())
+	_, err = conn.AdminDeleteUser(&cognitoidentityprovider.AdminDeleteUserInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String(username),
+	})
+
+	if isAWSErr(err, cognitoidentityprovider.ErrCodeUserNotFoundException, "") || isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cognito User (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func cognitoUserSetPassword(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID, username, password string, permanent bool) error {
+	input := &cognitoidentityprovider.AdminSetUserPasswordInput{
+		Password:   aws.String(password),
+		Permanent:  aws.Bool(permanent),
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String(username),
+	}
+
+	_, err := conn.AdminSetUserPassword(input)
+
+	return err
+}
+
+func cognitoUserSetEnabled(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID, username string, enabled bool) error {
+	if enabled {
+		_, err := conn.AdminEnableUser(&cognitoidentityprovider.AdminEnableUserInput{
+			UserPoolId: aws.String(userPoolID),
+			Username:   aws.String(username),
+		})
+
+		return err
+	}
+
+	_, err := conn.AdminDisableUser(&cognitoidentityprovider.AdminDisableUserInput{
+		UserPoolId: aws.String(userPoolID),
+		Username:   aws.String(username),
+	})
+
+	return err
+}
+
+func cognitoUserUpdateGroups(conn *cognitoidentityprovider.CognitoIdentityProvider, userPoolID, username string, remove, add []*string) error {
+	for _, groupName := range remove {
+		_, err := conn.AdminRemoveUserFromGroup(&cognitoidentityprovider.AdminRemoveUserFromGroupInput{
+			GroupName:  groupName,
+			UserPoolId: aws.String(userPoolID),
+			Username:   aws.String(username),
+		})
+
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error removing from group (%s): %w", aws.StringValue(groupName), err)
+		}
+	}
+
+	for _, groupName := range add {
+		_, err := conn.AdminAddUserToGroup(&cognitoidentityprovider.AdminAddUserToGroupInput{
+			GroupName:  groupName,
+			UserPoolId: aws.String(userPoolID),
+			Username:   aws.String(username),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error adding to group (%s): %w", aws.StringValue(groupName), err)
+		}
+	}
+
+	return nil
+}
+
+func expandCognitoUserAttributes(tfMap map[string]interface{}) []*cognitoidentityprovider.AttributeType {
+	apiObjects := make([]*cognitoidentityprovider.AttributeType, 0, len(tfMap))
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, &cognitoidentityprovider.AttributeType{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+// flattenCognitoUserAttributes returns the user's attributes, omitting the
+// immutable sub attribute and the verification attributes that Cognito sets
+// on its own unless they are already configured.
+func flattenCognitoUserAttributes(apiObjects []*cognitoidentityprovider.AttributeType, configured map[string]interface{}) map[string]interface{} {
+	tfMap := make(map[string]interface{})
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		name := aws.StringValue(apiObject.Name)
+
+		switch name {
+		case cognitoUserAttributeNameSub:
+			continue
+		case cognitoUserAttributeNameEmailVerified, cognitoUserAttributeNamePhoneNumberVerified:
+			if _, ok := configured[name]; !ok {
+				continue
+			}
+		}
+
+		tfMap[name] = aws.StringValue(apiObject.Value)
+	}
+
+	return tfMap
+}
+
+func diffCognitoUserAttributes(o, n map[string]interface{}) ([]*cognitoidentityprovider.AttributeType, []*string) {
+	var upsert []*cognitoidentityprovider.AttributeType
+	var remove []*string
+
+	for k, v := range n {
+		if ov, ok := o[k]; !ok || ov.(string) != v.(string) {
+			upsert = append(upsert, &cognitoidentityprovider.AttributeType{
+				Name:  aws.String(k),
+				Value: aws.String(v.(string)),
+			})
+		}
+	}
+
+	for k := range o {
+		if _, ok := n[k]; !ok {
+			remove = append(remove, aws.String(k))
+		}
+	}
+
+	return upsert, remove
+}
+
+func cognitoUserAttributeValue(apiObjects []*cognitoidentityprovider.AttributeType, name string) string {
+	for _, apiObject := range apiObjects {
+		if apiObject != nil && aws.StringValue(apiObject.Name) == name {
+			return aws.StringValue(apiObject.Value)
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfcognitoidentityprovider "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider/finder"
+)
+
+const cognitoUserPoolUICustomizationClientIDAll = "ALL"
+
+func resourceAwsCognitoUserPoolUICustomization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCognitoUserPoolUICustomizationPut,
+		Read:   resourceAwsCognitoUserPoolUICustomizationRead,
+		Update: resourceAwsCognitoUserPoolUICustomizationPut,
+		Delete: resourceAwsCognitoUserPoolUICustomizationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  cognitoUserPoolUICustomizationClientIDAll,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"css": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"css", "image_file"},
+			},
+			"css_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"image_file", "css"},
+				ValidateFunc: validation.StringIsBase64,
+			},
+			"image_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCognitoUserPoolId,
+			},
+		},
+	}
+}
+
+func resourceAwsCognitoUserPoolUICustomizationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	clientID := d.Get("client_id").(string)
+	userPoolID := d.Get("user_pool_id").(string)
+
+	input := &cognitoidentityprovider.SetUICustomizationInput{
+		ClientId:   aws.String(clientID),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	if v, ok := d.GetOk("css"); ok {
+		input.CSS = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("image_file"); ok {
+		imageFile, err := base64.StdEncoding.DecodeString(v.(string))
+
+		if err != nil {
+			return fmt.Errorf("error decoding Cognito User Pool UI customization image_file: %w", err)
+		}
+
+		input.ImageFile = imageFile
+	}
+
+	log.Printf("[DEBUG] Setting Cognito User Pool (%s) UI customization for client (%s)", userPoolID, clientID)
+	_, err := conn.SetUICustomization(input)
+
+	if err != nil {
+		return fmt.Errorf("error setting Cognito User Pool (%s) UI customization for client (%s): %w", userPoolID, clientID, err)
+	}
+
+	d.SetId(tfcognitoidentityprovider.UICustomizationCreateID(userPoolID, clientID))
+
+	return resourceAwsCognitoUserPoolUICustomizationRead(d, meta)
+}
+
+func resourceAwsCognitoUserPoolUICustomizationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, clientID, err := tfcognitoidentityprovider.UICustomizationParseID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing Cognito User Pool UI customization ID: %w", err)
+	}
+
+	uiCustomization, err := finder.UICustomizationByUserPoolIDAndClientID(conn, userPoolID, clientID)
+
+	if !d.IsNewResource() && isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Cognito User Pool UI customization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cognito User Pool UI customization (%s): %w", d.Id(), err)
+	}
+
+	if uiCustomization == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Cognito User Pool UI customization (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Cognito User Pool UI customization (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("client_id", uiCustomization.ClientId)
+	d.Set("css", uiCustomization.CSS)
+	d.Set("css_version", uiCustomization.CSSVersion)
+	d.Set("image_url", uiCustomization.ImageUrl)
+	d.Set("user_pool_id", uiCustomization.UserPoolId)
+
+	if uiCustomization.CreationDate != nil {
+		d.Set("creation_date", aws.TimeValue(uiCustomization.CreationDate).Format(time.RFC3339))
+	}
+
+	if uiCustomization.LastModifiedDate != nil {
+		d.Set("last_modified_date", aws.TimeValue(uiCustomization.LastModifiedDate).Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsCognitoUserPoolUICustomizationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+
+	userPoolID, clientID, err := tfcognitoidentityprovider.UICustomizationParseID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing Cognito User Pool UI customization ID: %w", err)
+	}
+
+	// Setting the UI customization without CSS or an image removes it.
+	input := &cognitoidentityprovider.SetUICustomizationInput{
+		ClientId:   aws.String(clientID),
+		UserPoolId: aws.String(userPoolID),
+	}
+
+	log.Printf("[DEBUG] Deleting Cognito User Pool UI customization: %s", d.Id())
+	_, err = conn.SetUICustomization(input)
+
+	if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Cognito User Pool UI customization (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
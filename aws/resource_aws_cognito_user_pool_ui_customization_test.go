@@ -0,0 +1,254 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfcognitoidentityprovider "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider/finder"
+)
+
+func TestAccAWSCognitoUserPoolUICustomization_AllClients_CSS(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_pool_ui_customization.test"
+	userPoolResourceName := "aws_cognito_user_pool.test"
+
+	css := ".label-customizable {font-weight: 400;}"
+	cssUpdated := ".label-customizable {font-weight: 100;}"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolUICustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfigAllClientsCSS(rName, css),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "client_id", "ALL"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "css", css),
+					resource.TestCheckResourceAttrSet(resourceName, "css_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_date"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_id", userPoolResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfigAllClientsCSS(rName, cssUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "css", cssUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPoolUICustomization_AllClients_ImageFile(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_pool_ui_customization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolUICustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfigAllClientsImageFile(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "client_id", "ALL"),
+					resource.TestCheckResourceAttrSet(resourceName, "image_file"),
+					resource.TestCheckResourceAttrSet(resourceName, "image_url"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_file"},
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPoolUICustomization_Client_CSS(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_pool_ui_customization.test"
+	clientResourceName := "aws_cognito_user_pool_client.test"
+
+	css := ".label-customizable {font-weight: 400;}"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolUICustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfigClientCSS(rName, css),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "client_id", clientResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "css", css),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPoolUICustomization_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_pool_ui_customization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolUICustomizationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolUICustomizationConfigAllClientsCSS(rName, ".label-customizable {font-weight: 400;}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCognitoUserPoolUICustomization(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserPoolUICustomizationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user_pool_ui_customization" {
+			continue
+		}
+
+		userPoolID, clientID, err := tfcognitoidentityprovider.UICustomizationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := finder.UICustomizationByUserPoolIDAndClientID(conn, userPoolID, clientID)
+
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Cognito User Pool UI customization (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCognitoUserPoolUICustomizationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		userPoolID, clientID, err := tfcognitoidentityprovider.UICustomizationParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		output, err := finder.UICustomizationByUserPoolIDAndClientID(conn, userPoolID, clientID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Cognito User Pool UI customization (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_pool_domain" "test" {
+  domain       = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+`, rName)
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfigAllClientsCSS(rName, css string) string {
+	return composeConfig(
+		testAccAWSCognitoUserPoolUICustomizationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user_pool_ui_customization" "test" {
+  css = %[1]q
+
+  # Refer to the aws_cognito_user_pool_domain resource's
+  # user_pool_id attribute to ensure it is in an 'Active' state
+  user_pool_id = aws_cognito_user_pool_domain.test.user_pool_id
+}
+`, css))
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfigAllClientsImageFile(rName string) string {
+	return composeConfig(
+		testAccAWSCognitoUserPoolUICustomizationConfigBase(rName),
+		`
+resource "aws_cognito_user_pool_ui_customization" "test" {
+  image_file = filebase64("test-fixtures/cognito_user_pool_ui_customization_logo.png")
+
+  # Refer to the aws_cognito_user_pool_domain resource's
+  # user_pool_id attribute to ensure it is in an 'Active' state
+  user_pool_id = aws_cognito_user_pool_domain.test.user_pool_id
+}
+`)
+}
+
+func testAccAWSCognitoUserPoolUICustomizationConfigClientCSS(rName, css string) string {
+	return composeConfig(
+		testAccAWSCognitoUserPoolUICustomizationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user_pool_client" "test" {
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_user_pool_ui_customization" "test" {
+  client_id = aws_cognito_user_pool_client.test.id
+  css       = %[2]q
+
+  # Refer to the aws_cognito_user_pool_domain resource's
+  # user_pool_id attribute to ensure it is in an 'Active' state
+  user_pool_id = aws_cognito_user_pool_domain.test.user_pool_id
+}
+`, rName, css))
+}
@@ -0,0 +1,360 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfcognitoidentityprovider "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
+)
+
+func TestAccAWSCognitoUser_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user.test"
+	userPoolResourceName := "aws_cognito_user_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified_date"),
+					resource.TestCheckResourceAttr(resourceName, "status", cognitoidentityprovider.UserStatusTypeForceChangePassword),
+					resource.TestCheckResourceAttrSet(resourceName, "sub"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_pool_id", userPoolResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "username", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"message_action",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUser_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCognitoUser(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUser_Attributes(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserConfigAttributes(rName, "test@example.com", "Test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.email", "test@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.given_name", "Test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"message_action",
+				},
+			},
+			{
+				Config: testAccAWSCognitoUserConfigAttributes(rName, "updated@example.com", "Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.email", "updated@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.given_name", "Updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUser_Enabled(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserConfigEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserConfigEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUser_Password(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserConfigTemporaryPassword(rName, "Terraform-test-1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", cognitoidentityprovider.UserStatusTypeForceChangePassword),
+				),
+			},
+			{
+				Config: testAccAWSCognitoUserConfigPassword(rName, "Terraform-test-2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", cognitoidentityprovider.UserStatusTypeConfirmed),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUser_Groups(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserConfigGroups(rName, "aws_cognito_user_group.test1.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttrPair(resourceName, "groups.*", "aws_cognito_user_group.test1", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"message_action",
+				},
+			},
+			{
+				Config: testAccAWSCognitoUserConfigGroups(rName, "aws_cognito_user_group.test2.name"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttrPair(resourceName, "groups.*", "aws_cognito_user_group.test2", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCognitoUserDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cognito_user" {
+			continue
+		}
+
+		userPoolID, username, err := tfcognitoidentityprovider.UserParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.UserByUserPoolIDAndUsername(conn, userPoolID, username)
+
+		if isAWSErr(err, cognitoidentityprovider.ErrCodeUserNotFoundException, "") || isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cognito User (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCognitoUserExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		userPoolID, username, err := tfcognitoidentityprovider.UserParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
+
+		output, err := finder.UserByUserPoolIDAndUsername(conn, userPoolID, username)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Cognito User (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSCognitoUserConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSCognitoUserConfigBasic(rName string) string {
+	return composeConfig(
+		testAccAWSCognitoUserConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user" "test" {
+  message_action = "SUPPRESS"
+  user_pool_id   = aws_cognito_user_pool.test.id
+  username       = %[1]q
+}
+`, rName))
+}
+
+func testAccAWSCognitoUserConfigAttributes(rName, email, givenName string) string {
+	return composeConfig(
+		testAccAWSCognitoUserConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user" "test" {
+  message_action = "SUPPRESS"
+  user_pool_id   = aws_cognito_user_pool.test.id
+  username       = %[1]q
+
+  attributes = {
+    email      = %[2]q
+    given_name = %[3]q
+  }
+}
+`, rName, email, givenName))
+}
+
+func testAccAWSCognitoUserConfigEnabled(rName string, enabled bool) string {
+	return composeConfig(
+		testAccAWSCognitoUserConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user" "test" {
+  enabled        = %[2]t
+  message_action = "SUPPRESS"
+  user_pool_id   = aws_cognito_user_pool.test.id
+  username       = %[1]q
+}
+`, rName, enabled))
+}
+
+func testAccAWSCognitoUserConfigTemporaryPassword(rName, password string) string {
+	return composeConfig(
+		testAccAWSCognitoUserConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user" "test" {
+  message_action     = "SUPPRESS"
+  temporary_password = %[2]q
+  user_pool_id       = aws_cognito_user_pool.test.id
+  username           = %[1]q
+}
+`, rName, password))
+}
+
+func testAccAWSCognitoUserConfigPassword(rName, password string) string {
+	return composeConfig(
+		testAccAWSCognitoUserConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user" "test" {
+  message_action = "SUPPRESS"
+  password       = %[2]q
+  user_pool_id   = aws_cognito_user_pool.test.id
+  username       = %[1]q
+}
+`, rName, password))
+}
+
+func testAccAWSCognitoUserConfigGroups(rName, groupName string) string {
+	return composeConfig(
+		testAccAWSCognitoUserConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_cognito_user_group" "test1" {
+  name         = "%[1]s-1"
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_user_group" "test2" {
+  name         = "%[1]s-2"
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_user" "test" {
+  groups         = [%[2]s]
+  message_action = "SUPPRESS"
+  user_pool_id   = aws_cognito_user_pool.test.id
+  username       = %[1]q
+}
+`, rName, groupName))
+}
@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsConfigOrganizationConformancePack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConfigOrganizationConformancePackCreate,
+		Read:   resourceAwsConfigOrganizationConformancePackRead,
+		Update: resourceAwsConfigOrganizationConformancePackUpdate,
+		Delete: resourceAwsConfigOrganizationConformancePackDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_s3_bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			"delivery_s3_key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"excluded_accounts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1000,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateAwsAccountId,
+				},
+			},
+			"input_parameter": configConformancePackInputParameterSchema(),
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]*$`), "must be a valid conformance pack name"),
+				),
+			},
+			"template_body": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"template_body", "template_s3_uri"},
+				DiffSuppressFunc: suppressEquivalentJsonOrYamlDiffs,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 51200),
+					validateStringIsJsonOrYaml,
+				),
+			},
+			"template_s3_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"template_body", "template_s3_uri"},
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 1024),
+					validation.StringMatch(regexp.MustCompile(`^s3://`), "must begin with s3://"),
+				),
+			},
+		},
+	}
+}
+
+func resourceAwsConfigOrganizationConformancePackCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+	name := d.Get("name").(string)
+
+	input := expandConfigPutOrganizationConformancePackInput(d)
+
+	log.Printf("[DEBUG] Creating Config Organization Conformance Pack: %s", input)
+	_, err := conn.PutOrganizationConformancePack(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Config Organization Conformance Pack (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := configWaitForOrganizationConformancePackStatusCreateSuccessful(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Config Organization Conformance Pack (%s) creation: %w", d.Id(), err)
+	}
+
+	return resourceAwsConfigOrganizationConformancePackRead(d, meta)
+}
+
+func resourceAwsConfigOrganizationConformancePackRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	pack, err := configDescribeOrganizationConformancePack(conn, d.Id())
+
+	if !d.IsNewResource() && isAWSErr(err, configservice.ErrCodeNoSuchOrganizationConformancePackException, "") {
+		log.Printf("[WARN] Config Organization Conformance Pack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error describing Config Organization Conformance Pack (%s): %w", d.Id(), err)
+	}
+
+	if pack == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error describing Config Organization Conformance Pack (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] Config Organization Conformance Pack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", pack.OrganizationConformancePackArn)
+	d.Set("delivery_s3_bucket", pack.DeliveryS3Bucket)
+	d.Set("delivery_s3_key_prefix", pack.DeliveryS3KeyPrefix)
+	d.Set("name", pack.OrganizationConformancePackName)
+
+	if err := d.Set("excluded_accounts", aws.StringValueSlice(pack.ExcludedAccounts)); err != nil {
+		return fmt.Errorf("error setting excluded_accounts: %w", err)
+	}
+
+	if err := d.Set("input_parameter", flattenConfigConformancePackInputParameters(pack.ConformancePackInputParameters)); err != nil {
+		return fmt.Errorf("error setting input_parameter: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsConfigOrganizationConformancePackUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	input := expandConfigPutOrganizationConformancePackInput(d)
+
+	log.Printf("[DEBUG] Updating Config Organization Conformance Pack: %s", input)
+	_, err := conn.PutOrganizationConformancePack(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Config Organization Conformance Pack (%s): %w", d.Id(), err)
+	}
+
+	if err := configWaitForOrganizationConformancePackStatusUpdateSuccessful(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Config Organization Conformance Pack (%s) update: %w", d.Id(), err)
+	}
+
+	return resourceAwsConfigOrganizationConformancePackRead(d, meta)
+}
+
+func resourceAwsConfigOrganizationConformancePackDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	input := &configservice.DeleteOrganizationConformancePackInput{
+		OrganizationConformancePackName: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Config Organization Conformance Pack: %s", d.Id())
+	_, err := conn.DeleteOrganizationConformancePack(input)
+
+	if isAWSErr(err, configservice.ErrCodeNoSuchOrganizationConformancePackException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Config Organization Conformance Pack (%s): %w", d.Id(), err)
+	}
+
+	if err := configWaitForOrganizationConformancePackStatusDeleteSuccessful(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Config Organization Conformance Pack (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConfigPutOrganizationConformancePackInput(d *schema.ResourceData) *configservice.PutOrganizationConformancePackInput {
+	input := &configservice.PutOrganizationConformancePackInput{
+		DeliveryS3Bucket:                aws.String(d.Get("delivery_s3_bucket").(string)),
+		OrganizationConformancePackName: aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("delivery_s3_key_prefix"); ok {
+		input.DeliveryS3KeyPrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("excluded_accounts"); ok && v.(*schema.Set).Len() > 0 {
+		input.ExcludedAccounts = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("input_parameter"); ok && v.(*schema.Set).Len() > 0 {
+		input.ConformancePackInputParameters = expandConfigConformancePackInputParameters(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("template_body"); ok {
+		input.TemplateBody = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("template_s3_uri"); ok {
+		input.TemplateS3Uri = aws.String(v.(string))
+	}
+
+	return input
+}
@@ -0,0 +1,378 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsConfigRemediationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsConfigRemediationConfigurationPut,
+		Read:   resourceAwsConfigRemediationConfigurationRead,
+		Update: resourceAwsConfigRemediationConfigurationPut,
+		Delete: resourceAwsConfigRemediationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"automatic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"config_rule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"execution_controls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ssm_controls": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"concurrent_execution_rate_percentage": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+									},
+									"error_percentage": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"maximum_automatic_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 25),
+			},
+			"parameter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(0, 256),
+						},
+						"resource_value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(configservice.ResourceValueType_Values(), false),
+						},
+						"static_values": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 25,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 256),
+							},
+						},
+					},
+				},
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"retry_attempt_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 2678000),
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"target_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(configservice.RemediationTargetType_Values(), false),
+			},
+			"target_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsConfigRemediationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+	name := d.Get("config_rule_name").(string)
+
+	remediationConfiguration := &configservice.RemediationConfiguration{
+		ConfigRuleName: aws.String(name),
+		TargetId:       aws.String(d.Get("target_id").(string)),
+		TargetType:     aws.String(d.Get("target_type").(string)),
+	}
+
+	if v, ok := d.GetOk("automatic"); ok {
+		remediationConfiguration.Automatic = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("execution_controls"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		remediationConfiguration.ExecutionControls = expandConfigRemediationExecutionControls(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("maximum_automatic_attempts"); ok {
+		remediationConfiguration.MaximumAutomaticAttempts = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("parameter"); ok && v.(*schema.Set).Len() > 0 {
+		remediationConfiguration.Parameters = expandConfigRemediationParameterValues(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		remediationConfiguration.ResourceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("retry_attempt_seconds"); ok {
+		remediationConfiguration.RetryAttemptSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("target_version"); ok {
+		remediationConfiguration.TargetVersion = aws.String(v.(string))
+	}
+
+	input := &configservice.PutRemediationConfigurationsInput{
+		RemediationConfigurations: []*configservice.RemediationConfiguration{remediationConfiguration},
+	}
+
+	log.Printf("[DEBUG] Putting Config Remediation Configuration: %s", input)
+	output, err := conn.PutRemediationConfigurations(input)
+
+	if err != nil {
+		return fmt.Errorf("error putting Config Remediation Configuration (%s): %w", name, err)
+	}
+
+	if output != nil && len(output.FailedBatches) > 0 {
+		var failureMessages []string
+
+		for _, failedBatch := range output.FailedBatches {
+			failureMessages = append(failureMessages, aws.StringValue(failedBatch.FailureMessage))
+		}
+
+		return fmt.Errorf("error putting Config Remediation Configuration (%s): %s", name, strings.Join(failureMessages, ", "))
+	}
+
+	d.SetId(name)
+
+	return resourceAwsConfigRemediationConfigurationRead(d, meta)
+}
+
+func resourceAwsConfigRemediationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	remediationConfiguration, err := configDescribeRemediationConfiguration(conn, d.Id())
+
+	if !d.IsNewResource() && isAWSErr(err, configservice.ErrCodeNoSuchConfigRuleException, "") {
+		log.Printf("[WARN] Config Remediation Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error describing Config Remediation Configuration (%s): %w", d.Id(), err)
+	}
+
+	if remediationConfiguration == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error describing Config Remediation Configuration (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] Config Remediation Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", remediationConfiguration.Arn)
+	d.Set("automatic", remediationConfiguration.Automatic)
+	d.Set("config_rule_name", remediationConfiguration.ConfigRuleName)
+
+	if err := d.Set("execution_controls", flattenConfigRemediationExecutionControls(remediationConfiguration.ExecutionControls)); err != nil {
+		return fmt.Errorf("error setting execution_controls: %w", err)
+	}
+
+	d.Set("maximum_automatic_attempts", remediationConfiguration.MaximumAutomaticAttempts)
+
+	if err := d.Set("parameter", flattenConfigRemediationParameterValues(remediationConfiguration.Parameters)); err != nil {
+		return fmt.Errorf("error setting parameter: %w", err)
+	}
+
+	d.Set("resource_type", remediationConfiguration.ResourceType)
+	d.Set("retry_attempt_seconds", remediationConfiguration.RetryAttemptSeconds)
+	d.Set("target_id", remediationConfiguration.TargetId)
+	d.Set("target_type", remediationConfiguration.TargetType)
+	d.Set("target_version", remediationConfiguration.TargetVersion)
+
+	return nil
+}
+
+func resourceAwsConfigRemediationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).configconn
+
+	input := &configservice.DeleteRemediationConfigurationInput{
+		ConfigRuleName: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("resource_type"); ok {
+		input.ResourceType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Deleting Config Remediation Configuration: %s", d.Id())
+	_, err := conn.DeleteRemediationConfiguration(input)
+
+	if isAWSErr(err, configservice.ErrCodeNoSuchRemediationConfigurationException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Config Remediation Configuration (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandConfigRemediationExecutionControls(tfMap map[string]interface{}) *configservice.ExecutionControls {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &configservice.ExecutionControls{}
+
+	if v, ok := tfMap["ssm_controls"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SsmControls = expandConfigRemediationSsmControls(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandConfigRemediationSsmControls(tfMap map[string]interface{}) *configservice.SsmControls {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &configservice.SsmControls{}
+
+	if v, ok := tfMap["concurrent_execution_rate_percentage"].(int); ok && v != 0 {
+		apiObject.ConcurrentExecutionRatePercentage = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["error_percentage"].(int); ok && v != 0 {
+		apiObject.ErrorPercentage = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandConfigRemediationParameterValues(tfList []interface{}) map[string]*configservice.RemediationParameterValue {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make(map[string]*configservice.RemediationParameterValue)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &configservice.RemediationParameterValue{}
+
+		if v, ok := tfMap["resource_value"].(string); ok && v != "" {
+			apiObject.ResourceValue = &configservice.ResourceValue{
+				Value: aws.String(v),
+			}
+		}
+
+		if v, ok := tfMap["static_values"].([]interface{}); ok && len(v) > 0 {
+			apiObject.StaticValue = &configservice.StaticValue{
+				Values: expandStringList(v),
+			}
+		}
+
+		apiObjects[tfMap["name"].(string)] = apiObject
+	}
+
+	return apiObjects
+}
+
+func flattenConfigRemediationExecutionControls(apiObject *configservice.ExecutionControls) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SsmControls; v != nil {
+		tfMap["ssm_controls"] = []interface{}{
+			map[string]interface{}{
+				"concurrent_execution_rate_percentage": aws.Int64Value(v.ConcurrentExecutionRatePercentage),
+				"error_percentage":                     aws.Int64Value(v.ErrorPercentage),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenConfigRemediationParameterValues(apiObjects map[string]*configservice.RemediationParameterValue) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for name, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": name,
+		}
+
+		if v := apiObject.ResourceValue; v != nil {
+			tfMap["resource_value"] = aws.StringValue(v.Value)
+		}
+
+		if v := apiObject.StaticValue; v != nil {
+			tfMap["static_values"] = aws.StringValueSlice(v.Values)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
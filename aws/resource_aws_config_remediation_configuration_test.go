@@ -0,0 +1,253 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
+)
+
+func testAccConfigRemediationConfiguration_basic(t *testing.T) {
+	var rc configservice.RemediationConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_remediation_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigRemediationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRemediationConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRemediationConfigurationExists(resourceName, &rc),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "config", regexp.MustCompile(`remediation-configuration/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "automatic", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "config_rule_name", "aws_config_config_rule.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "3"),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":           "Message",
+						"resource_value": "RESOURCE_ID",
+					}),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":            "AutomationAssumeRole",
+						"static_values.#": "1",
+					}),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":            "TopicArn",
+						"static_values.#": "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "AWS::S3::Bucket"),
+					resource.TestCheckResourceAttr(resourceName, "target_id", "AWS-PublishSNSNotification"),
+					resource.TestCheckResourceAttr(resourceName, "target_type", "SSM_DOCUMENT"),
+					resource.TestCheckResourceAttr(resourceName, "target_version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConfigRemediationConfiguration_disappears(t *testing.T) {
+	var rc configservice.RemediationConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_remediation_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigRemediationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRemediationConfigurationConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRemediationConfigurationExists(resourceName, &rc),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsConfigRemediationConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccConfigRemediationConfiguration_Automatic(t *testing.T) {
+	var rc configservice.RemediationConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_config_remediation_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckConfigRemediationConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigRemediationConfigurationConfig_automatic(rName, 5, 60, 25, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRemediationConfigurationExists(resourceName, &rc),
+					resource.TestCheckResourceAttr(resourceName, "automatic", "true"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.0.ssm_controls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.0.ssm_controls.0.concurrent_execution_rate_percentage", "25"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.0.ssm_controls.0.error_percentage", "20"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_automatic_attempts", "5"),
+					resource.TestCheckResourceAttr(resourceName, "retry_attempt_seconds", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigRemediationConfigurationConfig_automatic(rName, 10, 300, 50, 40),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigRemediationConfigurationExists(resourceName, &rc),
+					resource.TestCheckResourceAttr(resourceName, "automatic", "true"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.0.ssm_controls.0.concurrent_execution_rate_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "execution_controls.0.ssm_controls.0.error_percentage", "40"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_automatic_attempts", "10"),
+					resource.TestCheckResourceAttr(resourceName, "retry_attempt_seconds", "300"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckConfigRemediationConfigurationExists(resourceName string, rc *configservice.RemediationConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).configconn
+
+		output, err := configDescribeRemediationConfiguration(conn, rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error describing Config Remediation Configuration (%s): %w", rs.Primary.ID, err)
+		}
+
+		if output == nil {
+			return fmt.Errorf("Config Remediation Configuration (%s) not found", rs.Primary.ID)
+		}
+
+		*rc = *output
+
+		return nil
+	}
+}
+
+func testAccCheckConfigRemediationConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).configconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_config_remediation_configuration" {
+			continue
+		}
+
+		rc, err := configDescribeRemediationConfiguration(conn, rs.Primary.ID)
+
+		if isAWSErr(err, configservice.ErrCodeNoSuchConfigRuleException, "") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error describing Config Remediation Configuration (%s): %w", rs.Primary.ID, err)
+		}
+
+		if rc != nil {
+			return fmt.Errorf("Config Remediation Configuration (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccConfigRemediationConfigurationConfigBase(rName string) string {
+	return composeConfig(
+		testAccConfigConfigRuleConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccConfigRemediationConfigurationConfig_basic(rName string) string {
+	return composeConfig(
+		testAccConfigRemediationConfigurationConfigBase(rName),
+		`
+resource "aws_config_remediation_configuration" "test" {
+  config_rule_name = aws_config_config_rule.test.name
+  resource_type    = "AWS::S3::Bucket"
+  target_id        = "AWS-PublishSNSNotification"
+  target_type      = "SSM_DOCUMENT"
+  target_version   = "1"
+
+  parameter {
+    name          = "AutomationAssumeRole"
+    static_values = [aws_iam_role.test.arn]
+  }
+
+  parameter {
+    name           = "Message"
+    resource_value = "RESOURCE_ID"
+  }
+
+  parameter {
+    name          = "TopicArn"
+    static_values = [aws_sns_topic.test.arn]
+  }
+}
+`)
+}
+
+func testAccConfigRemediationConfigurationConfig_automatic(rName string, attempts, seconds, concurrency, errorPercentage int) string {
+	return composeConfig(
+		testAccConfigRemediationConfigurationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_config_remediation_configuration" "test" {
+  automatic                  = true
+  config_rule_name           = aws_config_config_rule.test.name
+  maximum_automatic_attempts = %[1]d
+  resource_type              = "AWS::S3::Bucket"
+  retry_attempt_seconds      = %[2]d
+  target_id                  = "AWS-PublishSNSNotification"
+  target_type                = "SSM_DOCUMENT"
+  target_version             = "1"
+
+  execution_controls {
+    ssm_controls {
+      concurrent_execution_rate_percentage = %[3]d
+      error_percentage                     = %[4]d
+    }
+  }
+
+  parameter {
+    name          = "AutomationAssumeRole"
+    static_values = [aws_iam_role.test.arn]
+  }
+
+  parameter {
+    name           = "Message"
+    resource_value = "RESOURCE_ID"
+  }
+
+  parameter {
+    name          = "TopicArn"
+    static_values = [aws_sns_topic.test.arn]
+  }
+}
+`, attempts, seconds, concurrency, errorPercentage))
+}
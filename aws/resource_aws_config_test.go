@@ -17,6 +17,13 @@ func TestAccAWSConfig_serial(t *testing.T) {
 			"scopeTagValue":    testAccConfigConfigRule_Scope_TagValue,
 			"tags":             testAccConfigConfigRule_tags,
 		},
+		"ConformancePack": {
+			"basic":               testAccConfigConformancePack_basic,
+			"disappears":          testAccConfigConformancePack_disappears,
+			"DeliveryS3KeyPrefix": testAccConfigConformancePack_DeliveryS3KeyPrefix,
+			"InputParameters":     testAccConfigConformancePack_InputParameters,
+			"TemplateS3Uri":       testAccConfigConformancePack_TemplateS3Uri,
+		},
 		"ConfigurationRecorderStatus": {
 			"basic":        testAccConfigConfigurationRecorderStatus_basic,
 			"startEnabled": testAccConfigConfigurationRecorderStatus_startEnabled,
@@ -32,6 +39,12 @@ func TestAccAWSConfig_serial(t *testing.T) {
 			"allParams":   testAccConfigDeliveryChannel_allParams,
 			"importBasic": testAccConfigDeliveryChannel_importBasic,
 		},
+		"OrganizationConformancePack": {
+			"basic":            testAccConfigOrganizationConformancePack_basic,
+			"disappears":       testAccConfigOrganizationConformancePack_disappears,
+			"ExcludedAccounts": testAccConfigOrganizationConformancePack_ExcludedAccounts,
+			"InputParameters":  testAccConfigOrganizationConformancePack_InputParameters,
+		},
 		"OrganizationCustomRule": {
 			"basic":                     testAccConfigOrganizationCustomRule_basic,
 			"disappears":                testAccConfigOrganizationCustomRule_disappears,
@@ -61,6 +74,11 @@ func TestAccAWSConfig_serial(t *testing.T) {
 			"TagKeyScope":               testAccConfigOrganizationManagedRule_TagKeyScope,
 			"TagValueScope":             testAccConfigOrganizationManagedRule_TagValueScope,
 		},
+		"RemediationConfiguration": {
+			"basic":      testAccConfigRemediationConfiguration_basic,
+			"disappears": testAccConfigRemediationConfiguration_disappears,
+			"Automatic":  testAccConfigRemediationConfiguration_Automatic,
+		},
 	}
 
 	for group, m := range testCases {
@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
+)
+
+const rdsDbProxyDefaultTargetGroupName = "default"
+
+func resourceAwsDbProxyDefaultTargetGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDbProxyDefaultTargetGroupCreate,
+		Read:   resourceAwsDbProxyDefaultTargetGroupRead,
+		Update: resourceAwsDbProxyDefaultTargetGroupUpdate,
+		Delete: schema.Noop,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_proxy_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRdsIdentifier,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_pool_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_borrow_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      120,
+							ValidateFunc: validation.IntBetween(0, 3600),
+						},
+						"init_query": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"max_connections_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      100,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"max_idle_connections_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      50,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"session_pinning_filters": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								// This isn't available as a constant
+								ValidateFunc: validation.StringInSlice([]string{
+									"EXCLUDE_VARIABLE_SETS",
+								}, false),
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsDbProxyDefaultTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("db_proxy_name").(string))
+
+	return resourceAwsDbProxyDefaultTargetGroupCreateUpdate(d, meta, schema.TimeoutCreate)
+}
+
+func resourceAwsDbProxyDefaultTargetGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	return resourceAwsDbProxyDefaultTargetGroupCreateUpdate(d, meta, schema.TimeoutUpdate)
+}
+
+func resourceAwsDbProxyDefaultTargetGroupCreateUpdate(d *schema.ResourceData, meta interface{}, timeout string) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	input := &rds.ModifyDBProxyTargetGroupInput{
+		DBProxyName:     aws.String(d.Id()),
+		TargetGroupName: aws.String(rdsDbProxyDefaultTargetGroupName),
+	}
+
+	if v, ok := d.GetOk("connection_pool_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ConnectionPoolConfig = expandDbProxyConnectionPoolConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating RDS DB Proxy (%s) default target group: %s", d.Id(), input)
+	_, err := conn.ModifyDBProxyTargetGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating RDS DB Proxy (%s) default target group: %w", d.Id(), err)
+	}
+
+	stateChangeConf := &resource.StateChangeConf{
+		Pending: []string{rds.DBProxyStatusModifying},
+		Target:  []string{rds.DBProxyStatusAvailable},
+		Refresh: resourceAwsDbProxyRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(timeout),
+	}
+
+	if _, err := stateChangeConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for RDS DB Proxy (%s) default target group update: %w", d.Id(), err)
+	}
+
+	return resourceAwsDbProxyDefaultTargetGroupRead(d, meta)
+}
+
+func resourceAwsDbProxyDefaultTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	tg, err := finder.DBProxyTargetGroupByDBProxyNameAndTargetGroupName(conn, d.Id(), rdsDbProxyDefaultTargetGroupName)
+
+	if !d.IsNewResource() && (isAWSErr(err, rds.ErrCodeDBProxyNotFoundFault, "") || isAWSErr(err, rds.ErrCodeDBProxyTargetGroupNotFoundFault, "")) {
+		log.Printf("[WARN] RDS DB Proxy (%s) default target group not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Proxy (%s) default target group: %w", d.Id(), err)
+	}
+
+	if tg == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading RDS DB Proxy (%s) default target group: not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] RDS DB Proxy (%s) default target group not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", tg.TargetGroupArn)
+	d.Set("db_proxy_name", tg.DBProxyName)
+	d.Set("name", tg.TargetGroupName)
+
+	if err := d.Set("connection_pool_config", flattenDbProxyConnectionPoolConfigInfo(tg.ConnectionPoolConfig)); err != nil {
+		return fmt.Errorf("error setting connection_pool_config: %w", err)
+	}
+
+	return nil
+}
+
+func expandDbProxyConnectionPoolConfig(tfMap map[string]interface{}) *rds.ConnectionPoolConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &rds.ConnectionPoolConfiguration{
+		ConnectionBorrowTimeout:   aws.Int64(int64(tfMap["connection_borrow_timeout"].(int))),
+		InitQuery:                 aws.String(tfMap["init_query"].(string)),
+		MaxConnectionsPercent:     aws.Int64(int64(tfMap["max_connections_percent"].(int))),
+		MaxIdleConnectionsPercent: aws.Int64(int64(tfMap["max_idle_connections_percent"].(int))),
+		SessionPinningFilters:     expandStringSet(tfMap["session_pinning_filters"].(*schema.Set)),
+	}
+
+	return apiObject
+}
+
+func flattenDbProxyConnectionPoolConfigInfo(apiObject *rds.ConnectionPoolConfigurationInfo) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"connection_borrow_timeout":    aws.Int64Value(apiObject.ConnectionBorrowTimeout),
+		"init_query":                   aws.StringValue(apiObject.InitQuery),
+		"max_connections_percent":      aws.Int64Value(apiObject.MaxConnectionsPercent),
+		"max_idle_connections_percent": aws.Int64Value(apiObject.MaxIdleConnectionsPercent),
+		"session_pinning_filters":      flattenStringSet(apiObject.SessionPinningFilters),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
+)
+
+func TestAccAWSDBProxyDefaultTargetGroup_basic(t *testing.T) {
+	var dbProxyTargetGroup rds.DBProxyTargetGroup
+	resourceName := "aws_db_proxy_default_target_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyDefaultTargetGroupConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBProxyDefaultTargetGroupExists(resourceName, &dbProxyTargetGroup),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "rds", regexp.MustCompile(`target-group:.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "db_proxy_name", "aws_db_proxy.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.connection_borrow_timeout", "120"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.init_query", ""),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_connections_percent", "100"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_idle_connections_percent", "50"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.session_pinning_filters.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDBProxyDefaultTargetGroup_ConnectionPoolConfig(t *testing.T) {
+	var dbProxyTargetGroup rds.DBProxyTargetGroup
+	resourceName := "aws_db_proxy_default_target_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyDefaultTargetGroupConfigConnectionPoolConfig(rName, 90, "SET x=1, y=2", 80, 40),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBProxyDefaultTargetGroupExists(resourceName, &dbProxyTargetGroup),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.connection_borrow_timeout", "90"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.init_query", "SET x=1, y=2"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_connections_percent", "80"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_idle_connections_percent", "40"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.session_pinning_filters.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "connection_pool_config.0.session_pinning_filters.*", "EXCLUDE_VARIABLE_SETS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSDBProxyDefaultTargetGroupConfigConnectionPoolConfig(rName, 60, "SET x=2, y=3", 70, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBProxyDefaultTargetGroupExists(resourceName, &dbProxyTargetGroup),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.connection_borrow_timeout", "60"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.init_query", "SET x=2, y=3"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_connections_percent", "70"),
+					resource.TestCheckResourceAttr(resourceName, "connection_pool_config.0.max_idle_connections_percent", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDBProxyDefaultTargetGroup_disappears(t *testing.T) {
+	var v rds.DBProxy
+	resourceName := "aws_db_proxy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyDefaultTargetGroupConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBProxyExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsDbProxy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDBProxyDefaultTargetGroupExists(resourceName string, v *rds.DBProxyTargetGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+
+		output, err := finder.DBProxyTargetGroupByDBProxyNameAndTargetGroupName(conn, rs.Primary.ID, "default")
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("DB Proxy (%s) default target group not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSDBProxyDefaultTargetGroupConfigBasic(rName string) string {
+	return composeConfig(
+		testAccAWSDBProxyConfig(rName),
+		`
+resource "aws_db_proxy_default_target_group" "test" {
+  db_proxy_name = aws_db_proxy.test.name
+}
+`)
+}
+
+func testAccAWSDBProxyDefaultTargetGroupConfigConnectionPoolConfig(rName string, connectionBorrowTimeout int, initQuery string, maxConnectionsPercent, maxIdleConnectionsPercent int) string {
+	return composeConfig(
+		testAccAWSDBProxyConfig(rName),
+		fmt.Sprintf(`
+resource "aws_db_proxy_default_target_group" "test" {
+  db_proxy_name = aws_db_proxy.test.name
+
+  connection_pool_config {
+    connection_borrow_timeout    = %[1]d
+    init_query                   = %[2]q
+    max_connections_percent      = %[3]d
+    max_idle_connections_percent = %[4]d
+    session_pinning_filters      = ["EXCLUDE_VARIABLE_SETS"]
+  }
+}
+`, connectionBorrowTimeout, initQuery, maxConnectionsPercent, maxIdleConnectionsPercent))
+}
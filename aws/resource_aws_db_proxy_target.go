@@ -0,0 +1,193 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfrds "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
+)
+
+func resourceAwsDbProxyTarget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDbProxyTargetCreate,
+		Read:   resourceAwsDbProxyTargetRead,
+		Delete: resourceAwsDbProxyTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"db_cluster_identifier", "db_instance_identifier"},
+			},
+			"db_instance_identifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"db_cluster_identifier", "db_instance_identifier"},
+			},
+			"db_proxy_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRdsIdentifier,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rds_resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tracked_cluster_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsDbProxyTargetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	dbProxyName := d.Get("db_proxy_name").(string)
+	targetGroupName := d.Get("target_group_name").(string)
+
+	input := &rds.RegisterDBProxyTargetsInput{
+		DBProxyName:     aws.String(dbProxyName),
+		TargetGroupName: aws.String(targetGroupName),
+	}
+
+	var targetType, rdsResourceID string
+
+	if v, ok := d.GetOk("db_cluster_identifier"); ok {
+		input.DBClusterIdentifiers = aws.StringSlice([]string{v.(string)})
+		targetType = rds.TargetTypeTrackedCluster
+		rdsResourceID = v.(string)
+	}
+
+	if v, ok := d.GetOk("db_instance_identifier"); ok {
+		input.DBInstanceIdentifiers = aws.StringSlice([]string{v.(string)})
+		targetType = rds.TargetTypeRdsInstance
+		rdsResourceID = v.(string)
+	}
+
+	log.Printf("[DEBUG] Registering RDS DB Proxy (%s) target: %s", dbProxyName, input)
+	_, err := conn.RegisterDBProxyTargets(input)
+
+	if err != nil {
+		return fmt.Errorf("error registering RDS DB Proxy (%s/%s) target: %w", dbProxyName, targetGroupName, err)
+	}
+
+	d.SetId(tfrds.ProxyTargetCreateID(dbProxyName, targetGroupName, targetType, rdsResourceID))
+
+	return resourceAwsDbProxyTargetRead(d, meta)
+}
+
+func resourceAwsDbProxyTargetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	dbProxyName, targetGroupName, targetType, rdsResourceID, err := tfrds.ProxyTargetParseID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing RDS DB Proxy Target ID: %w", err)
+	}
+
+	target, err := finder.DBProxyTarget(conn, dbProxyName, targetGroupName, targetType, rdsResourceID)
+
+	if !d.IsNewResource() && (isAWSErr(err, rds.ErrCodeDBProxyNotFoundFault, "") || isAWSErr(err, rds.ErrCodeDBProxyTargetGroupNotFoundFault, "")) {
+		log.Printf("[WARN] RDS DB Proxy Target (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Proxy Target (%s): %w", d.Id(), err)
+	}
+
+	if target == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading RDS DB Proxy Target (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] RDS DB Proxy Target (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("db_proxy_name", dbProxyName)
+	d.Set("endpoint", target.Endpoint)
+	d.Set("port", target.Port)
+	d.Set("rds_resource_id", target.RdsResourceId)
+	d.Set("target_arn", target.TargetArn)
+	d.Set("target_group_name", targetGroupName)
+	d.Set("tracked_cluster_id", target.TrackedClusterId)
+	d.Set("type", target.Type)
+
+	if aws.StringValue(target.Type) == rds.TargetTypeTrackedCluster {
+		d.Set("db_cluster_identifier", target.RdsResourceId)
+	} else {
+		d.Set("db_instance_identifier", target.RdsResourceId)
+	}
+
+	return nil
+}
+
+func resourceAwsDbProxyTargetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	dbProxyName, targetGroupName, targetType, rdsResourceID, err := tfrds.ProxyTargetParseID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing RDS DB Proxy Target ID: %w", err)
+	}
+
+	input := &rds.DeregisterDBProxyTargetsInput{
+		DBProxyName:     aws.String(dbProxyName),
+		TargetGroupName: aws.String(targetGroupName),
+	}
+
+	if targetType == rds.TargetTypeTrackedCluster {
+		input.DBClusterIdentifiers = aws.StringSlice([]string{rdsResourceID})
+	} else {
+		input.DBInstanceIdentifiers = aws.StringSlice([]string{rdsResourceID})
+	}
+
+	log.Printf("[DEBUG] Deregistering RDS DB Proxy Target: %s", input)
+	_, err = conn.DeregisterDBProxyTargets(input)
+
+	if isAWSErr(err, rds.ErrCodeDBProxyNotFoundFault, "") || isAWSErr(err, rds.ErrCodeDBProxyTargetGroupNotFoundFault, "") || isAWSErr(err, rds.ErrCodeDBProxyTargetNotFoundFault, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering RDS DB Proxy Target (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
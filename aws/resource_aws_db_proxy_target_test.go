@@ -0,0 +1,219 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfrds "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
+)
+
+func TestAccAWSDBProxyTarget_Instance(t *testing.T) {
+	var dbProxyTarget rds.DBProxyTarget
+	resourceName := "aws_db_proxy_target.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBProxyTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyTargetConfigInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBProxyTargetExists(resourceName, &dbProxyTarget),
+					resource.TestCheckResourceAttrPair(resourceName, "db_instance_identifier", "aws_db_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "db_proxy_name", "aws_db_proxy.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint", "aws_db_instance.test", "address"),
+					resource.TestCheckResourceAttrPair(resourceName, "port", "aws_db_instance.test", "port"),
+					resource.TestCheckResourceAttr(resourceName, "rds_resource_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "target_group_name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "tracked_cluster_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "type", "RDS_INSTANCE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDBProxyTarget_Cluster(t *testing.T) {
+	var dbProxyTarget rds.DBProxyTarget
+	resourceName := "aws_db_proxy_target.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBProxyTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyTargetConfigCluster(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBProxyTargetExists(resourceName, &dbProxyTarget),
+					resource.TestCheckResourceAttrPair(resourceName, "db_cluster_identifier", "aws_rds_cluster.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "db_proxy_name", "aws_db_proxy.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "rds_resource_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "target_group_name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "tracked_cluster_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "TRACKED_CLUSTER"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDBProxyTarget_disappears(t *testing.T) {
+	var dbProxyTarget rds.DBProxyTarget
+	resourceName := "aws_db_proxy_target.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBProxyTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyTargetConfigInstance(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBProxyTargetExists(resourceName, &dbProxyTarget),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsDbProxyTarget(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSDBProxyTargetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).rdsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_db_proxy_target" {
+			continue
+		}
+
+		dbProxyName, targetGroupName, targetType, rdsResourceID, err := tfrds.ProxyTargetParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := finder.DBProxyTarget(conn, dbProxyName, targetGroupName, targetType, rdsResourceID)
+
+		if isAWSErr(err, rds.ErrCodeDBProxyNotFoundFault, "") || isAWSErr(err, rds.ErrCodeDBProxyTargetGroupNotFoundFault, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("RDS DB Proxy Target (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSDBProxyTargetExists(resourceName string, v *rds.DBProxyTarget) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		dbProxyName, targetGroupName, targetType, rdsResourceID, err := tfrds.ProxyTargetParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).rdsconn
+
+		output, err := finder.DBProxyTarget(conn, dbProxyName, targetGroupName, targetType, rdsResourceID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("RDS DB Proxy Target (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSDBProxyTargetConfigInstance(rName string) string {
+	return composeConfig(
+		testAccAWSDBProxyConfig(rName),
+		fmt.Sprintf(`
+resource "aws_db_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test.*.id
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage      = 5
+  db_subnet_group_name   = aws_db_subnet_group.test.id
+  engine                 = "mysql"
+  identifier             = %[1]q
+  instance_class         = "db.t2.micro"
+  password               = "db_user_password"
+  skip_final_snapshot    = true
+  username               = "db_user"
+  vpc_security_group_ids = [aws_security_group.test.id]
+}
+
+resource "aws_db_proxy_target" "test" {
+  db_instance_identifier = aws_db_instance.test.id
+  db_proxy_name          = aws_db_proxy.test.name
+  target_group_name      = "default"
+}
+`, rName))
+}
+
+func testAccAWSDBProxyTargetConfigCluster(rName string) string {
+	return composeConfig(
+		testAccAWSDBProxyConfig(rName),
+		fmt.Sprintf(`
+resource "aws_db_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = aws_subnet.test.*.id
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier     = %[1]q
+  db_subnet_group_name   = aws_db_subnet_group.test.id
+  engine                 = "aurora-mysql"
+  engine_mode            = "provisioned"
+  master_password        = "db_user_password"
+  master_username        = "db_user"
+  skip_final_snapshot    = true
+  vpc_security_group_ids = [aws_security_group.test.id]
+}
+
+resource "aws_db_proxy_target" "test" {
+  db_cluster_identifier = aws_rds_cluster.test.id
+  db_proxy_name         = aws_db_proxy.test.name
+  target_group_name     = "default"
+}
+`, rName))
+}
@@ -0,0 +1,431 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
+)
+
+const (
+	elasticacheGlobalReplicationGroupMemberRolePrimary   = "PRIMARY"
+	elasticacheGlobalReplicationGroupMemberRoleSecondary = "SECONDARY"
+)
+
+func resourceAwsElasticacheGlobalReplicationGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsElasticacheGlobalReplicationGroupCreate,
+		Read:   resourceAwsElasticacheGlobalReplicationGroupRead,
+		Update: resourceAwsElasticacheGlobalReplicationGroupUpdate,
+		Delete: resourceAwsElasticacheGlobalReplicationGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(waiter.GlobalReplicationGroupAvailableTimeout),
+			Update: schema.DefaultTimeout(waiter.GlobalReplicationGroupAvailableTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"at_rest_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"auth_token_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"automatic_failover_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"cache_node_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"cluster_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"global_replication_group_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"global_replication_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"global_replication_group_id_suffix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"primary_replication_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"transit_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	input := &elasticache.CreateGlobalReplicationGroupInput{
+		GlobalReplicationGroupIdSuffix: aws.String(d.Get("global_replication_group_id_suffix").(string)),
+		PrimaryReplicationGroupId:      aws.String(d.Get("primary_replication_group_id").(string)),
+	}
+
+	if v, ok := d.GetOk("global_replication_group_description"); ok {
+		input.GlobalReplicationGroupDescription = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating ElastiCache Global Replication Group: %s", input)
+	output, err := conn.CreateGlobalReplicationGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating ElastiCache Global Replication Group: %w", err)
+	}
+
+	if output == nil || output.GlobalReplicationGroup == nil {
+		return fmt.Errorf("error creating ElastiCache Global Replication Group: empty output")
+	}
+
+	d.SetId(aws.StringValue(output.GlobalReplicationGroup.GlobalReplicationGroupId))
+
+	globalReplicationGroup, err := waiter.GlobalReplicationGroupAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) creation: %w", d.Id(), err)
+	}
+
+	// Settings inherited from the primary replication group can only be changed
+	// once the global replication group exists, and each change is propagated to all members.
+	if v, ok := d.GetOk("engine_version"); ok && v.(string) != aws.StringValue(globalReplicationGroup.EngineVersion) {
+		input := &elasticache.ModifyGlobalReplicationGroupInput{
+			ApplyImmediately:         aws.Bool(true),
+			EngineVersion:            aws.String(v.(string)),
+			GlobalReplicationGroupId: aws.String(d.Id()),
+		}
+
+		if err := modifyElasticacheGlobalReplicationGroup(conn, input, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("cache_node_type"); ok && v.(string) != aws.StringValue(globalReplicationGroup.CacheNodeType) {
+		input := &elasticache.ModifyGlobalReplicationGroupInput{
+			ApplyImmediately:         aws.Bool(true),
+			CacheNodeType:            aws.String(v.(string)),
+			GlobalReplicationGroupId: aws.String(d.Id()),
+		}
+
+		if err := modifyElasticacheGlobalReplicationGroup(conn, input, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOkExists("automatic_failover_enabled"); ok && v.(bool) != elasticacheGlobalReplicationGroupAutomaticFailoverEnabled(globalReplicationGroup) {
+		input := &elasticache.ModifyGlobalReplicationGroupInput{
+			ApplyImmediately:         aws.Bool(true),
+			AutomaticFailoverEnabled: aws.Bool(v.(bool)),
+			GlobalReplicationGroupId: aws.String(d.Id()),
+		}
+
+		if err := modifyElasticacheGlobalReplicationGroup(conn, input, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsElasticacheGlobalReplicationGroupRead(d, meta)
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	globalReplicationGroup, err := finder.GlobalReplicationGroupByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+		log.Printf("[WARN] ElastiCache Global Replication Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+	}
+
+	if globalReplicationGroup == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading ElastiCache Global Replication Group (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] ElastiCache Global Replication Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if status := aws.StringValue(globalReplicationGroup.Status); !d.IsNewResource() && (status == waiter.GlobalReplicationGroupStatusDeleting || status == waiter.GlobalReplicationGroupStatusDeleted) {
+		log.Printf("[WARN] ElastiCache Global Replication Group (%s) in deleted state (%s), removing from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", globalReplicationGroup.ARN)
+	d.Set("at_rest_encryption_enabled", globalReplicationGroup.AtRestEncryptionEnabled)
+	d.Set("auth_token_enabled", globalReplicationGroup.AuthTokenEnabled)
+	d.Set("automatic_failover_enabled", elasticacheGlobalReplicationGroupAutomaticFailoverEnabled(globalReplicationGroup))
+	d.Set("cache_node_type", globalReplicationGroup.CacheNodeType)
+	d.Set("cluster_enabled", globalReplicationGroup.ClusterEnabled)
+	d.Set("engine", globalReplicationGroup.Engine)
+	d.Set("engine_version", globalReplicationGroup.EngineVersion)
+	d.Set("global_replication_group_description", globalReplicationGroup.GlobalReplicationGroupDescription)
+	d.Set("global_replication_group_id", globalReplicationGroup.GlobalReplicationGroupId)
+	d.Set("transit_encryption_enabled", globalReplicationGroup.TransitEncryptionEnabled)
+
+	d.Set("primary_replication_group_id", "")
+	for _, member := range globalReplicationGroup.Members {
+		if member == nil {
+			continue
+		}
+
+		if strings.EqualFold(aws.StringValue(member.Role), elasticacheGlobalReplicationGroupMemberRolePrimary) {
+			d.Set("primary_replication_group_id", member.ReplicationGroupId)
+			break
+		}
+	}
+
+	// The ID suffix is not returned by the API, so derive it from the ID,
+	// which is of the form "<random prefix>-<suffix>".
+	if parts := strings.SplitN(d.Id(), "-", 2); len(parts) == 2 {
+		d.Set("global_replication_group_id_suffix", parts[1])
+	}
+
+	return nil
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	// Only one setting may be modified at a time; the API rejects
+	// requests that combine engine version and node type changes.
+	if d.HasChange("global_replication_group_description") {
+		input := &elasticache.ModifyGlobalReplicationGroupInput{
+			ApplyImmediately:                  aws.Bool(true),
+			GlobalReplicationGroupDescription: aws.String(d.Get("global_replication_group_description").(string)),
+			GlobalReplicationGroupId:          aws.String(d.Id()),
+		}
+
+		if err := modifyElasticacheGlobalReplicationGroup(conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("engine_version") {
+		input := &elasticache.ModifyGlobalReplicationGroupInput{
+			ApplyImmediately:         aws.Bool(true),
+			EngineVersion:            aws.String(d.Get("engine_version").(string)),
+			GlobalReplicationGroupId: aws.String(d.Id()),
+		}
+
+		if err := modifyElasticacheGlobalReplicationGroup(conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("cache_node_type") {
+		input := &elasticache.ModifyGlobalReplicationGroupInput{
+			ApplyImmediately:         aws.Bool(true),
+			CacheNodeType:            aws.String(d.Get("cache_node_type").(string)),
+			GlobalReplicationGroupId: aws.String(d.Id()),
+		}
+
+		if err := modifyElasticacheGlobalReplicationGroup(conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("automatic_failover_enabled") {
+		input := &elasticache.ModifyGlobalReplicationGroupInput{
+			ApplyImmediately:         aws.Bool(true),
+			AutomaticFailoverEnabled: aws.Bool(d.Get("automatic_failover_enabled").(bool)),
+			GlobalReplicationGroupId: aws.String(d.Id()),
+		}
+
+		if err := modifyElasticacheGlobalReplicationGroup(conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsElasticacheGlobalReplicationGroupRead(d, meta)
+}
+
+func resourceAwsElasticacheGlobalReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).elasticacheconn
+
+	globalReplicationGroup, err := finder.GlobalReplicationGroupByID(conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+	}
+
+	if globalReplicationGroup == nil {
+		return nil
+	}
+
+	// A global replication group can only be deleted once all secondary members have been removed.
+	// Secondaries managed by aws_elasticache_replication_group will normally have already
+	// disassociated themselves during their own deletion.
+	for _, member := range globalReplicationGroup.Members {
+		if member == nil || !strings.EqualFold(aws.StringValue(member.Role), elasticacheGlobalReplicationGroupMemberRoleSecondary) {
+			continue
+		}
+
+		if err := disassociateElasticacheGlobalReplicationGroupMember(conn, d.Id(), aws.StringValue(member.ReplicationGroupId), aws.StringValue(member.ReplicationGroupRegion)); err != nil {
+			return err
+		}
+	}
+
+	input := &elasticache.DeleteGlobalReplicationGroupInput{
+		GlobalReplicationGroupId:      aws.String(d.Id()),
+		RetainPrimaryReplicationGroup: aws.Bool(true),
+	}
+
+	log.Printf("[DEBUG] Deleting ElastiCache Global Replication Group: %s", input)
+	err = resource.Retry(waiter.GlobalReplicationGroupDeletedTimeout, func() *resource.RetryError {
+		_, err := conn.DeleteGlobalReplicationGroup(input)
+
+		// InvalidGlobalReplicationGroupState: Global replication group is not in a valid state to be deleted
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeInvalidGlobalReplicationGroupStateFault) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.DeleteGlobalReplicationGroup(input)
+	}
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.GlobalReplicationGroupDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func modifyElasticacheGlobalReplicationGroup(conn *elasticache.ElastiCache, input *elasticache.ModifyGlobalReplicationGroupInput, timeout time.Duration) error {
+	id := aws.StringValue(input.GlobalReplicationGroupId)
+
+	log.Printf("[DEBUG] Modifying ElastiCache Global Replication Group: %s", input)
+	if _, err := conn.ModifyGlobalReplicationGroup(input); err != nil {
+		return fmt.Errorf("error modifying ElastiCache Global Replication Group (%s): %w", id, err)
+	}
+
+	if _, err := waiter.GlobalReplicationGroupAvailable(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) modification: %w", id, err)
+	}
+
+	return nil
+}
+
+func disassociateElasticacheGlobalReplicationGroupMember(conn *elasticache.ElastiCache, globalReplicationGroupID, replicationGroupID, region string) error {
+	input := &elasticache.DisassociateGlobalReplicationGroupInput{
+		GlobalReplicationGroupId: aws.String(globalReplicationGroupID),
+		ReplicationGroupId:       aws.String(replicationGroupID),
+		ReplicationGroupRegion:   aws.String(region),
+	}
+
+	log.Printf("[DEBUG] Disassociating ElastiCache Replication Group from Global Replication Group: %s", input)
+	err := resource.Retry(waiter.GlobalReplicationGroupMemberDetachedTimeout, func() *resource.RetryError {
+		_, err := conn.DisassociateGlobalReplicationGroup(input)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeInvalidGlobalReplicationGroupStateFault) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.DisassociateGlobalReplicationGroup(input)
+	}
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating ElastiCache Replication Group (%s) from Global Replication Group (%s): %w", replicationGroupID, globalReplicationGroupID, err)
+	}
+
+	if _, err := waiter.GlobalReplicationGroupMemberDetached(conn, globalReplicationGroupID, replicationGroupID); err != nil {
+		return fmt.Errorf("error waiting for ElastiCache Replication Group (%s) to be disassociated from Global Replication Group (%s): %w", replicationGroupID, globalReplicationGroupID, err)
+	}
+
+	return nil
+}
+
+func elasticacheGlobalReplicationGroupAutomaticFailoverEnabled(globalReplicationGroup *elasticache.GlobalReplicationGroup) bool {
+	for _, member := range globalReplicationGroup.Members {
+		if member == nil || !strings.EqualFold(aws.StringValue(member.Role), elasticacheGlobalReplicationGroupMemberRolePrimary) {
+			continue
+		}
+
+		switch strings.ToLower(aws.StringValue(member.AutomaticFailover)) {
+		case elasticache.AutomaticFailoverStatusEnabled, elasticache.AutomaticFailoverStatusEnabling:
+			return true
+		}
+	}
+
+	return false
+}
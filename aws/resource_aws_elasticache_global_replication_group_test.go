@@ -0,0 +1,450 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
+		Name: "aws_elasticache_global_replication_group",
+		F:    testSweepElasticacheGlobalReplicationGroups,
+	})
+}
+
+func testSweepElasticacheGlobalReplicationGroups(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).elasticacheconn
+	var sweeperErrs *multierror.Error
+
+	input := &elasticache.DescribeGlobalReplicationGroupsInput{
+		ShowMemberInfo: aws.Bool(true),
+	}
+
+	err = conn.DescribeGlobalReplicationGroupsPages(input, func(page *elasticache.DescribeGlobalReplicationGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, globalReplicationGroup := range page.GlobalReplicationGroups {
+			if globalReplicationGroup == nil {
+				continue
+			}
+
+			id := aws.StringValue(globalReplicationGroup.GlobalReplicationGroupId)
+
+			// Only sweep global replication groups whose primary is in this region.
+			isPrimaryRegion := false
+			for _, member := range globalReplicationGroup.Members {
+				if member != nil && strings.EqualFold(aws.StringValue(member.Role), elasticacheGlobalReplicationGroupMemberRolePrimary) && aws.StringValue(member.ReplicationGroupRegion) == region {
+					isPrimaryRegion = true
+				}
+			}
+
+			if !isPrimaryRegion {
+				continue
+			}
+
+			r := resourceAwsElasticacheGlobalReplicationGroup()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			log.Printf("[INFO] Deleting ElastiCache Global Replication Group: %s", id)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting ElastiCache Global Replication Group (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !lastPage
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping ElastiCache Global Replication Group sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing ElastiCache Global Replication Groups: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSElasticacheGlobalReplicationGroup_basic(t *testing.T) {
+	var globalReplicationGroup elasticache.GlobalReplicationGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_global_replication_group.test"
+	primaryReplicationGroupResourceName := "aws_elasticache_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSElasticacheGlobalReplicationGroup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheGlobalReplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfig(rName, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					testAccMatchResourceAttrGlobalARN(resourceName, "arn", "elasticache", regexp.MustCompile(`globalreplicationgroup:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "at_rest_encryption_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auth_token_enabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "cache_node_type", primaryReplicationGroupResourceName, "node_type"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "engine", "redis"),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", primaryReplicationGroupResourceName, "engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "global_replication_group_description", "test description"),
+					resource.TestMatchResourceAttr(resourceName, "global_replication_group_id", regexp.MustCompile(fmt.Sprintf(`^[a-z]+-%s$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "global_replication_group_id_suffix", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "primary_replication_group_id", primaryReplicationGroupResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "transit_encryption_enabled", "false"),
+					resource.TestCheckResourceAttrPair(primaryReplicationGroupResourceName, "global_replication_group_id", resourceName, "global_replication_group_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSElasticacheGlobalReplicationGroup_disappears(t *testing.T) {
+	var globalReplicationGroup elasticache.GlobalReplicationGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_global_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSElasticacheGlobalReplicationGroup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheGlobalReplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfig(rName, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsElasticacheGlobalReplicationGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSElasticacheGlobalReplicationGroup_Description(t *testing.T) {
+	var globalReplicationGroup elasticache.GlobalReplicationGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_global_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSElasticacheGlobalReplicationGroup(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSElasticacheGlobalReplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfig(rName, "description 1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					resource.TestCheckResourceAttr(resourceName, "global_replication_group_description", "description 1"),
+				),
+			},
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfig(rName, "description 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					resource.TestCheckResourceAttr(resourceName, "global_replication_group_description", "description 2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSElasticacheGlobalReplicationGroup_SecondaryMember(t *testing.T) {
+	var providers []*schema.Provider
+	var globalReplicationGroup elasticache.GlobalReplicationGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_global_replication_group.test"
+	secondaryReplicationGroupResourceName := "aws_elasticache_replication_group.secondary"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSElasticacheGlobalReplicationGroup(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAWSElasticacheGlobalReplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfigSecondaryMember(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					testAccCheckAWSElasticacheGlobalReplicationGroupMemberCount(&globalReplicationGroup, 2),
+					resource.TestCheckResourceAttrPair(secondaryReplicationGroupResourceName, "global_replication_group_id", resourceName, "global_replication_group_id"),
+					resource.TestCheckResourceAttrPair(secondaryReplicationGroupResourceName, "node_type", resourceName, "cache_node_type"),
+					resource.TestCheckResourceAttrPair(secondaryReplicationGroupResourceName, "engine_version", resourceName, "engine_version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSElasticacheGlobalReplicationGroup_SecondaryMember_Updates(t *testing.T) {
+	var providers []*schema.Provider
+	var globalReplicationGroup elasticache.GlobalReplicationGroup
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_elasticache_global_replication_group.test"
+	primaryReplicationGroupResourceName := "aws_elasticache_replication_group.test"
+	secondaryReplicationGroupResourceName := "aws_elasticache_replication_group.secondary"
+
+	// Changes made through the global replication group are only reflected in the
+	// member replication groups once they are refreshed, so each configuration is
+	// applied twice and the members are checked in the second step.
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSElasticacheGlobalReplicationGroup(t)
+			testAccMultipleRegionPreCheck(t, 2)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAWSElasticacheGlobalReplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfigSecondaryMemberUpdates(rName, "5.0.6", "cache.m5.large", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSElasticacheGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					testAccCheckAWSElasticacheGlobalReplicationGroupMemberCount(&globalReplicationGroup, 2),
+					resource.TestCheckResourceAttr(resourceName, "automatic_failover_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "cache_node_type", "cache.m5.large"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "5.0.6"),
+				),
+			},
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfigSecondaryMemberUpdates(rName, "6.0.5", "cache.m5.large", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "engine_version", "6.0.5"),
+				),
+			},
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfigSecondaryMemberUpdates(rName, "6.0.5", "cache.m5.large", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(primaryReplicationGroupResourceName, "engine_version", "6.0.5"),
+					resource.TestCheckResourceAttr(secondaryReplicationGroupResourceName, "engine_version", "6.0.5"),
+				),
+			},
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfigSecondaryMemberUpdates(rName, "6.0.5", "cache.m5.xlarge", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cache_node_type", "cache.m5.xlarge"),
+				),
+			},
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfigSecondaryMemberUpdates(rName, "6.0.5", "cache.m5.xlarge", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(primaryReplicationGroupResourceName, "node_type", "cache.m5.xlarge"),
+					resource.TestCheckResourceAttr(secondaryReplicationGroupResourceName, "node_type", "cache.m5.xlarge"),
+				),
+			},
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfigSecondaryMemberUpdates(rName, "6.0.5", "cache.m5.xlarge", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "automatic_failover_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAWSElasticacheGlobalReplicationGroupConfigSecondaryMemberUpdates(rName, "6.0.5", "cache.m5.xlarge", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(primaryReplicationGroupResourceName, "automatic_failover_enabled", "true"),
+					resource.TestCheckResourceAttr(secondaryReplicationGroupResourceName, "automatic_failover_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSElasticacheGlobalReplicationGroup(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+
+	input := &elasticache.DescribeGlobalReplicationGroupsInput{}
+
+	_, err := conn.DescribeGlobalReplicationGroups(input)
+
+	if testAccPreCheckSkipError(err) || tfawserr.ErrMessageContains(err, "InvalidParameterValue", "Access Denied to API Version") {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAWSElasticacheGlobalReplicationGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_elasticache_global_replication_group" {
+			continue
+		}
+
+		globalReplicationGroup, err := finder.GlobalReplicationGroupByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if globalReplicationGroup != nil && aws.StringValue(globalReplicationGroup.Status) != "deleted" {
+			return fmt.Errorf("ElastiCache Global Replication Group (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSElasticacheGlobalReplicationGroupExists(n string, v *elasticache.GlobalReplicationGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ElastiCache Global Replication Group ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).elasticacheconn
+
+		globalReplicationGroup, err := finder.GlobalReplicationGroupByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if globalReplicationGroup == nil {
+			return fmt.Errorf("ElastiCache Global Replication Group (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *globalReplicationGroup
+
+		return nil
+	}
+}
+
+func testAccCheckAWSElasticacheGlobalReplicationGroupMemberCount(globalReplicationGroup *elasticache.GlobalReplicationGroup, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := len(globalReplicationGroup.Members); got != expected {
+			return fmt.Errorf("ElastiCache Global Replication Group (%s) has %d members, expected %d", aws.StringValue(globalReplicationGroup.GlobalReplicationGroupId), got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSElasticacheGlobalReplicationGroupConfig(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "test"
+  engine                        = "redis"
+  engine_version                = "5.0.6"
+  node_type                     = "cache.m5.large"
+  number_cache_clusters         = 1
+}
+
+resource "aws_elasticache_global_replication_group" "test" {
+  global_replication_group_id_suffix   = %[1]q
+  global_replication_group_description = %[2]q
+  primary_replication_group_id         = aws_elasticache_replication_group.test.id
+}
+`, rName, description)
+}
+
+func testAccAWSElasticacheGlobalReplicationGroupConfigSecondaryMember(rName string) string {
+	return composeConfig(testAccMultipleRegionProviderConfig(2), fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "primary"
+  engine                        = "redis"
+  engine_version                = "5.0.6"
+  node_type                     = "cache.m5.large"
+  number_cache_clusters         = 1
+}
+
+resource "aws_elasticache_global_replication_group" "test" {
+  global_replication_group_id_suffix = %[1]q
+  primary_replication_group_id       = aws_elasticache_replication_group.test.id
+}
+
+resource "aws_elasticache_replication_group" "secondary" {
+  provider = "awsalternate"
+
+  replication_group_id          = "%[1]s-secondary"
+  replication_group_description = "secondary"
+  global_replication_group_id   = aws_elasticache_global_replication_group.test.global_replication_group_id
+  number_cache_clusters         = 1
+}
+`, rName))
+}
+
+func testAccAWSElasticacheGlobalReplicationGroupConfigSecondaryMemberUpdates(rName, engineVersion, cacheNodeType string, automaticFailoverEnabled bool) string {
+	return composeConfig(testAccMultipleRegionProviderConfig(2), fmt.Sprintf(`
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "primary"
+  engine                        = "redis"
+  engine_version                = "5.0.6"
+  node_type                     = "cache.m5.large"
+  number_cache_clusters         = 2
+
+  # Managed through the global replication group
+  lifecycle {
+    ignore_changes = [automatic_failover_enabled, engine_version, node_type]
+  }
+}
+
+resource "aws_elasticache_global_replication_group" "test" {
+  global_replication_group_id_suffix = %[1]q
+  primary_replication_group_id       = aws_elasticache_replication_group.test.id
+
+  automatic_failover_enabled = %[4]t
+  cache_node_type            = %[3]q
+  engine_version             = %[2]q
+}
+
+resource "aws_elasticache_replication_group" "secondary" {
+  provider = "awsalternate"
+
+  replication_group_id          = "%[1]s-secondary"
+  replication_group_description = "secondary"
+  global_replication_group_id   = aws_elasticache_global_replication_group.test.global_replication_group_id
+  number_cache_clusters         = 2
+
+  # Managed through the global replication group
+  lifecycle {
+    ignore_changes = [automatic_failover_enabled]
+  }
+}
+`, rName, engineVersion, cacheNodeType, automaticFailoverEnabled))
+}
@@ -10,11 +10,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/elasticache"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/elasticache/waiter"
 )
 
 func resourceAwsElasticacheReplicationGroup() *schema.Resource {
@@ -103,6 +106,21 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"global_replication_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ConflictsWith: []string{
+					"at_rest_encryption_enabled",
+					"auth_token",
+					"engine_version",
+					"node_type",
+					"snapshot_arns",
+					"snapshot_name",
+					"transit_encryption_enabled",
+				},
+			},
 			"maintenance_window": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -266,12 +284,19 @@ func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta i
 		ReplicationGroupId:          aws.String(d.Get("replication_group_id").(string)),
 		ReplicationGroupDescription: aws.String(d.Get("replication_group_description").(string)),
 		AutomaticFailoverEnabled:    aws.Bool(d.Get("automatic_failover_enabled").(bool)),
-		AutoMinorVersionUpgrade:     aws.Bool(d.Get("auto_minor_version_upgrade").(bool)),
-		CacheNodeType:               aws.String(d.Get("node_type").(string)),
-		Engine:                      aws.String(d.Get("engine").(string)),
 		Tags:                        tags,
 	}
 
+	// Secondary members of a global replication group inherit their engine,
+	// node type and encryption settings from the primary.
+	if v, ok := d.GetOk("global_replication_group_id"); ok {
+		params.GlobalReplicationGroupId = aws.String(v.(string))
+	} else {
+		params.AutoMinorVersionUpgrade = aws.Bool(d.Get("auto_minor_version_upgrade").(bool))
+		params.CacheNodeType = aws.String(d.Get("node_type").(string))
+		params.Engine = aws.String(d.Get("engine").(string))
+	}
+
 	if v, ok := d.GetOk("engine_version"); ok {
 		params.EngineVersion = aws.String(v.(string))
 	}
@@ -351,7 +376,8 @@ func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta i
 		clusterModeList := clusterMode.([]interface{})
 		attributes := clusterModeList[0].(map[string]interface{})
 
-		if v, ok := attributes["num_node_groups"]; ok {
+		// The number of shards of a global replication group secondary is inherited from the primary
+		if v, ok := attributes["num_node_groups"]; ok && params.GlobalReplicationGroupId == nil {
 			params.NumNodeGroups = aws.Int64(int64(v.(int)))
 		}
 
@@ -387,6 +413,12 @@ func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta i
 		return fmt.Errorf("Error waiting for elasticache replication group (%s) to be created: %s", d.Id(), sterr)
 	}
 
+	if v, ok := d.GetOk("global_replication_group_id"); ok {
+		if _, err := waiter.GlobalReplicationGroupMemberAssociated(conn, v.(string), d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Elasticache Replication Group (%s) to be associated with Global Replication Group (%s): %w", d.Id(), v.(string), err)
+		}
+	}
+
 	return resourceAwsElasticacheReplicationGroupRead(d, meta)
 }
 
@@ -441,6 +473,14 @@ func resourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta int
 
 	d.Set("kms_key_id", rgp.KmsKeyId)
 
+	isGlobalSecondary := false
+	if rgp.GlobalReplicationGroupInfo != nil {
+		d.Set("global_replication_group_id", rgp.GlobalReplicationGroupInfo.GlobalReplicationGroupId)
+		isGlobalSecondary = strings.EqualFold(aws.StringValue(rgp.GlobalReplicationGroupInfo.GlobalReplicationGroupMemberRole), elasticacheGlobalReplicationGroupMemberRoleSecondary)
+	} else {
+		d.Set("global_replication_group_id", "")
+	}
+
 	d.Set("replication_group_description", rgp.Description)
 	d.Set("number_cache_clusters", len(rgp.MemberClusters))
 	if err := d.Set("member_clusters", flattenStringSet(rgp.MemberClusters)); err != nil {
@@ -495,8 +535,13 @@ func resourceAwsElasticacheReplicationGroupRead(d *schema.ResourceData, meta int
 		}
 
 		d.Set("auto_minor_version_upgrade", c.AutoMinorVersionUpgrade)
-		d.Set("at_rest_encryption_enabled", c.AtRestEncryptionEnabled)
-		d.Set("transit_encryption_enabled", c.TransitEncryptionEnabled)
+
+		// Encryption settings of a global replication group secondary are inherited
+		// from the primary and cannot be configured, so keep the configured defaults.
+		if !isGlobalSecondary {
+			d.Set("at_rest_encryption_enabled", c.AtRestEncryptionEnabled)
+			d.Set("transit_encryption_enabled", c.TransitEncryptionEnabled)
+		}
 
 		if c.AuthTokenEnabled != nil && !aws.BoolValue(c.AuthTokenEnabled) {
 			d.Set("auth_token", nil)
@@ -835,6 +880,23 @@ func resourceAwsElasticacheReplicationGroupUpdate(d *schema.ResourceData, meta i
 func resourceAwsElasticacheReplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
 
+	// Secondary members must leave the global replication group before they can be deleted.
+	// The primary member is removed when the global replication group itself is deleted.
+	if v, ok := d.GetOk("global_replication_group_id"); ok {
+		globalReplicationGroupID := v.(string)
+		member, err := finder.GlobalReplicationGroupMemberByID(conn, globalReplicationGroupID, d.Id())
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, elasticache.ErrCodeGlobalReplicationGroupNotFoundFault) {
+			return fmt.Errorf("error reading Elasticache Replication Group (%s) membership of Global Replication Group (%s): %w", d.Id(), globalReplicationGroupID, err)
+		}
+
+		if member != nil && strings.EqualFold(aws.StringValue(member.Role), elasticacheGlobalReplicationGroupMemberRoleSecondary) {
+			if err := disassociateElasticacheGlobalReplicationGroupMember(conn, globalReplicationGroupID, d.Id(), meta.(*AWSClient).region); err != nil {
+				return err
+			}
+		}
+	}
+
 	err := deleteElasticacheReplicationGroup(d.Id(), conn)
 	if err != nil {
 		return fmt.Errorf("error deleting Elasticache Replication Group (%s): %w", d.Id(), err)
@@ -0,0 +1,337 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/fms/finder"
+)
+
+const fmsPolicyResourceTypeList = "ResourceTypeList"
+
+func resourceAwsFmsPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsPolicyCreate,
+		Read:   resourceAwsFmsPolicyRead,
+		Update: resourceAwsFmsPolicyUpdate,
+		Delete: resourceAwsFmsPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsFmsPolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_all_policy_resources": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"exclude_map": fmsPolicyScopeMapSchema(),
+			"exclude_resource_tags": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"include_map": fmsPolicyScopeMapSchema(),
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"policy_update_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"remediation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"resource_tags": tagsSchema(),
+			"resource_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.StringLenBetween(1, 128),
+				ConflictsWith: []string{"resource_type_list"},
+			},
+			"resource_type_list": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"resource_type"},
+			},
+			"security_service_policy_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"managed_service_data": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppressEquivalentJsonDiffs,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(fms.SecurityServiceType_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func fmsPolicyScopeMapSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateAwsAccountId,
+					},
+				},
+				"orgunit": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsFmsPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	input := &fms.PutPolicyInput{
+		Policy: resourceAwsFmsPolicyExpandPolicy(d),
+	}
+
+	log.Printf("[DEBUG] Creating FMS Policy: %s", input)
+	output, err := conn.PutPolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating FMS Policy (%s): %w", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.Policy.PolicyId))
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	output, err := finder.PolicyByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] FMS Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FMS Policy (%s): %w", d.Id(), err)
+	}
+
+	if output == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading FMS Policy (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] FMS Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	policy := output.Policy
+
+	d.Set("arn", output.PolicyArn)
+	d.Set("exclude_resource_tags", policy.ExcludeResourceTags)
+	d.Set("name", policy.PolicyName)
+	d.Set("policy_update_token", policy.PolicyUpdateToken)
+	d.Set("remediation_enabled", policy.RemediationEnabled)
+
+	if err := d.Set("exclude_map", flattenFmsPolicyScopeMap(policy.ExcludeMap)); err != nil {
+		return fmt.Errorf("error setting exclude_map: %w", err)
+	}
+
+	if err := d.Set("include_map", flattenFmsPolicyScopeMap(policy.IncludeMap)); err != nil {
+		return fmt.Errorf("error setting include_map: %w", err)
+	}
+
+	if err := d.Set("resource_tags", keyvaluetags.FmsKeyValueTags(policy.ResourceTags).IgnoreAws().Map()); err != nil {
+		return fmt.Errorf("error setting resource_tags: %w", err)
+	}
+
+	if aws.StringValue(policy.ResourceType) == fmsPolicyResourceTypeList {
+		d.Set("resource_type", nil)
+	} else {
+		d.Set("resource_type", policy.ResourceType)
+	}
+
+	if err := d.Set("resource_type_list", flattenStringSet(policy.ResourceTypeList)); err != nil {
+		return fmt.Errorf("error setting resource_type_list: %w", err)
+	}
+
+	if err := d.Set("security_service_policy_data", flattenFmsSecurityServicePolicyData(policy.SecurityServicePolicyData)); err != nil {
+		return fmt.Errorf("error setting security_service_policy_data: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	policy := resourceAwsFmsPolicyExpandPolicy(d)
+	policy.PolicyId = aws.String(d.Id())
+	policy.PolicyUpdateToken = aws.String(d.Get("policy_update_token").(string))
+
+	input := &fms.PutPolicyInput{
+		Policy: policy,
+	}
+
+	log.Printf("[DEBUG] Updating FMS Policy: %s", input)
+	if _, err := conn.PutPolicy(input); err != nil {
+		return fmt.Errorf("error updating FMS Policy (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsFmsPolicyRead(d, meta)
+}
+
+func resourceAwsFmsPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	log.Printf("[DEBUG] Deleting FMS Policy: %s", d.Id())
+	_, err := conn.DeletePolicy(&fms.DeletePolicyInput{
+		DeleteAllPolicyResources: aws.Bool(d.Get("delete_all_policy_resources").(bool)),
+		PolicyId:                 aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FMS Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsFmsPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("delete_all_policy_resources", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsFmsPolicyExpandPolicy(d *schema.ResourceData) *fms.Policy {
+	policy := &fms.Policy{
+		ExcludeMap:                expandFmsPolicyScopeMap(d.Get("exclude_map").([]interface{})),
+		ExcludeResourceTags:       aws.Bool(d.Get("exclude_resource_tags").(bool)),
+		IncludeMap:                expandFmsPolicyScopeMap(d.Get("include_map").([]interface{})),
+		PolicyName:                aws.String(d.Get("name").(string)),
+		RemediationEnabled:        aws.Bool(d.Get("remediation_enabled").(bool)),
+		ResourceTags:              keyvaluetags.New(d.Get("resource_tags").(map[string]interface{})).IgnoreAws().FmsTags(),
+		SecurityServicePolicyData: expandFmsSecurityServicePolicyData(d.Get("security_service_policy_data").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("resource_type_list"); ok && v.(*schema.Set).Len() > 0 {
+		policy.ResourceType = aws.String(fmsPolicyResourceTypeList)
+		policy.ResourceTypeList = expandStringSet(v.(*schema.Set))
+	} else {
+		policy.ResourceType = aws.String(d.Get("resource_type").(string))
+	}
+
+	return policy
+}
+
+func expandFmsPolicyScopeMap(tfList []interface{}) map[string][]*string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := map[string][]*string{}
+
+	if v, ok := tfMap["account"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject[fms.CustomerPolicyScopeIdTypeAccount] = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["orgunit"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject[fms.CustomerPolicyScopeIdTypeOrgUnit] = expandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenFmsPolicyScopeMap(apiObject map[string][]*string) []interface{} {
+	if len(apiObject) == 0 {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v, ok := apiObject[fms.CustomerPolicyScopeIdTypeAccount]; ok {
+		tfMap["account"] = flattenStringSet(v)
+	}
+
+	if v, ok := apiObject[fms.CustomerPolicyScopeIdTypeOrgUnit]; ok {
+		tfMap["orgunit"] = flattenStringSet(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandFmsSecurityServicePolicyData(tfList []interface{}) *fms.SecurityServicePolicyData {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &fms.SecurityServicePolicyData{
+		Type: aws.String(tfMap["type"].(string)),
+	}
+
+	if v, ok := tfMap["managed_service_data"].(string); ok && v != "" {
+		apiObject.ManagedServiceData = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenFmsSecurityServicePolicyData(apiObject *fms.SecurityServicePolicyData) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"managed_service_data": aws.StringValue(apiObject.ManagedServiceData),
+			"type":                 aws.StringValue(apiObject.Type),
+		},
+	}
+}
@@ -0,0 +1,377 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/fms/finder"
+)
+
+func TestAccAWSFmsPolicy_basic(t *testing.T) {
+	var policy fms.GetPolicyOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckFmsAdminAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFmsPolicyConfigBasic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFmsPolicyExists(resourceName, &policy),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "fms", regexp.MustCompile(`policy/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "delete_all_policy_resources", "true"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_update_token"),
+					resource.TestCheckResourceAttr(resourceName, "remediation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "AWS::EC2::SecurityGroup"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", fms.SecurityServiceTypeSecurityGroupsUsageAudit),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSFmsPolicyConfigBasic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "remediation_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFmsPolicy_disappears(t *testing.T) {
+	var policy fms.GetPolicyOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckFmsAdminAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFmsPolicyConfigBasic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFmsPolicyExists(resourceName, &policy),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsFmsPolicy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFmsPolicy_IncludeMap(t *testing.T) {
+	var policy fms.GetPolicyOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckFmsAdminAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFmsPolicyConfigIncludeMap(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "include_map.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "include_map.0.account.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_map.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSFmsPolicy_ResourceTags(t *testing.T) {
+	var policy fms.GetPolicyOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckFmsAdminAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFmsPolicyConfigResourceTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSFmsPolicyConfigResourceTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFmsPolicy_ResourceTypeList(t *testing.T) {
+	var policy fms.GetPolicyOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckFmsAdminAccount(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFmsPolicyConfigResourceTypeList(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "resource_type_list.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", fms.SecurityServiceTypeSecurityGroupsCommon),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPreCheckFmsAdminAccount(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+	output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+	if tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
+		t.Skip("skipping tests; this AWS account must be the Firewall Manager administrator account")
+	}
+
+	if err != nil {
+		t.Fatalf("error getting FMS Admin Account: %s", err)
+	}
+
+	if aws.StringValue(output.AdminAccount) != testAccProvider.Meta().(*AWSClient).accountid {
+		t.Skip("skipping tests; this AWS account must be the Firewall Manager administrator account")
+	}
+}
+
+func testAccCheckAWSFmsPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fms_policy" {
+			continue
+		}
+
+		output, err := finder.PolicyByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, fms.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("FMS Policy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSFmsPolicyExists(n string, v *fms.GetPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FMS Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+		output, err := finder.PolicyByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("FMS Policy (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAWSFmsPolicyConfigBasic(rName string, remediationEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  name                  = %[1]q
+  exclude_resource_tags = false
+  remediation_enabled   = %[2]t
+  resource_type         = "AWS::EC2::SecurityGroup"
+
+  security_service_policy_data {
+    type = "SECURITY_GROUPS_USAGE_AUDIT"
+
+    managed_service_data = jsonencode({
+      type                            = "SECURITY_GROUPS_USAGE_AUDIT"
+      deleteUnusedSecurityGroups      = false
+      coalesceRedundantSecurityGroups = false
+    })
+  }
+}
+`, rName, remediationEnabled)
+}
+
+func testAccAWSFmsPolicyConfigIncludeMap(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_fms_policy" "test" {
+  name                  = %[1]q
+  exclude_resource_tags = false
+  resource_type         = "AWS::EC2::SecurityGroup"
+
+  include_map {
+    account = [data.aws_caller_identity.current.account_id]
+  }
+
+  security_service_policy_data {
+    type = "SECURITY_GROUPS_USAGE_AUDIT"
+
+    managed_service_data = jsonencode({
+      type                            = "SECURITY_GROUPS_USAGE_AUDIT"
+      deleteUnusedSecurityGroups      = false
+      coalesceRedundantSecurityGroups = false
+    })
+  }
+}
+`, rName)
+}
+
+func testAccAWSFmsPolicyConfigResourceTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  name                  = %[1]q
+  exclude_resource_tags = false
+  resource_type         = "AWS::EC2::SecurityGroup"
+
+  resource_tags = {
+    %[2]q = %[3]q
+  }
+
+  security_service_policy_data {
+    type = "SECURITY_GROUPS_USAGE_AUDIT"
+
+    managed_service_data = jsonencode({
+      type                            = "SECURITY_GROUPS_USAGE_AUDIT"
+      deleteUnusedSecurityGroups      = false
+      coalesceRedundantSecurityGroups = false
+    })
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSFmsPolicyConfigResourceTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  name                  = %[1]q
+  exclude_resource_tags = false
+  resource_type         = "AWS::EC2::SecurityGroup"
+
+  resource_tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  security_service_policy_data {
+    type = "SECURITY_GROUPS_USAGE_AUDIT"
+
+    managed_service_data = jsonencode({
+      type                            = "SECURITY_GROUPS_USAGE_AUDIT"
+      deleteUnusedSecurityGroups      = false
+      coalesceRedundantSecurityGroups = false
+    })
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAWSFmsPolicyConfigResourceTypeList(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_fms_policy" "test" {
+  name                  = %[1]q
+  exclude_resource_tags = false
+  resource_type_list    = ["AWS::EC2::Instance", "AWS::EC2::NetworkInterface"]
+
+  security_service_policy_data {
+    type = "SECURITY_GROUPS_COMMON"
+
+    managed_service_data = jsonencode({
+      type                                     = "SECURITY_GROUPS_COMMON"
+      revertManualSecurityGroupChanges         = false
+      exclusiveResourceSecurityGroupManagement = false
+      securityGroups = [
+        {
+          id = aws_security_group.test.id
+        }
+      ]
+    })
+  }
+}
+`, rName)
+}
@@ -0,0 +1,429 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/waiter"
+)
+
+func resourceAwsGlueDevEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueDevEndpointCreate,
+		Read:   resourceAwsGlueDevEndpointRead,
+		Update: resourceAwsGlueDevEndpointUpdate,
+		Delete: resourceAwsGlueDevEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arguments": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"extra_jars_s3_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"extra_python_libs_s3_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"glue_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\w+\.\w+$`), "must match version pattern X.X"),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"number_of_nodes": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"number_of_workers", "worker_type"},
+				ValidateFunc:  validation.IntAtLeast(2),
+			},
+			"number_of_workers": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"number_of_nodes"},
+				ValidateFunc:  validation.IntAtLeast(2),
+			},
+			"private_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"public_keys"},
+			},
+			"public_keys": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"public_key"},
+				MaxItems:      5,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"security_configuration": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"security_group_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Set:          schema.HashString,
+				RequiredWith: []string{"subnet_id"},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"security_group_ids"},
+			},
+			"tags": tagsSchema(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"worker_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validation.StringInSlice(glue.WorkerType_Values(), false),
+				ConflictsWith: []string{"number_of_nodes"},
+				RequiredWith:  []string{"number_of_workers"},
+			},
+			"yarn_endpoint_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zeppelin_remote_spark_interpreter_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueDevEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	name := d.Get("name").(string)
+
+	input := &glue.CreateDevEndpointInput{
+		EndpointName: aws.String(name),
+		RoleArn:      aws.String(d.Get("role_arn").(string)),
+		Tags:         keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().GlueTags(),
+	}
+
+	if v, ok := d.GetOk("arguments"); ok {
+		input.Arguments = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("extra_jars_s3_path"); ok {
+		input.ExtraJarsS3Path = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("extra_python_libs_s3_path"); ok {
+		input.ExtraPythonLibsS3Path = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("glue_version"); ok {
+		input.GlueVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("number_of_nodes"); ok {
+		input.NumberOfNodes = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("number_of_workers"); ok {
+		input.NumberOfWorkers = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("public_key"); ok {
+		input.PublicKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("public_keys"); ok && v.(*schema.Set).Len() > 0 {
+		input.PublicKeys = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("security_configuration"); ok {
+		input.SecurityConfiguration = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("subnet_id"); ok {
+		input.SubnetId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("worker_type"); ok {
+		input.WorkerType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Glue Dev Endpoint: %s", input)
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateDevEndpoint(input)
+
+		// Retry for IAM eventual consistency
+		if tfawserr.ErrMessageContains(err, glue.ErrCodeInvalidInputException, "should be given assume role permissions for Glue Service") {
+			return resource.RetryableError(err)
+		}
+
+		if tfawserr.ErrMessageContains(err, glue.ErrCodeInvalidInputException, "is not authorized to perform") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.CreateDevEndpoint(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating Glue Dev Endpoint (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.DevEndpointCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Glue Dev Endpoint (%s) to become available: %w", d.Id(), err)
+	}
+
+	return resourceAwsGlueDevEndpointRead(d, meta)
+}
+
+func resourceAwsGlueDevEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	endpoint, err := finder.DevEndpointByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Glue Dev Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Glue Dev Endpoint (%s): %w", d.Id(), err)
+	}
+
+	if endpoint == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Glue Dev Endpoint (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] Glue Dev Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	endpointARN := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "glue",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("devEndpoint/%s", d.Id()),
+	}.String()
+	d.Set("arn", endpointARN)
+
+	if err := d.Set("arguments", aws.StringValueMap(endpoint.Arguments)); err != nil {
+		return fmt.Errorf("error setting arguments: %w", err)
+	}
+
+	d.Set("availability_zone", endpoint.AvailabilityZone)
+	d.Set("extra_jars_s3_path", endpoint.ExtraJarsS3Path)
+	d.Set("extra_python_libs_s3_path", endpoint.ExtraPythonLibsS3Path)
+	d.Set("failure_reason", endpoint.FailureReason)
+	d.Set("glue_version", endpoint.GlueVersion)
+	d.Set("name", endpoint.EndpointName)
+	d.Set("number_of_nodes", endpoint.NumberOfNodes)
+	d.Set("number_of_workers", endpoint.NumberOfWorkers)
+	d.Set("private_address", endpoint.PrivateAddress)
+	d.Set("public_address", endpoint.PublicAddress)
+	d.Set("public_key", endpoint.PublicKey)
+
+	if err := d.Set("public_keys", flattenStringSet(endpoint.PublicKeys)); err != nil {
+		return fmt.Errorf("error setting public_keys: %w", err)
+	}
+
+	d.Set("role_arn", endpoint.RoleArn)
+	d.Set("security_configuration", endpoint.SecurityConfiguration)
+
+	if err := d.Set("security_group_ids", flattenStringSet(endpoint.SecurityGroupIds)); err != nil {
+		return fmt.Errorf("error setting security_group_ids: %w", err)
+	}
+
+	d.Set("status", endpoint.Status)
+	d.Set("subnet_id", endpoint.SubnetId)
+	d.Set("vpc_id", endpoint.VpcId)
+	d.Set("worker_type", endpoint.WorkerType)
+	d.Set("yarn_endpoint_address", endpoint.YarnEndpointAddress)
+	d.Set("zeppelin_remote_spark_interpreter_port", endpoint.ZeppelinRemoteSparkInterpreterPort)
+
+	tags, err := keyvaluetags.GlueListTags(conn, endpointARN)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Glue Dev Endpoint (%s): %w", endpointARN, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueDevEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	if d.HasChanges("arguments", "extra_jars_s3_path", "extra_python_libs_s3_path", "public_key", "public_keys") {
+		input := &glue.UpdateDevEndpointInput{
+			EndpointName: aws.String(d.Id()),
+		}
+
+		if d.HasChange("arguments") {
+			o, n := d.GetChange("arguments")
+			os := o.(map[string]interface{})
+			ns := n.(map[string]interface{})
+
+			var remove []*string
+			for k := range os {
+				if _, ok := ns[k]; !ok {
+					remove = append(remove, aws.String(k))
+				}
+			}
+
+			if len(ns) > 0 {
+				input.AddArguments = stringMapToPointers(ns)
+			}
+
+			if len(remove) > 0 {
+				input.DeleteArguments = remove
+			}
+		}
+
+		if d.HasChanges("extra_jars_s3_path", "extra_python_libs_s3_path") {
+			input.CustomLibraries = &glue.DevEndpointCustomLibraries{
+				ExtraJarsS3Path:       aws.String(d.Get("extra_jars_s3_path").(string)),
+				ExtraPythonLibsS3Path: aws.String(d.Get("extra_python_libs_s3_path").(string)),
+			}
+			input.UpdateEtlLibraries = aws.Bool(true)
+		}
+
+		if d.HasChange("public_key") {
+			input.PublicKey = aws.String(d.Get("public_key").(string))
+		}
+
+		if d.HasChange("public_keys") {
+			o, n := d.GetChange("public_keys")
+			os := o.(*schema.Set)
+			ns := n.(*schema.Set)
+
+			if add := ns.Difference(os); add.Len() > 0 {
+				input.AddPublicKeys = expandStringSet(add)
+			}
+
+			if remove := os.Difference(ns); remove.Len() > 0 {
+				input.DeletePublicKeys = expandStringSet(remove)
+			}
+		}
+
+		log.Printf("[DEBUG] Updating Glue Dev Endpoint: %s", input)
+		_, err := conn.UpdateDevEndpoint(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Glue Dev Endpoint (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.GlueUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Glue Dev Endpoint (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsGlueDevEndpointRead(d, meta)
+}
+
+func resourceAwsGlueDevEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[DEBUG] Deleting Glue Dev Endpoint (%s)", d.Id())
+	_, err := conn.DeleteDevEndpoint(&glue.DeleteDevEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Dev Endpoint (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.DevEndpointDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Glue Dev Endpoint (%s) to be deleted: %w", d.Id(), err)
+	}
+
+	return nil
+}
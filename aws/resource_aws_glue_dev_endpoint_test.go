@@ -0,0 +1,419 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_glue_dev_endpoint", &resource.Sweeper{
+		Name: "aws_glue_dev_endpoint",
+		F:    testSweepGlueDevEndpoints,
+	})
+}
+
+func testSweepGlueDevEndpoints(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).glueconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.GetDevEndpointsPages(&glue.GetDevEndpointsInput{}, func(page *glue.GetDevEndpointsOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, endpoint := range page.DevEndpoints {
+			name := aws.StringValue(endpoint.EndpointName)
+
+			log.Printf("[INFO] Deleting Glue Dev Endpoint: %s", name)
+			r := resourceAwsGlueDevEndpoint()
+			d := r.Data(nil)
+			d.SetId(name)
+			err := r.Delete(d, client)
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting Glue Dev Endpoint (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !isLast
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Glue Dev Endpoint sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Glue Dev Endpoints: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSGlueDevEndpoint_basic(t *testing.T) {
+	var endpoint glue.DevEndpoint
+	rName := acctest.RandomWithPrefix("tf-acc-test-glue-dev-endpoint")
+	resourceName := "aws_glue_dev_endpoint.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueDevEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueDevEndpointConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDevEndpointExists(resourceName, &endpoint),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "glue", fmt.Sprintf("devEndpoint/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "READY"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueDevEndpoint_disappears(t *testing.T) {
+	var endpoint glue.DevEndpoint
+	rName := acctest.RandomWithPrefix("tf-acc-test-glue-dev-endpoint")
+	resourceName := "aws_glue_dev_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueDevEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueDevEndpointConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDevEndpointExists(resourceName, &endpoint),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsGlueDevEndpoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueDevEndpoint_Arguments(t *testing.T) {
+	var endpoint glue.DevEndpoint
+	rName := acctest.RandomWithPrefix("tf-acc-test-glue-dev-endpoint")
+	resourceName := "aws_glue_dev_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueDevEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueDevEndpointConfigArguments1(rName, "--arg1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDevEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "arguments.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "arguments.--arg1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlueDevEndpointConfigArguments2(rName, "--arg1", "value1updated", "--arg2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDevEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "arguments.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "arguments.--arg1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "arguments.--arg2", "value2"),
+				),
+			},
+			{
+				Config: testAccGlueDevEndpointConfigArguments1(rName, "--arg2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDevEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "arguments.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "arguments.--arg2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueDevEndpoint_Tags(t *testing.T) {
+	var endpoint glue.DevEndpoint
+	rName := acctest.RandomWithPrefix("tf-acc-test-glue-dev-endpoint")
+	resourceName := "aws_glue_dev_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueDevEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueDevEndpointConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDevEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlueDevEndpointConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDevEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccGlueDevEndpointConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDevEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueDevEndpoint_WorkerType(t *testing.T) {
+	var endpoint glue.DevEndpoint
+	rName := acctest.RandomWithPrefix("tf-acc-test-glue-dev-endpoint")
+	resourceName := "aws_glue_dev_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueDevEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueDevEndpointConfigWorkerType(rName, glue.WorkerTypeG1x),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueDevEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "glue_version", "1.0"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_workers", "2"),
+					resource.TestCheckResourceAttr(resourceName, "worker_type", glue.WorkerTypeG1x),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueDevEndpointExists(n string, v *glue.DevEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Dev Endpoint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		endpoint, err := finder.DevEndpointByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if endpoint == nil {
+			return fmt.Errorf("Glue Dev Endpoint (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *endpoint
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueDevEndpointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_dev_endpoint" {
+			continue
+		}
+
+		_, err := finder.DevEndpointByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue Dev Endpoint (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccGlueDevEndpointConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "glue.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSGlueServiceRole"
+  role       = aws_iam_role.test.name
+}
+`, rName)
+}
+
+func testAccGlueDevEndpointConfigBasic(rName string) string {
+	return composeConfig(
+		testAccGlueDevEndpointConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_dev_endpoint" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccGlueDevEndpointConfigArguments1(rName, argKey1, argValue1 string) string {
+	return composeConfig(
+		testAccGlueDevEndpointConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_dev_endpoint" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  arguments = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, argKey1, argValue1))
+}
+
+func testAccGlueDevEndpointConfigArguments2(rName, argKey1, argValue1, argKey2, argValue2 string) string {
+	return composeConfig(
+		testAccGlueDevEndpointConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_dev_endpoint" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  arguments = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, argKey1, argValue1, argKey2, argValue2))
+}
+
+func testAccGlueDevEndpointConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccGlueDevEndpointConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_dev_endpoint" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccGlueDevEndpointConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccGlueDevEndpointConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_dev_endpoint" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccGlueDevEndpointConfigWorkerType(rName, workerType string) string {
+	return composeConfig(
+		testAccGlueDevEndpointConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_dev_endpoint" "test" {
+  name              = %[1]q
+  role_arn          = aws_iam_role.test.arn
+  glue_version      = "1.0"
+  number_of_workers = 2
+  worker_type       = %[2]q
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, workerType))
+}
@@ -0,0 +1,426 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_glue_ml_transform", &resource.Sweeper{
+		Name: "aws_glue_ml_transform",
+		F:    testSweepGlueMLTransforms,
+	})
+}
+
+func testSweepGlueMLTransforms(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).glueconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.GetMLTransformsPages(&glue.GetMLTransformsInput{}, func(page *glue.GetMLTransformsOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, transform := range page.Transforms {
+			id := aws.StringValue(transform.TransformId)
+
+			log.Printf("[INFO] Deleting Glue ML Transform: %s", id)
+			r := resourceAwsGlueMLTransform()
+			d := r.Data(nil)
+			d.SetId(id)
+			err := r.Delete(d, client)
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting Glue ML Transform (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !isLast
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping Glue ML Transform sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Glue ML Transforms: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSGlueMLTransform_basic(t *testing.T) {
+	var transform glue.GetMLTransformOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_ml_transform.test"
+	roleResourceName := "aws_iam_role.test"
+	tableResourceName := "aws_glue_catalog_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueMLTransformDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueMLTransformConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "glue", regexp.MustCompile(`mlTransform/tfm-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "input_record_tables.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_record_tables.0.database_name", tableResourceName, "database_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "input_record_tables.0.table_name", tableResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.transform_type", "FIND_MATCHES"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.find_matches_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.find_matches_parameters.0.primary_key_column_name", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "label_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "schema.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueMLTransform_disappears(t *testing.T) {
+	var transform glue.GetMLTransformOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_ml_transform.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueMLTransformDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueMLTransformConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsGlueMLTransform(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueMLTransform_Description(t *testing.T) {
+	var transform glue.GetMLTransformOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_ml_transform.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueMLTransformDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueMLTransformConfigDescription(rName, "First Description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					resource.TestCheckResourceAttr(resourceName, "description", "First Description"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueMLTransformConfigDescription(rName, "Second Description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					resource.TestCheckResourceAttr(resourceName, "description", "Second Description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueMLTransform_Tags(t *testing.T) {
+	var transform glue.GetMLTransformOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_ml_transform.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueMLTransformDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueMLTransformConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueMLTransformConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSGlueMLTransformConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueMLTransformExists(resourceName, &transform),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueMLTransformExists(n string, v *glue.GetMLTransformOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue ML Transform ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := finder.MLTransformByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Glue ML Transform (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueMLTransformDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_ml_transform" {
+			continue
+		}
+
+		_, err := finder.MLTransformByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue ML Transform (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSGlueMLTransformConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "glue.${data.aws_partition.current.dns_suffix}"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSGlueServiceRole"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    columns {
+      name = "my_column_1"
+      type = "int"
+    }
+
+    columns {
+      name = "my_column_2"
+      type = "string"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSGlueMLTransformConfigBasic(rName string) string {
+	return composeConfig(
+		testAccAWSGlueMLTransformConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_ml_transform" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input_record_tables {
+    database_name = aws_glue_catalog_table.test.database_name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+
+  parameters {
+    transform_type = "FIND_MATCHES"
+
+    find_matches_parameters {
+      primary_key_column_name = "my_column_1"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccAWSGlueMLTransformConfigDescription(rName, description string) string {
+	return composeConfig(
+		testAccAWSGlueMLTransformConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_ml_transform" "test" {
+  name        = %[1]q
+  description = %[2]q
+  role_arn    = aws_iam_role.test.arn
+
+  input_record_tables {
+    database_name = aws_glue_catalog_table.test.database_name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+
+  parameters {
+    transform_type = "FIND_MATCHES"
+
+    find_matches_parameters {
+      primary_key_column_name = "my_column_1"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, description))
+}
+
+func testAccAWSGlueMLTransformConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSGlueMLTransformConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_ml_transform" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input_record_tables {
+    database_name = aws_glue_catalog_table.test.database_name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+
+  parameters {
+    transform_type = "FIND_MATCHES"
+
+    find_matches_parameters {
+      primary_key_column_name = "my_column_1"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSGlueMLTransformConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSGlueMLTransformConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_ml_transform" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  input_record_tables {
+    database_name = aws_glue_catalog_table.test.database_name
+    table_name    = aws_glue_catalog_table.test.name
+  }
+
+  parameters {
+    transform_type = "FIND_MATCHES"
+
+    find_matches_parameters {
+      primary_key_column_name = "my_column_1"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
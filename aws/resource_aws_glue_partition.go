@@ -0,0 +1,242 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfglue "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+)
+
+func resourceAwsGluePartition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGluePartitionCreate,
+		Read:   resourceAwsGluePartitionRead,
+		Update: resourceAwsGluePartitionUpdate,
+		Delete: resourceAwsGluePartitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"last_accessed_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_analyzed_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"partition_values": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 1024),
+						// The character is used to separate values in the resource ID
+						validation.StringDoesNotContainAny("#"),
+					),
+				},
+			},
+			"storage_descriptor": glueStorageDescriptorSchema(),
+			"table_name": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceAwsGluePartitionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
+	dbName := d.Get("database_name").(string)
+	tableName := d.Get("table_name").(string)
+	values := expandStringList(d.Get("partition_values").([]interface{}))
+
+	input := &glue.CreatePartitionInput{
+		CatalogId:      aws.String(catalogID),
+		DatabaseName:   aws.String(dbName),
+		TableName:      aws.String(tableName),
+		PartitionInput: expandGluePartitionInput(d),
+	}
+
+	log.Printf("[DEBUG] Creating Glue Partition: %s", input)
+	_, err := conn.CreatePartition(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Glue Partition: %w", err)
+	}
+
+	d.SetId(tfglue.PartitionCreateID(catalogID, dbName, tableName, aws.StringValueSlice(values)))
+
+	return resourceAwsGluePartitionRead(d, meta)
+}
+
+func resourceAwsGluePartitionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, tableName, values, err := tfglue.PartitionParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	partition, err := finder.PartitionByValues(conn, catalogID, dbName, tableName, values)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Glue Partition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Glue Partition (%s): %w", d.Id(), err)
+	}
+
+	if partition == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Glue Partition (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] Glue Partition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("catalog_id", partition.CatalogId)
+	d.Set("database_name", partition.DatabaseName)
+	d.Set("table_name", partition.TableName)
+
+	if err := d.Set("partition_values", flattenStringList(partition.Values)); err != nil {
+		return fmt.Errorf("error setting partition_values: %w", err)
+	}
+
+	d.Set("creation_time", "")
+	if partition.CreationTime != nil {
+		d.Set("creation_time", partition.CreationTime.Format(time.RFC3339))
+	}
+
+	d.Set("last_accessed_time", "")
+	if partition.LastAccessTime != nil {
+		d.Set("last_accessed_time", partition.LastAccessTime.Format(time.RFC3339))
+	}
+
+	d.Set("last_analyzed_time", "")
+	if partition.LastAnalyzedTime != nil {
+		d.Set("last_analyzed_time", partition.LastAnalyzedTime.Format(time.RFC3339))
+	}
+
+	if err := d.Set("storage_descriptor", flattenGlueStorageDescriptor(partition.StorageDescriptor)); err != nil {
+		return fmt.Errorf("error setting storage_descriptor: %w", err)
+	}
+
+	if err := d.Set("parameters", aws.StringValueMap(partition.Parameters)); err != nil {
+		return fmt.Errorf("error setting parameters: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGluePartitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, tableName, values, err := tfglue.PartitionParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &glue.UpdatePartitionInput{
+		CatalogId:          aws.String(catalogID),
+		DatabaseName:       aws.String(dbName),
+		TableName:          aws.String(tableName),
+		PartitionInput:     expandGluePartitionInput(d),
+		PartitionValueList: aws.StringSlice(values),
+	}
+
+	log.Printf("[DEBUG] Updating Glue Partition: %s", input)
+	_, err = conn.UpdatePartition(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Glue Partition (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsGluePartitionRead(d, meta)
+}
+
+func resourceAwsGluePartitionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, tableName, values, err := tfglue.PartitionParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Glue Partition (%s)", d.Id())
+	_, err = conn.DeletePartition(&glue.DeletePartitionInput{
+		CatalogId:       aws.String(catalogID),
+		DatabaseName:    aws.String(dbName),
+		TableName:       aws.String(tableName),
+		PartitionValues: aws.StringSlice(values),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Partition (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGluePartitionInput(d *schema.ResourceData) *glue.PartitionInput {
+	partitionInput := &glue.PartitionInput{
+		Values: expandStringList(d.Get("partition_values").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("storage_descriptor"); ok {
+		partitionInput.StorageDescriptor = expandGlueStorageDescriptor(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		partitionInput.Parameters = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	return partitionInput
+}
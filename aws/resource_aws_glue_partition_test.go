@@ -0,0 +1,356 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfglue "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/glue/finder"
+)
+
+func TestAccAWSGluePartition_basic(t *testing.T) {
+	var partition glue.Partition
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_partition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGluePartitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGluePartitionConfigBasic(rName, "2020-01-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGluePartitionExists(resourceName, &partition),
+					testAccCheckResourceAttrAccountID(resourceName, "catalog_id"),
+					resource.TestCheckResourceAttr(resourceName, "database_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "table_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "partition_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partition_values.0", "2020-01-01"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGluePartition_disappears(t *testing.T) {
+	var partition glue.Partition
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_partition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGluePartitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGluePartitionConfigBasic(rName, "2020-01-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGluePartitionExists(resourceName, &partition),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsGluePartition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGluePartition_MultipleValues(t *testing.T) {
+	var partition glue.Partition
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_partition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGluePartitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGluePartitionConfigMultipleValues(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGluePartitionExists(resourceName, &partition),
+					resource.TestCheckResourceAttr(resourceName, "partition_values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "partition_values.0", "2020"),
+					resource.TestCheckResourceAttr(resourceName, "partition_values.1", "01"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGluePartition_Parameters(t *testing.T) {
+	var partition glue.Partition
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_partition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGluePartitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGluePartitionConfigParameters1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGluePartitionExists(resourceName, &partition),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGluePartitionConfigParameters2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGluePartitionExists(resourceName, &partition),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccGluePartitionConfigBasic(rName, "2020-01-01"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGluePartitionExists(resourceName, &partition),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGluePartition_StorageDescriptor(t *testing.T) {
+	var partition glue.Partition
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_partition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGluePartitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGluePartitionConfigStorageDescriptor(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGluePartitionExists(resourceName, &partition),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.location", fmt.Sprintf("s3://%s/2020-01-01", rName)),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.0.name", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.ser_de_info.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.ser_de_info.0.serialization_library", "org.apache.hadoop.hive.serde2.columnar.ColumnarSerDe"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGluePartitionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_partition" {
+			continue
+		}
+
+		catalogID, dbName, tableName, values, err := tfglue.PartitionParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = finder.PartitionByValues(conn, catalogID, dbName, tableName, values)
+
+		if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Glue Partition (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckGluePartitionExists(n string, v *glue.Partition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Glue Partition ID is set")
+		}
+
+		catalogID, dbName, tableName, values, err := tfglue.PartitionParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		partition, err := finder.PartitionByValues(conn, catalogID, dbName, tableName, values)
+
+		if err != nil {
+			return err
+		}
+
+		if partition == nil {
+			return fmt.Errorf("Glue Partition (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *partition
+
+		return nil
+	}
+}
+
+func testAccGluePartitionConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  partition_keys {
+    name = "date"
+    type = "string"
+  }
+}
+`, rName)
+}
+
+func testAccGluePartitionConfigBasic(rName, value string) string {
+	return composeConfig(
+		testAccGluePartitionConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_partition" "test" {
+  database_name    = aws_glue_catalog_database.test.name
+  table_name       = aws_glue_catalog_table.test.name
+  partition_values = [%[1]q]
+}
+`, value))
+}
+
+func testAccGluePartitionConfigMultipleValues(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  partition_keys {
+    name = "year"
+    type = "string"
+  }
+
+  partition_keys {
+    name = "month"
+    type = "string"
+  }
+}
+
+resource "aws_glue_partition" "test" {
+  database_name    = aws_glue_catalog_database.test.name
+  table_name       = aws_glue_catalog_table.test.name
+  partition_values = ["2020", "01"]
+}
+`, rName)
+}
+
+func testAccGluePartitionConfigParameters1(rName, parameterKey1, parameterValue1 string) string {
+	return composeConfig(
+		testAccGluePartitionConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_partition" "test" {
+  database_name    = aws_glue_catalog_database.test.name
+  table_name       = aws_glue_catalog_table.test.name
+  partition_values = ["2020-01-01"]
+
+  parameters = {
+    %[1]q = %[2]q
+  }
+}
+`, parameterKey1, parameterValue1))
+}
+
+func testAccGluePartitionConfigParameters2(rName, parameterKey1, parameterValue1, parameterKey2, parameterValue2 string) string {
+	return composeConfig(
+		testAccGluePartitionConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_partition" "test" {
+  database_name    = aws_glue_catalog_database.test.name
+  table_name       = aws_glue_catalog_table.test.name
+  partition_values = ["2020-01-01"]
+
+  parameters = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, parameterKey1, parameterValue1, parameterKey2, parameterValue2))
+}
+
+func testAccGluePartitionConfigStorageDescriptor(rName string) string {
+	return composeConfig(
+		testAccGluePartitionConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_glue_partition" "test" {
+  database_name    = aws_glue_catalog_database.test.name
+  table_name       = aws_glue_catalog_table.test.name
+  partition_values = ["2020-01-01"]
+
+  storage_descriptor {
+    location      = "s3://%[1]s/2020-01-01"
+    input_format  = "org.apache.hadoop.hive.ql.io.RCFileInputFormat"
+    output_format = "org.apache.hadoop.hive.ql.io.RCFileOutputFormat"
+
+    columns {
+      name    = "my_column_1"
+      type    = "int"
+      comment = "my_column1_comment"
+    }
+
+    ser_de_info {
+      name                  = "ser_de_name"
+      serialization_library = "org.apache.hadoop.hive.serde2.columnar.ColumnarSerDe"
+    }
+  }
+}
+`, rName))
+}
@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAwsGlueResourcePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueResourcePolicyCreate,
+		Read:   resourceAwsGlueResourcePolicyRead,
+		Update: resourceAwsGlueResourcePolicyUpdate,
+		Delete: resourceAwsGlueResourcePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+		},
+	}
+}
+
+func resourceAwsGlueResourcePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input := &glue.PutResourcePolicyInput{
+		PolicyInJson:          aws.String(d.Get("policy").(string)),
+		PolicyExistsCondition: aws.String(glue.ExistConditionNotExist),
+	}
+
+	log.Printf("[DEBUG] Creating Glue Resource Policy: %s", input)
+	_, err := conn.PutResourcePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Glue Resource Policy: %w", err)
+	}
+
+	// There is a single resource policy per Data Catalog, i.e. per account and region.
+	d.SetId(meta.(*AWSClient).region)
+
+	return resourceAwsGlueResourcePolicyRead(d, meta)
+}
+
+func resourceAwsGlueResourcePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	output, err := conn.GetResourcePolicy(&glue.GetResourcePolicyInput{})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		log.Printf("[WARN] Glue Resource Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Glue Resource Policy (%s): %w", d.Id(), err)
+	}
+
+	d.Set("policy", output.PolicyInJson)
+
+	return nil
+}
+
+func resourceAwsGlueResourcePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input := &glue.PutResourcePolicyInput{
+		PolicyInJson:          aws.String(d.Get("policy").(string)),
+		PolicyExistsCondition: aws.String(glue.ExistConditionMustExist),
+	}
+
+	log.Printf("[DEBUG] Updating Glue Resource Policy: %s", input)
+	_, err := conn.PutResourcePolicy(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating Glue Resource Policy (%s): %w", d.Id(), err)
+	}
+
+	return resourceAwsGlueResourcePolicyRead(d, meta)
+}
+
+func resourceAwsGlueResourcePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	log.Printf("[DEBUG] Deleting Glue Resource Policy (%s)", d.Id())
+	_, err := conn.DeleteResourcePolicy(&glue.DeleteResourcePolicyInput{})
+
+	if tfawserr.ErrCodeEquals(err, glue.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Glue Resource Policy (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+)
+
+func resourceAwsIotAnalyticsChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsChannelCreate,
+		Read:   resourceAwsIotAnalyticsChannelRead,
+		Update: resourceAwsIotAnalyticsChannelUpdate,
+		Delete: resourceAwsIotAnalyticsChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"customer_managed_s3": iotAnalyticsCustomerManagedS3Schema(),
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIotAnalyticsName,
+			},
+
+			"retention_period": iotAnalyticsRetentionPeriodSchema(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateChannelInput{
+		ChannelName:     aws.String(name),
+		ChannelStorage:  expandIotAnalyticsChannelStorage(d.Get("customer_managed_s3").([]interface{})),
+		RetentionPeriod: expandIotAnalyticsRetentionPeriod(d.Get("retention_period").([]interface{})),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Channel: %s", input)
+	_, err := conn.CreateChannel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Channel (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsChannelRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	channel, err := finder.ChannelByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] IoT Analytics Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Channel (%s): %w", d.Id(), err)
+	}
+
+	if channel == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading IoT Analytics Channel (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] IoT Analytics Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(channel.Arn)
+	d.Set("arn", arn)
+	d.Set("name", channel.Name)
+
+	var customerManagedS3 []interface{}
+	if channel.Storage != nil && channel.Storage.CustomerManagedS3 != nil {
+		customerManagedS3 = flattenIotAnalyticsCustomerManagedS3(channel.Storage.CustomerManagedS3.Bucket, channel.Storage.CustomerManagedS3.KeyPrefix, channel.Storage.CustomerManagedS3.RoleArn)
+	}
+
+	if err := d.Set("customer_managed_s3", customerManagedS3); err != nil {
+		return fmt.Errorf("error setting customer_managed_s3: %w", err)
+	}
+
+	if err := d.Set("retention_period", flattenIotAnalyticsRetentionPeriod(channel.RetentionPeriod)); err != nil {
+		return fmt.Errorf("error setting retention_period: %w", err)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Channel (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChanges("customer_managed_s3", "retention_period") {
+		input := &iotanalytics.UpdateChannelInput{
+			ChannelName:     aws.String(d.Id()),
+			ChannelStorage:  expandIotAnalyticsChannelStorage(d.Get("customer_managed_s3").([]interface{})),
+			RetentionPeriod: expandIotAnalyticsRetentionPeriod(d.Get("retention_period").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Channel: %s", input)
+		_, err := conn.UpdateChannel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Channel (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Channel (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsChannelRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Channel (%s)", d.Id())
+	_, err := conn.DeleteChannel(&iotanalytics.DeleteChannelInput{
+		ChannelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Channel (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+var validateIotAnalyticsName = validation.All(
+	validation.StringLenBetween(1, 128),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_]+$`), "must contain only alphanumeric characters and underscores"),
+)
+
+func iotAnalyticsCustomerManagedS3Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"bucket": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(3, 255),
+				},
+				"key_prefix": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringMatch(regexp.MustCompile(`/$`), "must end with a forward slash"),
+					),
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+			},
+		},
+	}
+}
+
+func iotAnalyticsRetentionPeriodSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"number_of_days": {
+					Type:          schema.TypeInt,
+					Optional:      true,
+					ValidateFunc:  validation.IntAtLeast(1),
+					ConflictsWith: []string{"retention_period.0.unlimited"},
+				},
+				"unlimited": {
+					Type:          schema.TypeBool,
+					Optional:      true,
+					Computed:      true,
+					ConflictsWith: []string{"retention_period.0.number_of_days"},
+				},
+			},
+		},
+	}
+}
+
+func expandIotAnalyticsChannelStorage(vCustomerManagedS3 []interface{}) *iotanalytics.ChannelStorage {
+	if len(vCustomerManagedS3) == 0 || vCustomerManagedS3[0] == nil {
+		return &iotanalytics.ChannelStorage{
+			ServiceManagedS3: &iotanalytics.ServiceManagedChannelS3Storage{},
+		}
+	}
+
+	mCustomerManagedS3 := vCustomerManagedS3[0].(map[string]interface{})
+
+	customerManagedS3 := &iotanalytics.CustomerManagedChannelS3Storage{
+		Bucket:  aws.String(mCustomerManagedS3["bucket"].(string)),
+		RoleArn: aws.String(mCustomerManagedS3["role_arn"].(string)),
+	}
+
+	if v, ok := mCustomerManagedS3["key_prefix"].(string); ok && v != "" {
+		customerManagedS3.KeyPrefix = aws.String(v)
+	}
+
+	return &iotanalytics.ChannelStorage{
+		CustomerManagedS3: customerManagedS3,
+	}
+}
+
+func expandIotAnalyticsRetentionPeriod(vRetentionPeriod []interface{}) *iotanalytics.RetentionPeriod {
+	if len(vRetentionPeriod) == 0 || vRetentionPeriod[0] == nil {
+		return nil
+	}
+
+	mRetentionPeriod := vRetentionPeriod[0].(map[string]interface{})
+
+	retentionPeriod := &iotanalytics.RetentionPeriod{}
+
+	if v, ok := mRetentionPeriod["number_of_days"].(int); ok && v > 0 {
+		retentionPeriod.NumberOfDays = aws.Int64(int64(v))
+	} else {
+		retentionPeriod.Unlimited = aws.Bool(true)
+	}
+
+	return retentionPeriod
+}
+
+func flattenIotAnalyticsCustomerManagedS3(bucket, keyPrefix, roleArn *string) []interface{} {
+	mCustomerManagedS3 := map[string]interface{}{
+		"bucket":     aws.StringValue(bucket),
+		"key_prefix": aws.StringValue(keyPrefix),
+		"role_arn":   aws.StringValue(roleArn),
+	}
+
+	return []interface{}{mCustomerManagedS3}
+}
+
+func flattenIotAnalyticsRetentionPeriod(retentionPeriod *iotanalytics.RetentionPeriod) []interface{} {
+	if retentionPeriod == nil {
+		return []interface{}{}
+	}
+
+	mRetentionPeriod := map[string]interface{}{
+		"number_of_days": int(aws.Int64Value(retentionPeriod.NumberOfDays)),
+		"unlimited":      aws.BoolValue(retentionPeriod.Unlimited),
+	}
+
+	return []interface{}{mRetentionPeriod}
+}
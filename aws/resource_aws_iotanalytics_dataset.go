@@ -0,0 +1,852 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+)
+
+func resourceAwsIotAnalyticsDataset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsDatasetCreate,
+		Read:   resourceAwsIotAnalyticsDatasetRead,
+		Update: resourceAwsIotAnalyticsDatasetUpdate,
+		Delete: resourceAwsIotAnalyticsDatasetDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"container_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"execution_role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+									"image": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 255),
+									},
+									"resource_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compute_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(iotanalytics.ComputeType_Values(), false),
+												},
+												"volume_size_in_gb": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 50),
+												},
+											},
+										},
+									},
+									"variable": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 50,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dataset_content_version_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"dataset_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateIotAnalyticsName,
+															},
+														},
+													},
+												},
+												"double_value": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+												"output_file_uri_value": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"file_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+												"string_value": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(0, 1024),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"query_action": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"filter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delta_time": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"offset_seconds": {
+																Type:     schema.TypeInt,
+																Required: true,
+															},
+															"time_expression": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"sql_query": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_delivery_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"iot_events_destination_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"input_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 128),
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateArn,
+												},
+											},
+										},
+									},
+									"s3_destination_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"bucket": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(3, 255),
+												},
+												"glue_configuration": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"database_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 150),
+															},
+															"table_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 150),
+															},
+														},
+													},
+												},
+												"key": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 255),
+												},
+												"role_arn": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validateArn,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"entry_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIotAnalyticsName,
+			},
+
+			"retention_period": iotAnalyticsRetentionPeriodSchema(),
+
+			"tags": tagsSchema(),
+
+			"trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateIotAnalyticsName,
+									},
+								},
+							},
+						},
+						"schedule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expression": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"versioning_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_versions": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ValidateFunc:  validation.IntBetween(1, 1000),
+							ConflictsWith: []string{"versioning_configuration.0.unlimited"},
+						},
+						"unlimited": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							ConflictsWith: []string{"versioning_configuration.0.max_versions"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsDatasetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateDatasetInput{
+		Actions:                 expandIotAnalyticsDatasetActions(d.Get("action").([]interface{})),
+		ContentDeliveryRules:    expandIotAnalyticsDatasetContentDeliveryRules(d.Get("content_delivery_rule").([]interface{})),
+		DatasetName:             aws.String(name),
+		RetentionPeriod:         expandIotAnalyticsRetentionPeriod(d.Get("retention_period").([]interface{})),
+		Triggers:                expandIotAnalyticsDatasetTriggers(d.Get("trigger").([]interface{})),
+		VersioningConfiguration: expandIotAnalyticsVersioningConfiguration(d.Get("versioning_configuration").([]interface{})),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Dataset: %s", input)
+	_, err := conn.CreateDataset(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Dataset (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsDatasetRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatasetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	dataset, err := finder.DatasetByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] IoT Analytics Dataset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Dataset (%s): %w", d.Id(), err)
+	}
+
+	if dataset == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading IoT Analytics Dataset (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] IoT Analytics Dataset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(dataset.Arn)
+	d.Set("arn", arn)
+	d.Set("name", dataset.Name)
+
+	if err := d.Set("action", flattenIotAnalyticsDatasetActions(dataset.Actions)); err != nil {
+		return fmt.Errorf("error setting action: %w", err)
+	}
+
+	if err := d.Set("content_delivery_rule", flattenIotAnalyticsDatasetContentDeliveryRules(dataset.ContentDeliveryRules)); err != nil {
+		return fmt.Errorf("error setting content_delivery_rule: %w", err)
+	}
+
+	if err := d.Set("retention_period", flattenIotAnalyticsRetentionPeriod(dataset.RetentionPeriod)); err != nil {
+		return fmt.Errorf("error setting retention_period: %w", err)
+	}
+
+	if err := d.Set("trigger", flattenIotAnalyticsDatasetTriggers(dataset.Triggers)); err != nil {
+		return fmt.Errorf("error setting trigger: %w", err)
+	}
+
+	if err := d.Set("versioning_configuration", flattenIotAnalyticsVersioningConfiguration(dataset.VersioningConfiguration)); err != nil {
+		return fmt.Errorf("error setting versioning_configuration: %w", err)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Dataset (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsDatasetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChanges("action", "content_delivery_rule", "retention_period", "trigger", "versioning_configuration") {
+		input := &iotanalytics.UpdateDatasetInput{
+			Actions:                 expandIotAnalyticsDatasetActions(d.Get("action").([]interface{})),
+			ContentDeliveryRules:    expandIotAnalyticsDatasetContentDeliveryRules(d.Get("content_delivery_rule").([]interface{})),
+			DatasetName:             aws.String(d.Id()),
+			RetentionPeriod:         expandIotAnalyticsRetentionPeriod(d.Get("retention_period").([]interface{})),
+			Triggers:                expandIotAnalyticsDatasetTriggers(d.Get("trigger").([]interface{})),
+			VersioningConfiguration: expandIotAnalyticsVersioningConfiguration(d.Get("versioning_configuration").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Dataset: %s", input)
+		_, err := conn.UpdateDataset(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Dataset (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Dataset (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsDatasetRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatasetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Dataset (%s)", d.Id())
+	_, err := conn.DeleteDataset(&iotanalytics.DeleteDatasetInput{
+		DatasetName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Dataset (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotAnalyticsDatasetActions(vActions []interface{}) []*iotanalytics.DatasetAction {
+	actions := []*iotanalytics.DatasetAction{}
+
+	for _, vAction := range vActions {
+		mAction, ok := vAction.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		action := &iotanalytics.DatasetAction{
+			ActionName: aws.String(mAction["name"].(string)),
+		}
+
+		if m := iotAnalyticsBlockMap(mAction["container_action"]); m != nil {
+			action.ContainerAction = &iotanalytics.ContainerDatasetAction{
+				ExecutionRoleArn: aws.String(m["execution_role_arn"].(string)),
+				Image:            aws.String(m["image"].(string)),
+				Variables:        expandIotAnalyticsDatasetVariables(m["variable"].([]interface{})),
+			}
+
+			if mResourceConfiguration := iotAnalyticsBlockMap(m["resource_configuration"]); mResourceConfiguration != nil {
+				action.ContainerAction.ResourceConfiguration = &iotanalytics.ResourceConfiguration{
+					ComputeType:    aws.String(mResourceConfiguration["compute_type"].(string)),
+					VolumeSizeInGB: aws.Int64(int64(mResourceConfiguration["volume_size_in_gb"].(int))),
+				}
+			}
+		}
+
+		if m := iotAnalyticsBlockMap(mAction["query_action"]); m != nil {
+			action.QueryAction = &iotanalytics.SqlQueryDatasetAction{
+				SqlQuery: aws.String(m["sql_query"].(string)),
+			}
+
+			if mFilter := iotAnalyticsBlockMap(m["filter"]); mFilter != nil {
+				if mDeltaTime := iotAnalyticsBlockMap(mFilter["delta_time"]); mDeltaTime != nil {
+					action.QueryAction.Filters = []*iotanalytics.QueryFilter{
+						{
+							DeltaTime: &iotanalytics.DeltaTime{
+								OffsetSeconds:  aws.Int64(int64(mDeltaTime["offset_seconds"].(int))),
+								TimeExpression: aws.String(mDeltaTime["time_expression"].(string)),
+							},
+						},
+					}
+				}
+			}
+		}
+
+		actions = append(actions, action)
+	}
+
+	return actions
+}
+
+func expandIotAnalyticsDatasetVariables(vVariables []interface{}) []*iotanalytics.Variable {
+	if len(vVariables) == 0 {
+		return nil
+	}
+
+	variables := []*iotanalytics.Variable{}
+
+	for _, vVariable := range vVariables {
+		mVariable, ok := vVariable.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		variable := &iotanalytics.Variable{
+			Name: aws.String(mVariable["name"].(string)),
+		}
+
+		if m := iotAnalyticsBlockMap(mVariable["dataset_content_version_value"]); m != nil {
+			variable.DatasetContentVersionValue = &iotanalytics.DatasetContentVersionValue{
+				DatasetName: aws.String(m["dataset_name"].(string)),
+			}
+		}
+
+		if v, ok := mVariable["double_value"].(float64); ok && v != 0 {
+			variable.DoubleValue = aws.Float64(v)
+		}
+
+		if m := iotAnalyticsBlockMap(mVariable["output_file_uri_value"]); m != nil {
+			variable.OutputFileUriValue = &iotanalytics.OutputFileUriValue{
+				FileName: aws.String(m["file_name"].(string)),
+			}
+		}
+
+		if v, ok := mVariable["string_value"].(string); ok && v != "" {
+			variable.StringValue = aws.String(v)
+		}
+
+		variables = append(variables, variable)
+	}
+
+	return variables
+}
+
+func expandIotAnalyticsDatasetContentDeliveryRules(vRules []interface{}) []*iotanalytics.DatasetContentDeliveryRule {
+	if len(vRules) == 0 {
+		return nil
+	}
+
+	rules := []*iotanalytics.DatasetContentDeliveryRule{}
+
+	for _, vRule := range vRules {
+		mRule, ok := vRule.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rule := &iotanalytics.DatasetContentDeliveryRule{
+			Destination: &iotanalytics.DatasetContentDeliveryDestination{},
+		}
+
+		if v, ok := mRule["entry_name"].(string); ok && v != "" {
+			rule.EntryName = aws.String(v)
+		}
+
+		if mDestination := iotAnalyticsBlockMap(mRule["destination"]); mDestination != nil {
+			if m := iotAnalyticsBlockMap(mDestination["iot_events_destination_configuration"]); m != nil {
+				rule.Destination.IotEventsDestinationConfiguration = &iotanalytics.IotEventsDestinationConfiguration{
+					InputName: aws.String(m["input_name"].(string)),
+					RoleArn:   aws.String(m["role_arn"].(string)),
+				}
+			}
+
+			if m := iotAnalyticsBlockMap(mDestination["s3_destination_configuration"]); m != nil {
+				rule.Destination.S3DestinationConfiguration = &iotanalytics.S3DestinationConfiguration{
+					Bucket:  aws.String(m["bucket"].(string)),
+					Key:     aws.String(m["key"].(string)),
+					RoleArn: aws.String(m["role_arn"].(string)),
+				}
+
+				if mGlueConfiguration := iotAnalyticsBlockMap(m["glue_configuration"]); mGlueConfiguration != nil {
+					rule.Destination.S3DestinationConfiguration.GlueConfiguration = &iotanalytics.GlueConfiguration{
+						DatabaseName: aws.String(mGlueConfiguration["database_name"].(string)),
+						TableName:    aws.String(mGlueConfiguration["table_name"].(string)),
+					}
+				}
+			}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandIotAnalyticsDatasetTriggers(vTriggers []interface{}) []*iotanalytics.DatasetTrigger {
+	if len(vTriggers) == 0 {
+		return nil
+	}
+
+	triggers := []*iotanalytics.DatasetTrigger{}
+
+	for _, vTrigger := range vTriggers {
+		mTrigger, ok := vTrigger.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		trigger := &iotanalytics.DatasetTrigger{}
+
+		if m := iotAnalyticsBlockMap(mTrigger["dataset"]); m != nil {
+			trigger.Dataset = &iotanalytics.TriggeringDataset{
+				Name: aws.String(m["name"].(string)),
+			}
+		}
+
+		if m := iotAnalyticsBlockMap(mTrigger["schedule"]); m != nil {
+			trigger.Schedule = &iotanalytics.Schedule{
+				Expression: aws.String(m["expression"].(string)),
+			}
+		}
+
+		triggers = append(triggers, trigger)
+	}
+
+	return triggers
+}
+
+func expandIotAnalyticsVersioningConfiguration(vVersioningConfiguration []interface{}) *iotanalytics.VersioningConfiguration {
+	if len(vVersioningConfiguration) == 0 || vVersioningConfiguration[0] == nil {
+		return nil
+	}
+
+	mVersioningConfiguration := vVersioningConfiguration[0].(map[string]interface{})
+
+	versioningConfiguration := &iotanalytics.VersioningConfiguration{}
+
+	if v, ok := mVersioningConfiguration["max_versions"].(int); ok && v > 0 {
+		versioningConfiguration.MaxVersions = aws.Int64(int64(v))
+	} else if v, ok := mVersioningConfiguration["unlimited"].(bool); ok && v {
+		versioningConfiguration.Unlimited = aws.Bool(v)
+	} else {
+		return nil
+	}
+
+	return versioningConfiguration
+}
+
+func flattenIotAnalyticsDatasetActions(actions []*iotanalytics.DatasetAction) []interface{} {
+	vActions := []interface{}{}
+
+	for _, action := range actions {
+		if action == nil {
+			continue
+		}
+
+		mAction := map[string]interface{}{
+			"name": aws.StringValue(action.ActionName),
+		}
+
+		if v := action.ContainerAction; v != nil {
+			mContainerAction := map[string]interface{}{
+				"execution_role_arn": aws.StringValue(v.ExecutionRoleArn),
+				"image":              aws.StringValue(v.Image),
+				"variable":           flattenIotAnalyticsDatasetVariables(v.Variables),
+			}
+
+			if v := v.ResourceConfiguration; v != nil {
+				mContainerAction["resource_configuration"] = []interface{}{map[string]interface{}{
+					"compute_type":      aws.StringValue(v.ComputeType),
+					"volume_size_in_gb": int(aws.Int64Value(v.VolumeSizeInGB)),
+				}}
+			}
+
+			mAction["container_action"] = []interface{}{mContainerAction}
+		}
+
+		if v := action.QueryAction; v != nil {
+			mQueryAction := map[string]interface{}{
+				"sql_query": aws.StringValue(v.SqlQuery),
+			}
+
+			if len(v.Filters) > 0 && v.Filters[0] != nil && v.Filters[0].DeltaTime != nil {
+				mQueryAction["filter"] = []interface{}{map[string]interface{}{
+					"delta_time": []interface{}{map[string]interface{}{
+						"offset_seconds":  int(aws.Int64Value(v.Filters[0].DeltaTime.OffsetSeconds)),
+						"time_expression": aws.StringValue(v.Filters[0].DeltaTime.TimeExpression),
+					}},
+				}}
+			}
+
+			mAction["query_action"] = []interface{}{mQueryAction}
+		}
+
+		vActions = append(vActions, mAction)
+	}
+
+	return vActions
+}
+
+func flattenIotAnalyticsDatasetVariables(variables []*iotanalytics.Variable) []interface{} {
+	vVariables := []interface{}{}
+
+	for _, variable := range variables {
+		if variable == nil {
+			continue
+		}
+
+		mVariable := map[string]interface{}{
+			"double_value": aws.Float64Value(variable.DoubleValue),
+			"name":         aws.StringValue(variable.Name),
+			"string_value": aws.StringValue(variable.StringValue),
+		}
+
+		if v := variable.DatasetContentVersionValue; v != nil {
+			mVariable["dataset_content_version_value"] = []interface{}{map[string]interface{}{
+				"dataset_name": aws.StringValue(v.DatasetName),
+			}}
+		}
+
+		if v := variable.OutputFileUriValue; v != nil {
+			mVariable["output_file_uri_value"] = []interface{}{map[string]interface{}{
+				"file_name": aws.StringValue(v.FileName),
+			}}
+		}
+
+		vVariables = append(vVariables, mVariable)
+	}
+
+	return vVariables
+}
+
+func flattenIotAnalyticsDatasetContentDeliveryRules(rules []*iotanalytics.DatasetContentDeliveryRule) []interface{} {
+	vRules := []interface{}{}
+
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		mRule := map[string]interface{}{
+			"entry_name": aws.StringValue(rule.EntryName),
+		}
+
+		if destination := rule.Destination; destination != nil {
+			mDestination := map[string]interface{}{}
+
+			if v := destination.IotEventsDestinationConfiguration; v != nil {
+				mDestination["iot_events_destination_configuration"] = []interface{}{map[string]interface{}{
+					"input_name": aws.StringValue(v.InputName),
+					"role_arn":   aws.StringValue(v.RoleArn),
+				}}
+			}
+
+			if v := destination.S3DestinationConfiguration; v != nil {
+				mS3DestinationConfiguration := map[string]interface{}{
+					"bucket":   aws.StringValue(v.Bucket),
+					"key":      aws.StringValue(v.Key),
+					"role_arn": aws.StringValue(v.RoleArn),
+				}
+
+				if v := v.GlueConfiguration; v != nil {
+					mS3DestinationConfiguration["glue_configuration"] = []interface{}{map[string]interface{}{
+						"database_name": aws.StringValue(v.DatabaseName),
+						"table_name":    aws.StringValue(v.TableName),
+					}}
+				}
+
+				mDestination["s3_destination_configuration"] = []interface{}{mS3DestinationConfiguration}
+			}
+
+			mRule["destination"] = []interface{}{mDestination}
+		}
+
+		vRules = append(vRules, mRule)
+	}
+
+	return vRules
+}
+
+func flattenIotAnalyticsDatasetTriggers(triggers []*iotanalytics.DatasetTrigger) []interface{} {
+	vTriggers := []interface{}{}
+
+	for _, trigger := range triggers {
+		if trigger == nil {
+			continue
+		}
+
+		mTrigger := map[string]interface{}{}
+
+		if v := trigger.Dataset; v != nil {
+			mTrigger["dataset"] = []interface{}{map[string]interface{}{
+				"name": aws.StringValue(v.Name),
+			}}
+		}
+
+		if v := trigger.Schedule; v != nil {
+			mTrigger["schedule"] = []interface{}{map[string]interface{}{
+				"expression": aws.StringValue(v.Expression),
+			}}
+		}
+
+		vTriggers = append(vTriggers, mTrigger)
+	}
+
+	return vTriggers
+}
+
+func flattenIotAnalyticsVersioningConfiguration(versioningConfiguration *iotanalytics.VersioningConfiguration) []interface{} {
+	if versioningConfiguration == nil {
+		return []interface{}{}
+	}
+
+	mVersioningConfiguration := map[string]interface{}{
+		"max_versions": int(aws.Int64Value(versioningConfiguration.MaxVersions)),
+		"unlimited":    aws.BoolValue(versioningConfiguration.Unlimited),
+	}
+
+	return []interface{}{mVersioningConfiguration}
+}
@@ -0,0 +1,199 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+)
+
+func resourceAwsIotAnalyticsDatastore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsDatastoreCreate,
+		Read:   resourceAwsIotAnalyticsDatastoreRead,
+		Update: resourceAwsIotAnalyticsDatastoreUpdate,
+		Delete: resourceAwsIotAnalyticsDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"customer_managed_s3": iotAnalyticsCustomerManagedS3Schema(),
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIotAnalyticsName,
+			},
+
+			"retention_period": iotAnalyticsRetentionPeriodSchema(),
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsDatastoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreateDatastoreInput{
+		DatastoreName:    aws.String(name),
+		DatastoreStorage: expandIotAnalyticsDatastoreStorage(d.Get("customer_managed_s3").([]interface{})),
+		RetentionPeriod:  expandIotAnalyticsRetentionPeriod(d.Get("retention_period").([]interface{})),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Datastore: %s", input)
+	_, err := conn.CreateDatastore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Datastore (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsDatastoreRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatastoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	datastore, err := finder.DatastoreByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] IoT Analytics Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Datastore (%s): %w", d.Id(), err)
+	}
+
+	if datastore == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading IoT Analytics Datastore (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] IoT Analytics Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(datastore.Arn)
+	d.Set("arn", arn)
+	d.Set("name", datastore.Name)
+
+	var customerManagedS3 []interface{}
+	if datastore.Storage != nil && datastore.Storage.CustomerManagedS3 != nil {
+		customerManagedS3 = flattenIotAnalyticsCustomerManagedS3(datastore.Storage.CustomerManagedS3.Bucket, datastore.Storage.CustomerManagedS3.KeyPrefix, datastore.Storage.CustomerManagedS3.RoleArn)
+	}
+
+	if err := d.Set("customer_managed_s3", customerManagedS3); err != nil {
+		return fmt.Errorf("error setting customer_managed_s3: %w", err)
+	}
+
+	if err := d.Set("retention_period", flattenIotAnalyticsRetentionPeriod(datastore.RetentionPeriod)); err != nil {
+		return fmt.Errorf("error setting retention_period: %w", err)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Datastore (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsDatastoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChanges("customer_managed_s3", "retention_period") {
+		input := &iotanalytics.UpdateDatastoreInput{
+			DatastoreName:    aws.String(d.Id()),
+			DatastoreStorage: expandIotAnalyticsDatastoreStorage(d.Get("customer_managed_s3").([]interface{})),
+			RetentionPeriod:  expandIotAnalyticsRetentionPeriod(d.Get("retention_period").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Datastore: %s", input)
+		_, err := conn.UpdateDatastore(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Datastore (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Datastore (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsDatastoreRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsDatastoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Datastore (%s)", d.Id())
+	_, err := conn.DeleteDatastore(&iotanalytics.DeleteDatastoreInput{
+		DatastoreName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Datastore (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotAnalyticsDatastoreStorage(vCustomerManagedS3 []interface{}) *iotanalytics.DatastoreStorage {
+	if len(vCustomerManagedS3) == 0 || vCustomerManagedS3[0] == nil {
+		return &iotanalytics.DatastoreStorage{
+			ServiceManagedS3: &iotanalytics.ServiceManagedDatastoreS3Storage{},
+		}
+	}
+
+	mCustomerManagedS3 := vCustomerManagedS3[0].(map[string]interface{})
+
+	customerManagedS3 := &iotanalytics.CustomerManagedDatastoreS3Storage{
+		Bucket:  aws.String(mCustomerManagedS3["bucket"].(string)),
+		RoleArn: aws.String(mCustomerManagedS3["role_arn"].(string)),
+	}
+
+	if v, ok := mCustomerManagedS3["key_prefix"].(string); ok && v != "" {
+		customerManagedS3.KeyPrefix = aws.String(v)
+	}
+
+	return &iotanalytics.DatastoreStorage{
+		CustomerManagedS3: customerManagedS3,
+	}
+}
@@ -0,0 +1,532 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+)
+
+func resourceAwsIotAnalyticsPipeline() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotAnalyticsPipelineCreate,
+		Read:   resourceAwsIotAnalyticsPipelineRead,
+		Update: resourceAwsIotAnalyticsPipelineUpdate,
+		Delete: resourceAwsIotAnalyticsPipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIotAnalyticsName,
+			},
+
+			"pipeline_activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 25,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"add_attributes": iotAnalyticsPipelineActivitySchema(map[string]*schema.Schema{
+							"attributes": {
+								Type:     schema.TypeMap,
+								Required: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						}),
+
+						"channel": iotAnalyticsPipelineActivitySchema(map[string]*schema.Schema{
+							"channel_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateIotAnalyticsName,
+							},
+						}),
+
+						"datastore": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"datastore_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateIotAnalyticsName,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+
+						"device_registry_enrich": iotAnalyticsPipelineActivitySchema(iotAnalyticsPipelineDeviceEnrichSchema()),
+
+						"device_shadow_enrich": iotAnalyticsPipelineActivitySchema(iotAnalyticsPipelineDeviceEnrichSchema()),
+
+						"filter": iotAnalyticsPipelineActivitySchema(map[string]*schema.Schema{
+							"filter": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						}),
+
+						"lambda": iotAnalyticsPipelineActivitySchema(map[string]*schema.Schema{
+							"batch_size": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(1, 1000),
+							},
+							"lambda_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 64),
+							},
+						}),
+
+						"math": iotAnalyticsPipelineActivitySchema(map[string]*schema.Schema{
+							"attribute": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+							"math": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 256),
+							},
+						}),
+
+						"remove_attributes": iotAnalyticsPipelineActivitySchema(map[string]*schema.Schema{
+							"attributes": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 50,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						}),
+
+						"select_attributes": iotAnalyticsPipelineActivitySchema(map[string]*schema.Schema{
+							"attributes": {
+								Type:     schema.TypeList,
+								Required: true,
+								MinItems: 1,
+								MaxItems: 50,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						}),
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+// iotAnalyticsPipelineActivitySchema returns the schema for a pipeline activity
+// with the common name and next arguments merged into the specified arguments.
+func iotAnalyticsPipelineActivitySchema(s map[string]*schema.Schema) *schema.Schema {
+	s["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+	}
+	s["next"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(1, 128),
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func iotAnalyticsPipelineDeviceEnrichSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"attribute": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 256),
+		},
+		"role_arn": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateArn,
+		},
+		"thing_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 256),
+		},
+	}
+}
+
+func resourceAwsIotAnalyticsPipelineCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	name := d.Get("name").(string)
+	input := &iotanalytics.CreatePipelineInput{
+		PipelineActivities: expandIotAnalyticsPipelineActivities(d.Get("pipeline_activity").([]interface{})),
+		PipelineName:       aws.String(name),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().IotanalyticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Analytics Pipeline: %s", input)
+	_, err := conn.CreatePipeline(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Analytics Pipeline (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsIotAnalyticsPipelineRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsPipelineRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	pipeline, err := finder.PipelineByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] IoT Analytics Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Analytics Pipeline (%s): %w", d.Id(), err)
+	}
+
+	if pipeline == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading IoT Analytics Pipeline (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] IoT Analytics Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(pipeline.Arn)
+	d.Set("arn", arn)
+	d.Set("name", pipeline.Name)
+
+	if err := d.Set("pipeline_activity", flattenIotAnalyticsPipelineActivities(pipeline.Activities)); err != nil {
+		return fmt.Errorf("error setting pipeline_activity: %w", err)
+	}
+
+	tags, err := keyvaluetags.IotanalyticsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Analytics Pipeline (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotAnalyticsPipelineUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	if d.HasChange("pipeline_activity") {
+		input := &iotanalytics.UpdatePipelineInput{
+			PipelineActivities: expandIotAnalyticsPipelineActivities(d.Get("pipeline_activity").([]interface{})),
+			PipelineName:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Analytics Pipeline: %s", input)
+		_, err := conn.UpdatePipeline(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Analytics Pipeline (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.IotanalyticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Analytics Pipeline (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotAnalyticsPipelineRead(d, meta)
+}
+
+func resourceAwsIotAnalyticsPipelineDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iotanalyticsconn
+
+	log.Printf("[DEBUG] Deleting IoT Analytics Pipeline (%s)", d.Id())
+	_, err := conn.DeletePipeline(&iotanalytics.DeletePipelineInput{
+		PipelineName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Analytics Pipeline (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandIotAnalyticsPipelineActivities(vActivities []interface{}) []*iotanalytics.PipelineActivity {
+	activities := []*iotanalytics.PipelineActivity{}
+
+	for _, vActivity := range vActivities {
+		mActivity, ok := vActivity.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		activity := &iotanalytics.PipelineActivity{}
+
+		if m := iotAnalyticsBlockMap(mActivity["add_attributes"]); m != nil {
+			activity.AddAttributes = &iotanalytics.AddAttributesActivity{
+				Attributes: stringMapToPointers(m["attributes"].(map[string]interface{})),
+				Name:       aws.String(m["name"].(string)),
+				Next:       iotAnalyticsPipelineActivityNext(m),
+			}
+		}
+
+		if m := iotAnalyticsBlockMap(mActivity["channel"]); m != nil {
+			activity.Channel = &iotanalytics.ChannelActivity{
+				ChannelName: aws.String(m["channel_name"].(string)),
+				Name:        aws.String(m["name"].(string)),
+				Next:        iotAnalyticsPipelineActivityNext(m),
+			}
+		}
+
+		if m := iotAnalyticsBlockMap(mActivity["datastore"]); m != nil {
+			activity.Datastore = &iotanalytics.DatastoreActivity{
+				DatastoreName: aws.String(m["datastore_name"].(string)),
+				Name:          aws.String(m["name"].(string)),
+			}
+		}
+
+		if m := iotAnalyticsBlockMap(mActivity["device_registry_enrich"]); m != nil {
+			activity.DeviceRegistryEnrich = &iotanalytics.DeviceRegistryEnrichActivity{
+				Attribute: aws.String(m["attribute"].(string)),
+				Name:      aws.String(m["name"].(string)),
+				Next:      iotAnalyticsPipelineActivityNext(m),
+				RoleArn:   aws.String(m["role_arn"].(string)),
+				ThingName: aws.String(m["thing_name"].(string)),
+			}
+		}
+
+		if m := iotAnalyticsBlockMap(mActivity["device_shadow_enrich"]); m != nil {
+			activity.DeviceShadowEnrich = &iotanalytics.DeviceShadowEnrichActivity{
+				Attribute: aws.String(m["attribute"].(string)),
+				Name:      aws.String(m["name"].(string)),
+				Next:      iotAnalyticsPipelineActivityNext(m),
+				RoleArn:   aws.String(m["role_arn"].(string)),
+				ThingName: aws.String(m["thing_name"].(string)),
+			}
+		}
+
+		if m := iotAnalyticsBlockMap(mActivity["filter"]); m != nil {
+			activity.Filter = &iotanalytics.FilterActivity{
+				Filter: aws.String(m["filter"].(string)),
+				Name:   aws.String(m["name"].(string)),
+				Next:   iotAnalyticsPipelineActivityNext(m),
+			}
+		}
+
+		if m := iotAnalyticsBlockMap(mActivity["lambda"]); m != nil {
+			activity.Lambda = &iotanalytics.LambdaActivity{
+				BatchSize:  aws.Int64(int64(m["batch_size"].(int))),
+				LambdaName: aws.String(m["lambda_name"].(string)),
+				Name:       aws.String(m["name"].(string)),
+				Next:       iotAnalyticsPipelineActivityNext(m),
+			}
+		}
+
+		if m := iotAnalyticsBlockMap(mActivity["math"]); m != nil {
+			activity.Math = &iotanalytics.MathActivity{
+				Attribute: aws.String(m["attribute"].(string)),
+				Math:      aws.String(m["math"].(string)),
+				Name:      aws.String(m["name"].(string)),
+				Next:      iotAnalyticsPipelineActivityNext(m),
+			}
+		}
+
+		if m := iotAnalyticsBlockMap(mActivity["remove_attributes"]); m != nil {
+			activity.RemoveAttributes = &iotanalytics.RemoveAttributesActivity{
+				Attributes: expandStringList(m["attributes"].([]interface{})),
+				Name:       aws.String(m["name"].(string)),
+				Next:       iotAnalyticsPipelineActivityNext(m),
+			}
+		}
+
+		if m := iotAnalyticsBlockMap(mActivity["select_attributes"]); m != nil {
+			activity.SelectAttributes = &iotanalytics.SelectAttributesActivity{
+				Attributes: expandStringList(m["attributes"].([]interface{})),
+				Name:       aws.String(m["name"].(string)),
+				Next:       iotAnalyticsPipelineActivityNext(m),
+			}
+		}
+
+		activities = append(activities, activity)
+	}
+
+	return activities
+}
+
+func iotAnalyticsBlockMap(v interface{}) map[string]interface{} {
+	l, ok := v.([]interface{})
+
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	return l[0].(map[string]interface{})
+}
+
+func iotAnalyticsPipelineActivityNext(m map[string]interface{}) *string {
+	if v, ok := m["next"].(string); ok && v != "" {
+		return aws.String(v)
+	}
+
+	return nil
+}
+
+func flattenIotAnalyticsPipelineActivities(activities []*iotanalytics.PipelineActivity) []interface{} {
+	vActivities := []interface{}{}
+
+	for _, activity := range activities {
+		if activity == nil {
+			continue
+		}
+
+		mActivity := map[string]interface{}{}
+
+		if v := activity.AddAttributes; v != nil {
+			mActivity["add_attributes"] = []interface{}{map[string]interface{}{
+				"attributes": aws.StringValueMap(v.Attributes),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := activity.Channel; v != nil {
+			mActivity["channel"] = []interface{}{map[string]interface{}{
+				"channel_name": aws.StringValue(v.ChannelName),
+				"name":         aws.StringValue(v.Name),
+				"next":         aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := activity.Datastore; v != nil {
+			mActivity["datastore"] = []interface{}{map[string]interface{}{
+				"datastore_name": aws.StringValue(v.DatastoreName),
+				"name":           aws.StringValue(v.Name),
+			}}
+		}
+
+		if v := activity.DeviceRegistryEnrich; v != nil {
+			mActivity["device_registry_enrich"] = []interface{}{map[string]interface{}{
+				"attribute":  aws.StringValue(v.Attribute),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+				"role_arn":   aws.StringValue(v.RoleArn),
+				"thing_name": aws.StringValue(v.ThingName),
+			}}
+		}
+
+		if v := activity.DeviceShadowEnrich; v != nil {
+			mActivity["device_shadow_enrich"] = []interface{}{map[string]interface{}{
+				"attribute":  aws.StringValue(v.Attribute),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+				"role_arn":   aws.StringValue(v.RoleArn),
+				"thing_name": aws.StringValue(v.ThingName),
+			}}
+		}
+
+		if v := activity.Filter; v != nil {
+			mActivity["filter"] = []interface{}{map[string]interface{}{
+				"filter": aws.StringValue(v.Filter),
+				"name":   aws.StringValue(v.Name),
+				"next":   aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := activity.Lambda; v != nil {
+			mActivity["lambda"] = []interface{}{map[string]interface{}{
+				"batch_size":  int(aws.Int64Value(v.BatchSize)),
+				"lambda_name": aws.StringValue(v.LambdaName),
+				"name":        aws.StringValue(v.Name),
+				"next":        aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := activity.Math; v != nil {
+			mActivity["math"] = []interface{}{map[string]interface{}{
+				"attribute": aws.StringValue(v.Attribute),
+				"math":      aws.StringValue(v.Math),
+				"name":      aws.StringValue(v.Name),
+				"next":      aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := activity.RemoveAttributes; v != nil {
+			mActivity["remove_attributes"] = []interface{}{map[string]interface{}{
+				"attributes": aws.StringValueSlice(v.Attributes),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+			}}
+		}
+
+		if v := activity.SelectAttributes; v != nil {
+			mActivity["select_attributes"] = []interface{}{map[string]interface{}{
+				"attributes": aws.StringValueSlice(v.Attributes),
+				"name":       aws.StringValue(v.Name),
+				"next":       aws.StringValue(v.Next),
+			}}
+		}
+
+		vActivities = append(vActivities, mActivity)
+	}
+
+	return vActivities
+}
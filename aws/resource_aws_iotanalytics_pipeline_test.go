@@ -0,0 +1,261 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotanalytics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotanalytics/finder"
+)
+
+func TestAccAWSIotAnalyticsPipeline_basic(t *testing.T) {
+	var v iotanalytics.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotAnalytics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "iotanalytics", fmt.Sprintf("pipeline/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.0.channel.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.0.channel.0.channel_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.0.channel.0.name", "channel_activity"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.0.channel.0.next", "datastore_activity"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.1.datastore.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.1.datastore.0.datastore_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.1.datastore.0.name", "datastore_activity"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_disappears(t *testing.T) {
+	var v iotanalytics.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotAnalytics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotAnalyticsPipeline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotAnalyticsPipeline_Activities(t *testing.T) {
+	var v iotanalytics.Pipeline
+	resourceName := "aws_iotanalytics_pipeline.test"
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotAnalytics(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotAnalyticsPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.#", "2"),
+				),
+			},
+			{
+				Config: testAccAWSIotAnalyticsPipelineConfigActivities(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSIotAnalyticsPipelineExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.#", "5"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.0.channel.0.next", "filter_activity"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.1.filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.1.filter.0.filter", "temperature > 40"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.1.filter.0.name", "filter_activity"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.1.filter.0.next", "math_activity"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.2.math.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.2.math.0.attribute", "temperature_f"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.2.math.0.math", "temperature * 1.8 + 32"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.3.add_attributes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.3.add_attributes.0.attributes.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.3.add_attributes.0.attributes.temperature_f", "temperature_fahrenheit"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_activity.4.datastore.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotAnalyticsPipelineDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotanalytics_pipeline" {
+			continue
+		}
+
+		pipeline, err := finder.PipelineByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, iotanalytics.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading IoT Analytics Pipeline (%s): %w", rs.Primary.ID, err)
+		}
+
+		if pipeline != nil {
+			return fmt.Errorf("IoT Analytics Pipeline (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSIotAnalyticsPipelineExists(n string, v *iotanalytics.Pipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Analytics Pipeline ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iotanalyticsconn
+
+		pipeline, err := finder.PipelineByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if pipeline == nil {
+			return fmt.Errorf("IoT Analytics Pipeline (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *pipeline
+
+		return nil
+	}
+}
+
+func testAccAWSIotAnalyticsPipelineConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotanalytics_channel" "test" {
+  name = %[1]q
+}
+
+resource "aws_iotanalytics_datastore" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSIotAnalyticsPipelineConfig(rName string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  pipeline_activity {
+    channel {
+      name         = "channel_activity"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "datastore_activity"
+    }
+  }
+
+  pipeline_activity {
+    datastore {
+      name           = "datastore_activity"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
+
+func testAccAWSIotAnalyticsPipelineConfigActivities(rName string) string {
+	return composeConfig(
+		testAccAWSIotAnalyticsPipelineConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotanalytics_pipeline" "test" {
+  name = %[1]q
+
+  pipeline_activity {
+    channel {
+      name         = "channel_activity"
+      channel_name = aws_iotanalytics_channel.test.name
+      next         = "filter_activity"
+    }
+  }
+
+  pipeline_activity {
+    filter {
+      name   = "filter_activity"
+      filter = "temperature > 40"
+      next   = "math_activity"
+    }
+  }
+
+  pipeline_activity {
+    math {
+      name      = "math_activity"
+      attribute = "temperature_f"
+      math      = "temperature * 1.8 + 32"
+      next      = "add_attributes_activity"
+    }
+  }
+
+  pipeline_activity {
+    add_attributes {
+      name = "add_attributes_activity"
+      next = "datastore_activity"
+
+      attributes = {
+        temperature_f = "temperature_fahrenheit"
+      }
+    }
+  }
+
+  pipeline_activity {
+    datastore {
+      name           = "datastore_activity"
+      datastore_name = aws_iotanalytics_datastore.test.name
+    }
+  }
+}
+`, rName))
+}
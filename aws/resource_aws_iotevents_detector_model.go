@@ -0,0 +1,1879 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/equivalency"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/waiter"
+)
+
+func resourceAwsIotEventsDetectorModel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIotEventsDetectorModelCreate,
+		Read:   resourceAwsIotEventsDetectorModelRead,
+		Update: resourceAwsIotEventsDetectorModelUpdate,
+		Delete: resourceAwsIotEventsDetectorModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"definition": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"definition", "detector_model_definition"},
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					equal, _ := equivalency.EquivalentIotEventsDetectorModelDefinitionJSON(old, new)
+
+					return equal
+				},
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+
+			"detector_model_definition": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"definition", "detector_model_definition"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initial_state_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						// The API may return states in a different order
+						"state": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"on_enter": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": iotEventsDetectorModelEventSchema(),
+											},
+										},
+									},
+									"on_exit": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": iotEventsDetectorModelEventSchema(),
+											},
+										},
+									},
+									"on_input": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"event": iotEventsDetectorModelEventSchema(),
+												"transition_event": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"action": iotEventsDetectorModelActionSchema(),
+															"condition": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(0, 512),
+															},
+															"event_name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(0, 128),
+															},
+															"next_state": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 128),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"state_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"evaluation_method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      iotevents.EvaluationMethodBatch,
+				ValidateFunc: validation.StringInSlice(iotevents.EvaluationMethod_Values(), false),
+			},
+
+			"key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_-]+$`), "must contain only alphanumeric characters, hyphens and underscores"),
+				),
+			},
+
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"tags": tagsSchema(),
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIotEventsDetectorModelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	definition, err := resourceAwsIotEventsDetectorModelDefinition(d)
+
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	input := &iotevents.CreateDetectorModelInput{
+		DetectorModelDefinition: definition,
+		DetectorModelName:       aws.String(name),
+		EvaluationMethod:        aws.String(d.Get("evaluation_method").(string)),
+		RoleArn:                 aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.DetectorModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("key"); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().IoteventsTags()
+	}
+
+	log.Printf("[DEBUG] Creating IoT Events Detector Model: %s", input)
+	_, err = conn.CreateDetectorModel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IoT Events Detector Model (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.DetectorModelActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Detector Model (%s) to become active: %w", d.Id(), err)
+	}
+
+	return resourceAwsIotEventsDetectorModelRead(d, meta)
+}
+
+func resourceAwsIotEventsDetectorModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	detectorModel, err := finder.DetectorModelByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IoT Events Detector Model (%s): %w", d.Id(), err)
+	}
+
+	if detectorModel == nil || detectorModel.DetectorModelConfiguration == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading IoT Events Detector Model (%s): not found", d.Id())
+		}
+
+		log.Printf("[WARN] IoT Events Detector Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	configuration := detectorModel.DetectorModelConfiguration
+	arn := aws.StringValue(configuration.DetectorModelArn)
+	d.Set("arn", arn)
+	d.Set("description", configuration.DetectorModelDescription)
+	d.Set("evaluation_method", configuration.EvaluationMethod)
+	d.Set("key", configuration.Key)
+	d.Set("name", configuration.DetectorModelName)
+	d.Set("role_arn", configuration.RoleArn)
+	d.Set("version", configuration.DetectorModelVersion)
+
+	// Only one of the definition arguments is configured, the JSON form is used on import
+	if _, ok := d.GetOk("detector_model_definition"); ok {
+		if err := d.Set("detector_model_definition", flattenIotEventsDetectorModelDefinition(detectorModel.DetectorModelDefinition)); err != nil {
+			return fmt.Errorf("error setting detector_model_definition: %w", err)
+		}
+	} else {
+		definition, err := flattenIotEventsDetectorModelDefinitionJSON(detectorModel.DetectorModelDefinition)
+
+		if err != nil {
+			return fmt.Errorf("error converting IoT Events Detector Model (%s) definition to JSON: %w", d.Id(), err)
+		}
+
+		d.Set("definition", definition)
+	}
+
+	tags, err := keyvaluetags.IoteventsListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for IoT Events Detector Model (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsIotEventsDetectorModelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	if d.HasChanges("definition", "description", "detector_model_definition", "evaluation_method", "role_arn") {
+		definition, err := resourceAwsIotEventsDetectorModelDefinition(d)
+
+		if err != nil {
+			return err
+		}
+
+		input := &iotevents.UpdateDetectorModelInput{
+			DetectorModelDefinition:  definition,
+			DetectorModelDescription: aws.String(d.Get("description").(string)),
+			DetectorModelName:        aws.String(d.Id()),
+			EvaluationMethod:         aws.String(d.Get("evaluation_method").(string)),
+			RoleArn:                  aws.String(d.Get("role_arn").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating IoT Events Detector Model: %s", input)
+		_, err = conn.UpdateDetectorModel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IoT Events Detector Model (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.DetectorModelActive(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for IoT Events Detector Model (%s) to become active: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.IoteventsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating IoT Events Detector Model (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIotEventsDetectorModelRead(d, meta)
+}
+
+func resourceAwsIotEventsDetectorModelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ioteventsconn
+
+	log.Printf("[DEBUG] Deleting IoT Events Detector Model (%s)", d.Id())
+	_, err := conn.DeleteDetectorModel(&iotevents.DeleteDetectorModelInput{
+		DetectorModelName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IoT Events Detector Model (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.DetectorModelDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for IoT Events Detector Model (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsIotEventsDetectorModelDefinition returns the detector model definition from whichever of definition or detector_model_definition is configured
+func resourceAwsIotEventsDetectorModelDefinition(d *schema.ResourceData) (*iotevents.DetectorModelDefinition, error) {
+	if v, ok := d.GetOk("detector_model_definition"); ok {
+		return expandIotEventsDetectorModelDefinition(v.([]interface{})), nil
+	}
+
+	return expandIotEventsDetectorModelDefinitionJSON(d.Get("definition").(string))
+}
+
+func expandIotEventsDetectorModelDefinitionJSON(rawDefinition string) (*iotevents.DetectorModelDefinition, error) {
+	var definition *iotevents.DetectorModelDefinition
+
+	err := json.Unmarshal([]byte(rawDefinition), &definition)
+
+	if err != nil {
+		return nil, fmt.Errorf("error decoding IoT Events Detector Model definition JSON: %w", err)
+	}
+
+	return definition, nil
+}
+
+// Convert iotevents.DetectorModelDefinition object into its JSON representation
+func flattenIotEventsDetectorModelDefinitionJSON(definition *iotevents.DetectorModelDefinition) (string, error) {
+	if definition == nil {
+		return "", nil
+	}
+
+	b, err := jsonutil.BuildJSON(definition)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func iotEventsDetectorModelEventSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": iotEventsDetectorModelActionSchema(),
+				"condition": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(0, 512),
+				},
+				"event_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(0, 128),
+				},
+			},
+		},
+	}
+}
+
+func iotEventsDetectorModelActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"clear_timer": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"timer_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+					},
+				},
+				"dynamodb": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"hash_key_field": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"hash_key_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"NUMBER", "STRING"}, false),
+							},
+							"hash_key_value": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"operation": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"payload": iotEventsDetectorModelPayloadSchema(),
+							"payload_field": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"range_key_field": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"range_key_type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"NUMBER", "STRING"}, false),
+							},
+							"range_key_value": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"table_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"dynamodb_v2": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"payload": iotEventsDetectorModelPayloadSchema(),
+							"table_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"firehose": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delivery_stream_name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"payload": iotEventsDetectorModelPayloadSchema(),
+							"separator": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"\n", "\t", "\r\n", ","}, false),
+							},
+						},
+					},
+				},
+				"iot_events": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"input_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							"payload": iotEventsDetectorModelPayloadSchema(),
+						},
+					},
+				},
+				"iot_site_wise": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"asset_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"entry_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"property_alias": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"property_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"property_value": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"quality": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"timestamp": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"offset_in_nanos": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"time_in_seconds": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+										"value": {
+											Type:     schema.TypeList,
+											Required: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"boolean_value": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"double_value": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"integer_value": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"string_value": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"iot_topic_publish": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"mqtt_topic": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							"payload": iotEventsDetectorModelPayloadSchema(),
+						},
+					},
+				},
+				"lambda": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"function_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateArn,
+							},
+							"payload": iotEventsDetectorModelPayloadSchema(),
+						},
+					},
+				},
+				"reset_timer": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"timer_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+					},
+				},
+				"set_timer": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"duration_expression": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+							"timer_name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+						},
+					},
+				},
+				"set_variable": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"value": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+							"variable_name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.All(
+									validation.StringLenBetween(1, 128),
+									validation.StringMatch(regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`), "must start with a letter and contain only alphanumeric characters and underscores"),
+								),
+							},
+						},
+					},
+				},
+				"sns": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"payload": iotEventsDetectorModelPayloadSchema(),
+							"target_arn": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validateArn,
+							},
+						},
+					},
+				},
+				"sqs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"payload": iotEventsDetectorModelPayloadSchema(),
+							"queue_url": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"use_base64": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func iotEventsDetectorModelPayloadSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_expression": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(iotevents.PayloadType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func expandIotEventsDetectorModelDefinition(tfList []interface{}) *iotevents.DetectorModelDefinition {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.DetectorModelDefinition{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["initial_state_name"].(string); ok && v != "" {
+		apiObject.InitialStateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["state"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.States = expandIotEventsStates(v.List())
+	}
+
+	return apiObject
+}
+
+func expandIotEventsStates(tfList []interface{}) []*iotevents.State {
+	var apiObjects []*iotevents.State
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.State{}
+
+		if v, ok := tfMap["on_enter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.OnEnter = &iotevents.OnEnterLifecycle{
+				Events: expandIotEventsEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["on_exit"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.OnExit = &iotevents.OnExitLifecycle{
+				Events: expandIotEventsEvents(v[0].(map[string]interface{})["event"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["on_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			onInput := v[0].(map[string]interface{})
+
+			apiObject.OnInput = &iotevents.OnInputLifecycle{
+				Events:           expandIotEventsEvents(onInput["event"].([]interface{})),
+				TransitionEvents: expandIotEventsTransitionEvents(onInput["transition_event"].([]interface{})),
+			}
+		}
+
+		if v, ok := tfMap["state_name"].(string); ok && v != "" {
+			apiObject.StateName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotEventsEvents(tfList []interface{}) []*iotevents.Event {
+	var apiObjects []*iotevents.Event
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.Event{}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Actions = expandIotEventsActions(v)
+		}
+
+		if v, ok := tfMap["condition"].(string); ok && v != "" {
+			apiObject.Condition = aws.String(v)
+		}
+
+		if v, ok := tfMap["event_name"].(string); ok && v != "" {
+			apiObject.EventName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotEventsTransitionEvents(tfList []interface{}) []*iotevents.TransitionEvent {
+	var apiObjects []*iotevents.TransitionEvent
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.TransitionEvent{}
+
+		if v, ok := tfMap["action"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Actions = expandIotEventsActions(v)
+		}
+
+		if v, ok := tfMap["condition"].(string); ok && v != "" {
+			apiObject.Condition = aws.String(v)
+		}
+
+		if v, ok := tfMap["event_name"].(string); ok && v != "" {
+			apiObject.EventName = aws.String(v)
+		}
+
+		if v, ok := tfMap["next_state"].(string); ok && v != "" {
+			apiObject.NextState = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandIotEventsActions(tfList []interface{}) []*iotevents.ActionData {
+	var apiObjects []*iotevents.ActionData
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.ActionData{}
+
+		if v, ok := tfMap["clear_timer"].([]interface{}); ok {
+			apiObject.ClearTimer = expandIotEventsClearTimerAction(v)
+		}
+
+		if v, ok := tfMap["dynamodb"].([]interface{}); ok {
+			apiObject.DynamoDB = expandIotEventsDynamoDBAction(v)
+		}
+
+		if v, ok := tfMap["dynamodb_v2"].([]interface{}); ok {
+			apiObject.DynamoDBv2 = expandIotEventsDynamoDBv2Action(v)
+		}
+
+		if v, ok := tfMap["firehose"].([]interface{}); ok {
+			apiObject.Firehose = expandIotEventsFirehoseAction(v)
+		}
+
+		if v, ok := tfMap["iot_events"].([]interface{}); ok {
+			apiObject.IotEvents = expandIotEventsIotEventsAction(v)
+		}
+
+		if v, ok := tfMap["iot_site_wise"].([]interface{}); ok {
+			apiObject.IotSiteWise = expandIotEventsIotSiteWiseAction(v)
+		}
+
+		if v, ok := tfMap["iot_topic_publish"].([]interface{}); ok {
+			apiObject.IotTopicPublish = expandIotEventsIotTopicPublishAction(v)
+		}
+
+		if v, ok := tfMap["lambda"].([]interface{}); ok {
+			apiObject.Lambda = expandIotEventsLambdaAction(v)
+		}
+
+		if v, ok := tfMap["reset_timer"].([]interface{}); ok {
+			apiObject.ResetTimer = expandIotEventsResetTimerAction(v)
+		}
+
+		if v, ok := tfMap["set_timer"].([]interface{}); ok {
+			apiObject.SetTimer = expandIotEventsSetTimerAction(v)
+		}
+
+		if v, ok := tfMap["set_variable"].([]interface{}); ok {
+			apiObject.SetVariable = expandIotEventsSetVariableAction(v)
+		}
+
+		if v, ok := tfMap["sns"].([]interface{}); ok {
+			apiObject.Sns = expandIotEventsSNSTopicPublishAction(v)
+		}
+
+		if v, ok := tfMap["sqs"].([]interface{}); ok {
+			apiObject.Sqs = expandIotEventsSqsAction(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenIotEventsDetectorModelDefinition(apiObject *iotevents.DetectorModelDefinition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.InitialStateName; v != nil {
+		tfMap["initial_state_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.States; v != nil {
+		tfMap["state"] = flattenIotEventsStates(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsStates(apiObjects []*iotevents.State) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := make(map[string]interface{})
+
+		// The API returns empty lifecycles for those that were not configured
+		if v := apiObject.OnEnter; v != nil && len(v.Events) > 0 {
+			tfMap["on_enter"] = []interface{}{map[string]interface{}{
+				"event": flattenIotEventsEvents(v.Events),
+			}}
+		}
+
+		if v := apiObject.OnExit; v != nil && len(v.Events) > 0 {
+			tfMap["on_exit"] = []interface{}{map[string]interface{}{
+				"event": flattenIotEventsEvents(v.Events),
+			}}
+		}
+
+		if v := apiObject.OnInput; v != nil && (len(v.Events) > 0 || len(v.TransitionEvents) > 0) {
+			tfMap["on_input"] = []interface{}{map[string]interface{}{
+				"event":            flattenIotEventsEvents(v.Events),
+				"transition_event": flattenIotEventsTransitionEvents(v.TransitionEvents),
+			}}
+		}
+
+		if v := apiObject.StateName; v != nil {
+			tfMap["state_name"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIotEventsEvents(apiObjects []*iotevents.Event) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := make(map[string]interface{})
+
+		if v := apiObject.Actions; v != nil {
+			tfMap["action"] = flattenIotEventsActions(v)
+		}
+
+		if v := apiObject.Condition; v != nil {
+			tfMap["condition"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.EventName; v != nil {
+			tfMap["event_name"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIotEventsTransitionEvents(apiObjects []*iotevents.TransitionEvent) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := make(map[string]interface{})
+
+		if v := apiObject.Actions; v != nil {
+			tfMap["action"] = flattenIotEventsActions(v)
+		}
+
+		if v := apiObject.Condition; v != nil {
+			tfMap["condition"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.EventName; v != nil {
+			tfMap["event_name"] = aws.StringValue(v)
+		}
+
+		if v := apiObject.NextState; v != nil {
+			tfMap["next_state"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenIotEventsActions(apiObjects []*iotevents.ActionData) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := make(map[string]interface{})
+
+		if v := apiObject.ClearTimer; v != nil {
+			tfMap["clear_timer"] = flattenIotEventsClearTimerAction(v)
+		}
+
+		if v := apiObject.DynamoDB; v != nil {
+			tfMap["dynamodb"] = flattenIotEventsDynamoDBAction(v)
+		}
+
+		if v := apiObject.DynamoDBv2; v != nil {
+			tfMap["dynamodb_v2"] = flattenIotEventsDynamoDBv2Action(v)
+		}
+
+		if v := apiObject.Firehose; v != nil {
+			tfMap["firehose"] = flattenIotEventsFirehoseAction(v)
+		}
+
+		if v := apiObject.IotEvents; v != nil {
+			tfMap["iot_events"] = flattenIotEventsIotEventsAction(v)
+		}
+
+		if v := apiObject.IotSiteWise; v != nil {
+			tfMap["iot_site_wise"] = flattenIotEventsIotSiteWiseAction(v)
+		}
+
+		if v := apiObject.IotTopicPublish; v != nil {
+			tfMap["iot_topic_publish"] = flattenIotEventsIotTopicPublishAction(v)
+		}
+
+		if v := apiObject.Lambda; v != nil {
+			tfMap["lambda"] = flattenIotEventsLambdaAction(v)
+		}
+
+		if v := apiObject.ResetTimer; v != nil {
+			tfMap["reset_timer"] = flattenIotEventsResetTimerAction(v)
+		}
+
+		if v := apiObject.SetTimer; v != nil {
+			tfMap["set_timer"] = flattenIotEventsSetTimerAction(v)
+		}
+
+		if v := apiObject.SetVariable; v != nil {
+			tfMap["set_variable"] = flattenIotEventsSetVariableAction(v)
+		}
+
+		if v := apiObject.Sns; v != nil {
+			tfMap["sns"] = flattenIotEventsSNSTopicPublishAction(v)
+		}
+
+		if v := apiObject.Sqs; v != nil {
+			tfMap["sqs"] = flattenIotEventsSqsAction(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandIotEventsClearTimerAction(tfList []interface{}) *iotevents.ClearTimerAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.ClearTimerAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["timer_name"].(string); ok && v != "" {
+		apiObject.TimerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsDynamoDBAction(tfList []interface{}) *iotevents.DynamoDBAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.DynamoDBAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["hash_key_field"].(string); ok && v != "" {
+		apiObject.HashKeyField = aws.String(v)
+	}
+
+	if v, ok := tfMap["hash_key_type"].(string); ok && v != "" {
+		apiObject.HashKeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["hash_key_value"].(string); ok && v != "" {
+		apiObject.HashKeyValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["operation"].(string); ok && v != "" {
+		apiObject.Operation = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandIotEventsPayload(v)
+	}
+
+	if v, ok := tfMap["payload_field"].(string); ok && v != "" {
+		apiObject.PayloadField = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_field"].(string); ok && v != "" {
+		apiObject.RangeKeyField = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_type"].(string); ok && v != "" {
+		apiObject.RangeKeyType = aws.String(v)
+	}
+
+	if v, ok := tfMap["range_key_value"].(string); ok && v != "" {
+		apiObject.RangeKeyValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsDynamoDBv2Action(tfList []interface{}) *iotevents.DynamoDBv2Action {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.DynamoDBv2Action{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandIotEventsPayload(v)
+	}
+
+	if v, ok := tfMap["table_name"].(string); ok && v != "" {
+		apiObject.TableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsFirehoseAction(tfList []interface{}) *iotevents.FirehoseAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.FirehoseAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["delivery_stream_name"].(string); ok && v != "" {
+		apiObject.DeliveryStreamName = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandIotEventsPayload(v)
+	}
+
+	if v, ok := tfMap["separator"].(string); ok && v != "" {
+		apiObject.Separator = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsIotEventsAction(tfList []interface{}) *iotevents.Action {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.Action{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["input_name"].(string); ok && v != "" {
+		apiObject.InputName = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandIotEventsPayload(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsIotSiteWiseAction(tfList []interface{}) *iotevents.IotSiteWiseAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.IotSiteWiseAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["asset_id"].(string); ok && v != "" {
+		apiObject.AssetId = aws.String(v)
+	}
+
+	if v, ok := tfMap["entry_id"].(string); ok && v != "" {
+		apiObject.EntryId = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_alias"].(string); ok && v != "" {
+		apiObject.PropertyAlias = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_id"].(string); ok && v != "" {
+		apiObject.PropertyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["property_value"].([]interface{}); ok {
+		apiObject.PropertyValue = expandIotEventsAssetPropertyValue(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsAssetPropertyValue(tfList []interface{}) *iotevents.AssetPropertyValue {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.AssetPropertyValue{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["quality"].(string); ok && v != "" {
+		apiObject.Quality = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp"].([]interface{}); ok {
+		apiObject.Timestamp = expandIotEventsAssetPropertyTimestamp(v)
+	}
+
+	if v, ok := tfMap["value"].([]interface{}); ok {
+		apiObject.Value = expandIotEventsAssetPropertyVariant(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsAssetPropertyTimestamp(tfList []interface{}) *iotevents.AssetPropertyTimestamp {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.AssetPropertyTimestamp{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["offset_in_nanos"].(string); ok && v != "" {
+		apiObject.OffsetInNanos = aws.String(v)
+	}
+
+	if v, ok := tfMap["time_in_seconds"].(string); ok && v != "" {
+		apiObject.TimeInSeconds = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsAssetPropertyVariant(tfList []interface{}) *iotevents.AssetPropertyVariant {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.AssetPropertyVariant{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["boolean_value"].(string); ok && v != "" {
+		apiObject.BooleanValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["double_value"].(string); ok && v != "" {
+		apiObject.DoubleValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["integer_value"].(string); ok && v != "" {
+		apiObject.IntegerValue = aws.String(v)
+	}
+
+	if v, ok := tfMap["string_value"].(string); ok && v != "" {
+		apiObject.StringValue = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsIotTopicPublishAction(tfList []interface{}) *iotevents.IotTopicPublishAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.IotTopicPublishAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["mqtt_topic"].(string); ok && v != "" {
+		apiObject.MqttTopic = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandIotEventsPayload(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsLambdaAction(tfList []interface{}) *iotevents.LambdaAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.LambdaAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["function_arn"].(string); ok && v != "" {
+		apiObject.FunctionArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandIotEventsPayload(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsResetTimerAction(tfList []interface{}) *iotevents.ResetTimerAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.ResetTimerAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["timer_name"].(string); ok && v != "" {
+		apiObject.TimerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsSetTimerAction(tfList []interface{}) *iotevents.SetTimerAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.SetTimerAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["duration_expression"].(string); ok && v != "" {
+		apiObject.DurationExpression = aws.String(v)
+	}
+
+	if v, ok := tfMap["timer_name"].(string); ok && v != "" {
+		apiObject.TimerName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsSetVariableAction(tfList []interface{}) *iotevents.SetVariableAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.SetVariableAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	if v, ok := tfMap["variable_name"].(string); ok && v != "" {
+		apiObject.VariableName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsSNSTopicPublishAction(tfList []interface{}) *iotevents.SNSTopicPublishAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.SNSTopicPublishAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandIotEventsPayload(v)
+	}
+
+	if v, ok := tfMap["target_arn"].(string); ok && v != "" {
+		apiObject.TargetArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsSqsAction(tfList []interface{}) *iotevents.SqsAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.SqsAction{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["payload"].([]interface{}); ok {
+		apiObject.Payload = expandIotEventsPayload(v)
+	}
+
+	if v, ok := tfMap["queue_url"].(string); ok && v != "" {
+		apiObject.QueueUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["use_base64"].(bool); ok {
+		apiObject.UseBase64 = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func expandIotEventsPayload(tfList []interface{}) *iotevents.Payload {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	apiObject := &iotevents.Payload{}
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["content_expression"].(string); ok && v != "" {
+		apiObject.ContentExpression = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenIotEventsClearTimerAction(apiObject *iotevents.ClearTimerAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.TimerName; v != nil {
+		tfMap["timer_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsDynamoDBAction(apiObject *iotevents.DynamoDBAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.HashKeyField; v != nil {
+		tfMap["hash_key_field"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.HashKeyType; v != nil {
+		tfMap["hash_key_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.HashKeyValue; v != nil {
+		tfMap["hash_key_value"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Operation; v != nil {
+		tfMap["operation"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenIotEventsPayload(v)
+	}
+
+	if v := apiObject.PayloadField; v != nil {
+		tfMap["payload_field"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RangeKeyField; v != nil {
+		tfMap["range_key_field"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RangeKeyType; v != nil {
+		tfMap["range_key_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.RangeKeyValue; v != nil {
+		tfMap["range_key_value"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TableName; v != nil {
+		tfMap["table_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsDynamoDBv2Action(apiObject *iotevents.DynamoDBv2Action) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenIotEventsPayload(v)
+	}
+
+	if v := apiObject.TableName; v != nil {
+		tfMap["table_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsFirehoseAction(apiObject *iotevents.FirehoseAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.DeliveryStreamName; v != nil {
+		tfMap["delivery_stream_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenIotEventsPayload(v)
+	}
+
+	if v := apiObject.Separator; v != nil {
+		tfMap["separator"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsIotEventsAction(apiObject *iotevents.Action) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.InputName; v != nil {
+		tfMap["input_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenIotEventsPayload(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsIotSiteWiseAction(apiObject *iotevents.IotSiteWiseAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.AssetId; v != nil {
+		tfMap["asset_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EntryId; v != nil {
+		tfMap["entry_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PropertyAlias; v != nil {
+		tfMap["property_alias"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PropertyId; v != nil {
+		tfMap["property_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PropertyValue; v != nil {
+		tfMap["property_value"] = flattenIotEventsAssetPropertyValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsAssetPropertyValue(apiObject *iotevents.AssetPropertyValue) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.Quality; v != nil {
+		tfMap["quality"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Timestamp; v != nil {
+		tfMap["timestamp"] = flattenIotEventsAssetPropertyTimestamp(v)
+	}
+
+	if v := apiObject.Value; v != nil {
+		tfMap["value"] = flattenIotEventsAssetPropertyVariant(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsAssetPropertyTimestamp(apiObject *iotevents.AssetPropertyTimestamp) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.OffsetInNanos; v != nil {
+		tfMap["offset_in_nanos"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TimeInSeconds; v != nil {
+		tfMap["time_in_seconds"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsAssetPropertyVariant(apiObject *iotevents.AssetPropertyVariant) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.BooleanValue; v != nil {
+		tfMap["boolean_value"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DoubleValue; v != nil {
+		tfMap["double_value"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.IntegerValue; v != nil {
+		tfMap["integer_value"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StringValue; v != nil {
+		tfMap["string_value"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsIotTopicPublishAction(apiObject *iotevents.IotTopicPublishAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.MqttTopic; v != nil {
+		tfMap["mqtt_topic"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenIotEventsPayload(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsLambdaAction(apiObject *iotevents.LambdaAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.FunctionArn; v != nil {
+		tfMap["function_arn"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenIotEventsPayload(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsResetTimerAction(apiObject *iotevents.ResetTimerAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.TimerName; v != nil {
+		tfMap["timer_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsSetTimerAction(apiObject *iotevents.SetTimerAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.DurationExpression; v != nil {
+		tfMap["duration_expression"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.TimerName; v != nil {
+		tfMap["timer_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsSetVariableAction(apiObject *iotevents.SetVariableAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.Value; v != nil {
+		tfMap["value"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.VariableName; v != nil {
+		tfMap["variable_name"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsSNSTopicPublishAction(apiObject *iotevents.SNSTopicPublishAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenIotEventsPayload(v)
+	}
+
+	if v := apiObject.TargetArn; v != nil {
+		tfMap["target_arn"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsSqsAction(apiObject *iotevents.SqsAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.Payload; v != nil {
+		tfMap["payload"] = flattenIotEventsPayload(v)
+	}
+
+	if v := apiObject.QueueUrl; v != nil {
+		tfMap["queue_url"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.UseBase64; v != nil {
+		tfMap["use_base64"] = aws.BoolValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIotEventsPayload(apiObject *iotevents.Payload) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{})
+
+	if v := apiObject.ContentExpression; v != nil {
+		tfMap["content_expression"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,463 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iotevents/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
+)
+
+func TestAccAWSIotEventsDetectorModel_basic(t *testing.T) {
+	var v iotevents.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	roleResourceName := "aws_iam_role.test"
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfig(rName, 70),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "iotevents", fmt.Sprintf("detectorModel/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "definition"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_method", "BATCH"),
+					resource.TestCheckResourceAttr(resourceName, "key", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API response may include empty lifecycles that are not in configuration.
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_disappears(t *testing.T) {
+	var v iotevents.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfig(rName, 70),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsIotEventsDetectorModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_Definition(t *testing.T) {
+	var v iotevents.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfig(rName, 70),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfig(rName, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_DetectorModelDefinition(t *testing.T) {
+	var v iotevents.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigDetectorModelDefinition(rName, 70),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "definition", ""),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.initial_state_name", "Normal"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.0.state.#", "2"),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "detector_model_definition.0.state.*", map[string]string{
+						"state_name":                                         "Normal",
+						"on_enter.#":                                         "1",
+						"on_enter.0.event.#":                                 "1",
+						"on_enter.0.event.0.event_name":                      "Init",
+						"on_enter.0.event.0.action.#":                        "1",
+						"on_enter.0.event.0.action.0.set_variable.#":         "1",
+						"on_input.#":                                         "1",
+						"on_input.0.transition_event.#":                      "1",
+						"on_input.0.transition_event.0.event_name":           "Overheated",
+						"on_input.0.transition_event.0.next_state":           "Alarm",
+						"on_input.0.transition_event.0.action.#":             "1",
+						"on_input.0.transition_event.0.action.0.set_timer.#": "1",
+					}),
+					tfawsresource.TestCheckTypeSetElemNestedAttrs(resourceName, "detector_model_definition.0.state.*", map[string]string{
+						"state_name":                   "Alarm",
+						"on_exit.#":                    "1",
+						"on_exit.0.event.0.event_name": "Cleanup",
+						"on_exit.0.event.0.action.0.clear_timer.#": "1",
+						"on_input.0.transition_event.0.event_name": "Cooled",
+						"on_input.0.transition_event.0.next_state": "Normal",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigDetectorModelDefinition(rName, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported detector models use the JSON definition argument.
+				ImportStateVerifyIgnore: []string{"definition", "detector_model_definition"},
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfig(rName, 80),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "definition"),
+					resource.TestCheckResourceAttr(resourceName, "detector_model_definition.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIotEventsDetectorModel_Tags(t *testing.T) {
+	var v iotevents.DetectorModel
+	resourceName := "aws_iotevents_detector_model.test"
+	rName := fmt.Sprintf("tf_acc_test_%d", acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSIotEvents(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIotEventsDetectorModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"definition"},
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSIotEventsDetectorModelConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIotEventsDetectorModelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIotEventsDetectorModelDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iotevents_detector_model" {
+			continue
+		}
+
+		detectorModel, err := finder.DetectorModelByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading IoT Events Detector Model (%s): %w", rs.Primary.ID, err)
+		}
+
+		if detectorModel != nil {
+			return fmt.Errorf("IoT Events Detector Model (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSIotEventsDetectorModelExists(n string, v *iotevents.DetectorModel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Events Detector Model ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ioteventsconn
+
+		detectorModel, err := finder.DetectorModelByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if detectorModel == nil {
+			return fmt.Errorf("IoT Events Detector Model (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *detectorModel
+
+		return nil
+	}
+}
+
+func testAccAWSIotEventsDetectorModelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "iotevents.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iotevents_input" "test" {
+  name = %[1]q
+
+  input_definition {
+    attribute {
+      json_path = "temperature"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSIotEventsDetectorModelConfigDefinition(threshold int) string {
+	return fmt.Sprintf(`
+  definition = jsonencode({
+    initialStateName = "Normal"
+    states = [
+      {
+        stateName = "Normal"
+        onInput = {
+          transitionEvents = [
+            {
+              eventName = "Overheated"
+              condition = "$input.${aws_iotevents_input.test.name}.temperature > %[1]d"
+              nextState = "Alarm"
+              actions   = []
+            }
+          ]
+        }
+      },
+      {
+        stateName = "Alarm"
+        onInput = {
+          transitionEvents = [
+            {
+              eventName = "Cooled"
+              condition = "$input.${aws_iotevents_input.test.name}.temperature <= %[1]d"
+              nextState = "Normal"
+              actions   = []
+            }
+          ]
+        }
+      }
+    ]
+  })
+`, threshold)
+}
+
+func testAccAWSIotEventsDetectorModelConfig(rName string, threshold int) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[2]s
+}
+`, rName, testAccAWSIotEventsDetectorModelConfigDefinition(threshold)))
+}
+
+func testAccAWSIotEventsDetectorModelConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccAWSIotEventsDetectorModelConfigDefinition(70), tagKey1, tagValue1))
+}
+
+func testAccAWSIotEventsDetectorModelConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccAWSIotEventsDetectorModelConfigDefinition(70), tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccAWSIotEventsDetectorModelConfigDetectorModelDefinition(rName string, threshold int) string {
+	return composeConfig(
+		testAccAWSIotEventsDetectorModelConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_iotevents_detector_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  detector_model_definition {
+    initial_state_name = "Normal"
+
+    state {
+      state_name = "Normal"
+
+      on_enter {
+        event {
+          event_name = "Init"
+          condition  = "true"
+
+          action {
+            set_variable {
+              variable_name = "threshold"
+              value         = "%[2]d"
+            }
+          }
+        }
+      }
+
+      on_input {
+        transition_event {
+          event_name = "Overheated"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature > $variable.threshold"
+          next_state = "Alarm"
+
+          action {
+            set_timer {
+              timer_name          = "alarm"
+              duration_expression = "300"
+            }
+          }
+        }
+      }
+    }
+
+    state {
+      state_name = "Alarm"
+
+      on_input {
+        transition_event {
+          event_name = "Cooled"
+          condition  = "$input.${aws_iotevents_input.test.name}.temperature <= $variable.threshold"
+          next_state = "Normal"
+        }
+      }
+
+      on_exit {
+        event {
+          event_name = "Cleanup"
+
+          action {
+            clear_timer {
+              timer_name = "alarm"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, threshold))
+}
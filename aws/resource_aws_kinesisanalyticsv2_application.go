@@ -0,0 +1,2422 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kinesisanalyticsv2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kinesisanalyticsv2/waiter"
+)
+
+func resourceAwsKinesisAnalyticsV2Application() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKinesisAnalyticsV2ApplicationCreate,
+		Read:   resourceAwsKinesisAnalyticsV2ApplicationRead,
+		Update: resourceAwsKinesisAnalyticsV2ApplicationUpdate,
+		Delete: resourceAwsKinesisAnalyticsV2ApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsKinesisAnalyticsV2ApplicationImport,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// An existing SQL application input cannot be removed.
+			customdiff.ForceNewIfChange("application_configuration.0.sql_application_configuration.0.input", func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			"application_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_code_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"code_content": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"s3_content_location": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bucket_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+															"file_key": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 1024),
+															},
+															"object_version": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+													ConflictsWith: []string{"application_configuration.0.application_code_configuration.0.code_content.0.text_content"},
+												},
+												"text_content": {
+													Type:          schema.TypeString,
+													Optional:      true,
+													ValidateFunc:  validation.StringLenBetween(0, 102400),
+													ConflictsWith: []string{"application_configuration.0.application_code_configuration.0.code_content.0.s3_content_location"},
+												},
+											},
+										},
+									},
+									"code_content_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.CodeContentType_Values(), false),
+									},
+								},
+							},
+						},
+						"application_snapshot_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"snapshots_enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"environment_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"property_group": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MaxItems: 50,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"property_group_id": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 50),
+												},
+												"property_map": {
+													Type:     schema.TypeMap,
+													Required: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"flink_application_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"checkpoint_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"checkpoint_interval": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"checkpointing_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"configuration_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.ConfigurationType_Values(), false),
+												},
+												"min_pause_between_checkpoints": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+											},
+										},
+									},
+									"monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"configuration_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.ConfigurationType_Values(), false),
+												},
+												"log_level": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.LogLevel_Values(), false),
+												},
+												"metrics_level": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.MetricsLevel_Values(), false),
+												},
+											},
+										},
+									},
+									"parallelism_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"auto_scaling_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Computed: true,
+												},
+												"configuration_type": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.ConfigurationType_Values(), false),
+												},
+												"parallelism": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"parallelism_per_kpu": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"run_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"application_restore_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"application_restore_type": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.ApplicationRestoreType_Values(), false),
+												},
+												"snapshot_name": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 256),
+												},
+											},
+										},
+									},
+									"flink_run_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"allow_non_restored_state": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"sql_application_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"input": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"in_app_stream_names": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"input_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"input_parallelism": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"count": {
+																Type:         schema.TypeInt,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntBetween(1, 64),
+															},
+														},
+													},
+												},
+												"input_processing_configuration": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"input_lambda_processor": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"resource_arn": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validateArn,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"input_schema": kinesisAnalyticsV2SourceSchemaSchema(),
+												"input_starting_position_configuration": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"input_starting_position": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.InputStartingPosition_Values(), false),
+															},
+														},
+													},
+												},
+												"kinesis_firehose_input": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"resource_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+													ExactlyOneOf: []string{
+														"application_configuration.0.sql_application_configuration.0.input.0.kinesis_firehose_input",
+														"application_configuration.0.sql_application_configuration.0.input.0.kinesis_streams_input",
+													},
+												},
+												"kinesis_streams_input": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"resource_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+													ExactlyOneOf: []string{
+														"application_configuration.0.sql_application_configuration.0.input.0.kinesis_firehose_input",
+														"application_configuration.0.sql_application_configuration.0.input.0.kinesis_streams_input",
+													},
+												},
+												"name_prefix": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.All(
+														validation.StringLenBetween(1, 32),
+														validation.StringMatch(regexp.MustCompile(`^[^-\s<>&]+$`), "must not include hyphen, whitespace, comma, or special characters"),
+													),
+												},
+											},
+										},
+									},
+									"output": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 3,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"destination_schema": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"record_format_type": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.RecordFormatType_Values(), false),
+															},
+														},
+													},
+												},
+												"kinesis_firehose_output": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"resource_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+												},
+												"kinesis_streams_output": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"resource_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+												},
+												"lambda_output": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"resource_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+														},
+													},
+												},
+												"name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 32),
+												},
+												"output_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"reference_data_source": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"reference_id": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"reference_schema": kinesisAnalyticsV2SourceSchemaSchema(),
+												"s3_reference_data_source": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bucket_arn": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validateArn,
+															},
+															"file_key": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 1024),
+															},
+														},
+													},
+												},
+												"table_name": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 32),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"vpc_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"security_group_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 5,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"subnet_ids": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 16,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"vpc_configuration_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"vpc_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cloudwatch_logging_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logging_option_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"log_stream_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"create_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "",
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"last_update_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"runtime_environment": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.RuntimeEnvironment_Values(), false),
+			},
+			"service_execution_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"start_application": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func kinesisAnalyticsV2SourceSchemaSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"record_column": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1000,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"mapping": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"sql_type": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"record_encoding": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"UTF-8"}, false),
+				},
+				"record_format": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"mapping_parameters": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"csv_mapping_parameters": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"record_column_delimiter": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+													"record_row_delimiter": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+										"json_mapping_parameters": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"record_row_path": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							"record_format_type": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(kinesisanalyticsv2.RecordFormatType_Values(), false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+
+	applicationName := d.Get("name").(string)
+	input := &kinesisanalyticsv2.CreateApplicationInput{
+		ApplicationConfiguration: expandKinesisAnalyticsV2ApplicationConfiguration(d.Get("application_configuration").([]interface{})),
+		ApplicationDescription:   aws.String(d.Get("description").(string)),
+		ApplicationName:          aws.String(applicationName),
+		CloudWatchLoggingOptions: expandKinesisAnalyticsV2CloudWatchLoggingOptions(d.Get("cloudwatch_logging_options").([]interface{})),
+		RuntimeEnvironment:       aws.String(d.Get("runtime_environment").(string)),
+		ServiceExecutionRole:     aws.String(d.Get("service_execution_role").(string)),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().Kinesisanalyticsv2Tags()
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Analytics v2 Application: %s", input)
+	outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+		return conn.CreateApplication(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating Kinesis Analytics v2 Application (%s): %w", applicationName, err)
+	}
+
+	output := outputRaw.(*kinesisanalyticsv2.CreateApplicationOutput)
+
+	d.SetId(aws.StringValue(output.ApplicationDetail.ApplicationARN))
+	// CreateTimestamp is required for deletion, so persist to state now in case of subsequent errors and destroy being called without refresh.
+	d.Set("create_timestamp", aws.TimeValue(output.ApplicationDetail.CreateTimestamp).Format(time.RFC3339))
+
+	if d.Get("start_application").(bool) {
+		if err := kinesisAnalyticsV2StartApplication(conn, expandKinesisAnalyticsV2StartApplicationInput(d)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	application, err := finder.ApplicationDetailByName(conn, d.Get("name").(string))
+
+	if tfawserr.ErrCodeEquals(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Kinesis Analytics v2 Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics v2 Application (%s): %w", d.Id(), err)
+	}
+
+	if application == nil {
+		log.Printf("[WARN] Kinesis Analytics v2 Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(application.ApplicationARN)
+	d.Set("arn", arn)
+	d.Set("create_timestamp", aws.TimeValue(application.CreateTimestamp).Format(time.RFC3339))
+	d.Set("description", application.ApplicationDescription)
+	d.Set("last_update_timestamp", aws.TimeValue(application.LastUpdateTimestamp).Format(time.RFC3339))
+	d.Set("name", application.ApplicationName)
+	d.Set("runtime_environment", application.RuntimeEnvironment)
+	d.Set("service_execution_role", application.ServiceExecutionRole)
+	d.Set("status", application.ApplicationStatus)
+	d.Set("version_id", application.ApplicationVersionId)
+
+	// The service does not describe the Flink run configuration, so carry it over from configuration.
+	flinkRunConfiguration := d.Get("application_configuration.0.run_configuration.0.flink_run_configuration").([]interface{})
+
+	if err := d.Set("application_configuration", flattenKinesisAnalyticsV2ApplicationConfigurationDescription(application.ApplicationConfigurationDescription, flinkRunConfiguration)); err != nil {
+		return fmt.Errorf("error setting application_configuration: %w", err)
+	}
+
+	if err := d.Set("cloudwatch_logging_options", flattenKinesisAnalyticsV2CloudWatchLoggingOptionDescriptions(application.CloudWatchLoggingOptionDescriptions)); err != nil {
+		return fmt.Errorf("error setting cloudwatch_logging_options: %w", err)
+	}
+
+	tags, err := keyvaluetags.Kinesisanalyticsv2ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Kinesis Analytics v2 Application (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+
+	applicationName := d.Get("name").(string)
+	currentApplicationVersionId := int64(d.Get("version_id").(int))
+	updateApplication := false
+
+	input := &kinesisanalyticsv2.UpdateApplicationInput{
+		ApplicationName: aws.String(applicationName),
+	}
+
+	if d.HasChange("application_configuration") {
+		applicationConfigurationUpdate := &kinesisanalyticsv2.ApplicationConfigurationUpdate{}
+
+		if d.HasChange("application_configuration.0.application_code_configuration") {
+			applicationConfigurationUpdate.ApplicationCodeConfigurationUpdate = expandKinesisAnalyticsV2ApplicationCodeConfigurationUpdate(d.Get("application_configuration.0.application_code_configuration").([]interface{}))
+
+			updateApplication = true
+		}
+
+		if d.HasChange("application_configuration.0.application_snapshot_configuration") {
+			applicationConfigurationUpdate.ApplicationSnapshotConfigurationUpdate = &kinesisanalyticsv2.ApplicationSnapshotConfigurationUpdate{
+				SnapshotsEnabledUpdate: aws.Bool(d.Get("application_configuration.0.application_snapshot_configuration.0.snapshots_enabled").(bool)),
+			}
+
+			updateApplication = true
+		}
+
+		if d.HasChange("application_configuration.0.environment_properties") {
+			propertyGroups := []*kinesisanalyticsv2.PropertyGroup{}
+
+			if v, ok := d.GetOk("application_configuration.0.environment_properties.0.property_group"); ok {
+				propertyGroups = expandKinesisAnalyticsV2PropertyGroups(v.(*schema.Set).List())
+			}
+
+			applicationConfigurationUpdate.EnvironmentPropertyUpdates = &kinesisanalyticsv2.EnvironmentPropertyUpdates{
+				PropertyGroups: propertyGroups,
+			}
+
+			updateApplication = true
+		}
+
+		if d.HasChange("application_configuration.0.flink_application_configuration") {
+			applicationConfigurationUpdate.FlinkApplicationConfigurationUpdate = expandKinesisAnalyticsV2FlinkApplicationConfigurationUpdate(d.Get("application_configuration.0.flink_application_configuration").([]interface{}))
+
+			updateApplication = true
+		}
+
+		if d.HasChange("application_configuration.0.run_configuration") {
+			input.RunConfigurationUpdate = expandKinesisAnalyticsV2RunConfigurationUpdate(d.Get("application_configuration.0.run_configuration").([]interface{}))
+
+			updateApplication = true
+		}
+
+		if d.HasChange("application_configuration.0.sql_application_configuration") {
+			sqlApplicationConfigurationUpdate := &kinesisanalyticsv2.SqlApplicationConfigurationUpdate{}
+
+			if d.HasChange("application_configuration.0.sql_application_configuration.0.input") {
+				o, n := d.GetChange("application_configuration.0.sql_application_configuration.0.input")
+
+				if len(o.([]interface{})) == 0 {
+					// Add new input.
+					input := &kinesisanalyticsv2.AddApplicationInputInput{
+						ApplicationName:             aws.String(applicationName),
+						CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+						Input:                       expandKinesisAnalyticsV2Input(n.([]interface{})),
+					}
+
+					log.Printf("[DEBUG] Adding Kinesis Analytics v2 Application (%s) input: %s", d.Id(), input)
+					outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+						return conn.AddApplicationInput(input)
+					})
+
+					if err != nil {
+						return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) input: %w", d.Id(), err)
+					}
+
+					if _, err := waiter.ApplicationUpdated(conn, applicationName); err != nil {
+						return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+					}
+
+					currentApplicationVersionId = aws.Int64Value(outputRaw.(*kinesisanalyticsv2.AddApplicationInputOutput).ApplicationVersionId)
+				} else if len(n.([]interface{})) > 0 {
+					// Update existing input.
+					oldInput := o.([]interface{})[0].(map[string]interface{})
+					newInput := n.([]interface{})[0].(map[string]interface{})
+					inputId := oldInput["input_id"].(string)
+
+					inputUpdate := &kinesisanalyticsv2.InputUpdate{
+						InputId:          aws.String(inputId),
+						NamePrefixUpdate: aws.String(newInput["name_prefix"].(string)),
+					}
+
+					if v, ok := newInput["input_parallelism"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+						if count, ok := v[0].(map[string]interface{})["count"].(int); ok && count > 0 {
+							inputUpdate.InputParallelismUpdate = &kinesisanalyticsv2.InputParallelismUpdate{
+								CountUpdate: aws.Int64(int64(count)),
+							}
+						}
+					}
+
+					if v, ok := newInput["input_schema"].([]interface{}); ok && len(v) > 0 {
+						sourceSchema := expandKinesisAnalyticsV2SourceSchema(v)
+
+						inputUpdate.InputSchemaUpdate = &kinesisanalyticsv2.InputSchemaUpdate{
+							RecordColumnUpdates:  sourceSchema.RecordColumns,
+							RecordEncodingUpdate: sourceSchema.RecordEncoding,
+							RecordFormatUpdate:   sourceSchema.RecordFormat,
+						}
+					}
+
+					if v, ok := newInput["kinesis_firehose_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+						inputUpdate.KinesisFirehoseInputUpdate = &kinesisanalyticsv2.KinesisFirehoseInputUpdate{
+							ResourceARNUpdate: aws.String(v[0].(map[string]interface{})["resource_arn"].(string)),
+						}
+					}
+
+					if v, ok := newInput["kinesis_streams_input"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+						inputUpdate.KinesisStreamsInputUpdate = &kinesisanalyticsv2.KinesisStreamsInputUpdate{
+							ResourceARNUpdate: aws.String(v[0].(map[string]interface{})["resource_arn"].(string)),
+						}
+					}
+
+					oldProcessing := oldInput["input_processing_configuration"].([]interface{})
+					newProcessing := newInput["input_processing_configuration"].([]interface{})
+
+					switch {
+					case len(oldProcessing) == 0 && len(newProcessing) > 0:
+						// Add new input processing configuration.
+						input := &kinesisanalyticsv2.AddApplicationInputProcessingConfigurationInput{
+							ApplicationName:              aws.String(applicationName),
+							CurrentApplicationVersionId:  aws.Int64(currentApplicationVersionId),
+							InputId:                      aws.String(inputId),
+							InputProcessingConfiguration: expandKinesisAnalyticsV2InputProcessingConfiguration(newProcessing),
+						}
+
+						log.Printf("[DEBUG] Adding Kinesis Analytics v2 Application (%s) input processing configuration: %s", d.Id(), input)
+						outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+							return conn.AddApplicationInputProcessingConfiguration(input)
+						})
+
+						if err != nil {
+							return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) input processing configuration: %w", d.Id(), err)
+						}
+
+						if _, err := waiter.ApplicationUpdated(conn, applicationName); err != nil {
+							return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+						}
+
+						currentApplicationVersionId = aws.Int64Value(outputRaw.(*kinesisanalyticsv2.AddApplicationInputProcessingConfigurationOutput).ApplicationVersionId)
+					case len(oldProcessing) > 0 && len(newProcessing) == 0:
+						// Delete existing input processing configuration.
+						input := &kinesisanalyticsv2.DeleteApplicationInputProcessingConfigurationInput{
+							ApplicationName:             aws.String(applicationName),
+							CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+							InputId:                     aws.String(inputId),
+						}
+
+						log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application (%s) input processing configuration: %s", d.Id(), input)
+						output, err := conn.DeleteApplicationInputProcessingConfiguration(input)
+
+						if err != nil {
+							return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) input processing configuration: %w", d.Id(), err)
+						}
+
+						if _, err := waiter.ApplicationUpdated(conn, applicationName); err != nil {
+							return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+						}
+
+						currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+					case len(oldProcessing) > 0 && len(newProcessing) > 0:
+						inputProcessingConfiguration := expandKinesisAnalyticsV2InputProcessingConfiguration(newProcessing)
+
+						inputUpdate.InputProcessingConfigurationUpdate = &kinesisanalyticsv2.InputProcessingConfigurationUpdate{
+							InputLambdaProcessorUpdate: &kinesisanalyticsv2.InputLambdaProcessorUpdate{
+								ResourceARNUpdate: inputProcessingConfiguration.InputLambdaProcessor.ResourceARN,
+							},
+						}
+					}
+
+					sqlApplicationConfigurationUpdate.InputUpdates = []*kinesisanalyticsv2.InputUpdate{inputUpdate}
+
+					updateApplication = true
+				}
+			}
+
+			if d.HasChange("application_configuration.0.sql_application_configuration.0.output") {
+				o, n := d.GetChange("application_configuration.0.sql_application_configuration.0.output")
+				os := o.(*schema.Set)
+				ns := n.(*schema.Set)
+
+				// Delete any removed outputs.
+				for _, tfMapRaw := range os.Difference(ns).List() {
+					tfMap, ok := tfMapRaw.(map[string]interface{})
+
+					if !ok {
+						continue
+					}
+
+					input := &kinesisanalyticsv2.DeleteApplicationOutputInput{
+						ApplicationName:             aws.String(applicationName),
+						CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+						OutputId:                    aws.String(tfMap["output_id"].(string)),
+					}
+
+					log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application (%s) output: %s", d.Id(), input)
+					output, err := conn.DeleteApplicationOutput(input)
+
+					if err != nil {
+						return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) output: %w", d.Id(), err)
+					}
+
+					if _, err := waiter.ApplicationUpdated(conn, applicationName); err != nil {
+						return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+					}
+
+					currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+				}
+
+				// Add any new outputs.
+				for _, tfMapRaw := range ns.Difference(os).List() {
+					tfMap, ok := tfMapRaw.(map[string]interface{})
+
+					if !ok {
+						continue
+					}
+
+					input := &kinesisanalyticsv2.AddApplicationOutputInput{
+						ApplicationName:             aws.String(applicationName),
+						CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+						Output:                      expandKinesisAnalyticsV2Output(tfMap),
+					}
+
+					log.Printf("[DEBUG] Adding Kinesis Analytics v2 Application (%s) output: %s", d.Id(), input)
+					outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+						return conn.AddApplicationOutput(input)
+					})
+
+					if err != nil {
+						return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) output: %w", d.Id(), err)
+					}
+
+					if _, err := waiter.ApplicationUpdated(conn, applicationName); err != nil {
+						return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+					}
+
+					currentApplicationVersionId = aws.Int64Value(outputRaw.(*kinesisanalyticsv2.AddApplicationOutputOutput).ApplicationVersionId)
+				}
+			}
+
+			if d.HasChange("application_configuration.0.sql_application_configuration.0.reference_data_source") {
+				o, n := d.GetChange("application_configuration.0.sql_application_configuration.0.reference_data_source")
+
+				switch {
+				case len(o.([]interface{})) == 0:
+					// Add new reference data source.
+					input := &kinesisanalyticsv2.AddApplicationReferenceDataSourceInput{
+						ApplicationName:             aws.String(applicationName),
+						CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+						ReferenceDataSource:         expandKinesisAnalyticsV2ReferenceDataSource(n.([]interface{})),
+					}
+
+					log.Printf("[DEBUG] Adding Kinesis Analytics v2 Application (%s) reference data source: %s", d.Id(), input)
+					outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+						return conn.AddApplicationReferenceDataSource(input)
+					})
+
+					if err != nil {
+						return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) reference data source: %w", d.Id(), err)
+					}
+
+					if _, err := waiter.ApplicationUpdated(conn, applicationName); err != nil {
+						return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+					}
+
+					currentApplicationVersionId = aws.Int64Value(outputRaw.(*kinesisanalyticsv2.AddApplicationReferenceDataSourceOutput).ApplicationVersionId)
+				case len(n.([]interface{})) == 0:
+					// Delete existing reference data source.
+					input := &kinesisanalyticsv2.DeleteApplicationReferenceDataSourceInput{
+						ApplicationName:             aws.String(applicationName),
+						CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+						ReferenceId:                 aws.String(o.([]interface{})[0].(map[string]interface{})["reference_id"].(string)),
+					}
+
+					log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application (%s) reference data source: %s", d.Id(), input)
+					output, err := conn.DeleteApplicationReferenceDataSource(input)
+
+					if err != nil {
+						return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) reference data source: %w", d.Id(), err)
+					}
+
+					if _, err := waiter.ApplicationUpdated(conn, applicationName); err != nil {
+						return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+					}
+
+					currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+				default:
+					// Update existing reference data source.
+					referenceDataSource := expandKinesisAnalyticsV2ReferenceDataSource(n.([]interface{}))
+
+					sqlApplicationConfigurationUpdate.ReferenceDataSourceUpdates = []*kinesisanalyticsv2.ReferenceDataSourceUpdate{
+						{
+							ReferenceId:           aws.String(o.([]interface{})[0].(map[string]interface{})["reference_id"].(string)),
+							ReferenceSchemaUpdate: referenceDataSource.ReferenceSchema,
+							S3ReferenceDataSourceUpdate: &kinesisanalyticsv2.S3ReferenceDataSourceUpdate{
+								BucketARNUpdate: referenceDataSource.S3ReferenceDataSource.BucketARN,
+								FileKeyUpdate:   referenceDataSource.S3ReferenceDataSource.FileKey,
+							},
+							TableNameUpdate: referenceDataSource.TableName,
+						},
+					}
+
+					updateApplication = true
+				}
+			}
+
+			if len(sqlApplicationConfigurationUpdate.InputUpdates) > 0 || len(sqlApplicationConfigurationUpdate.ReferenceDataSourceUpdates) > 0 {
+				applicationConfigurationUpdate.SqlApplicationConfigurationUpdate = sqlApplicationConfigurationUpdate
+			}
+		}
+
+		if d.HasChange("application_configuration.0.vpc_configuration") {
+			o, n := d.GetChange("application_configuration.0.vpc_configuration")
+
+			switch {
+			case len(o.([]interface{})) == 0:
+				// Add new VPC configuration.
+				input := &kinesisanalyticsv2.AddApplicationVpcConfigurationInput{
+					ApplicationName:             aws.String(applicationName),
+					CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+					VpcConfiguration:            expandKinesisAnalyticsV2VpcConfiguration(n.([]interface{})),
+				}
+
+				log.Printf("[DEBUG] Adding Kinesis Analytics v2 Application (%s) VPC configuration: %s", d.Id(), input)
+				outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+					return conn.AddApplicationVpcConfiguration(input)
+				})
+
+				if err != nil {
+					return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) VPC configuration: %w", d.Id(), err)
+				}
+
+				if _, err := waiter.ApplicationUpdated(conn, applicationName); err != nil {
+					return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+				}
+
+				currentApplicationVersionId = aws.Int64Value(outputRaw.(*kinesisanalyticsv2.AddApplicationVpcConfigurationOutput).ApplicationVersionId)
+			case len(n.([]interface{})) == 0:
+				// Delete existing VPC configuration.
+				input := &kinesisanalyticsv2.DeleteApplicationVpcConfigurationInput{
+					ApplicationName:             aws.String(applicationName),
+					CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+					VpcConfigurationId:          aws.String(o.([]interface{})[0].(map[string]interface{})["vpc_configuration_id"].(string)),
+				}
+
+				log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application (%s) VPC configuration: %s", d.Id(), input)
+				output, err := conn.DeleteApplicationVpcConfiguration(input)
+
+				if err != nil {
+					return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) VPC configuration: %w", d.Id(), err)
+				}
+
+				if _, err := waiter.ApplicationUpdated(conn, applicationName); err != nil {
+					return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+				}
+
+				currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+			default:
+				// Update existing VPC configuration.
+				vpcConfiguration := expandKinesisAnalyticsV2VpcConfiguration(n.([]interface{}))
+
+				applicationConfigurationUpdate.VpcConfigurationUpdates = []*kinesisanalyticsv2.VpcConfigurationUpdate{
+					{
+						SecurityGroupIdUpdates: vpcConfiguration.SecurityGroupIds,
+						SubnetIdUpdates:        vpcConfiguration.SubnetIds,
+						VpcConfigurationId:     aws.String(o.([]interface{})[0].(map[string]interface{})["vpc_configuration_id"].(string)),
+					},
+				}
+
+				updateApplication = true
+			}
+		}
+
+		input.ApplicationConfigurationUpdate = applicationConfigurationUpdate
+	}
+
+	if d.HasChange("cloudwatch_logging_options") {
+		o, n := d.GetChange("cloudwatch_logging_options")
+
+		switch {
+		case len(o.([]interface{})) == 0:
+			// Add new CloudWatch logging options.
+			input := &kinesisanalyticsv2.AddApplicationCloudWatchLoggingOptionInput{
+				ApplicationName:             aws.String(applicationName),
+				CloudWatchLoggingOption:     expandKinesisAnalyticsV2CloudWatchLoggingOptions(n.([]interface{}))[0],
+				CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+			}
+
+			log.Printf("[DEBUG] Adding Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), input)
+			outputRaw, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+				return conn.AddApplicationCloudWatchLoggingOption(input)
+			})
+
+			if err != nil {
+				return fmt.Errorf("error adding Kinesis Analytics v2 Application (%s) CloudWatch logging option: %w", d.Id(), err)
+			}
+
+			if _, err := waiter.ApplicationUpdated(conn, applicationName); err != nil {
+				return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+			}
+
+			currentApplicationVersionId = aws.Int64Value(outputRaw.(*kinesisanalyticsv2.AddApplicationCloudWatchLoggingOptionOutput).ApplicationVersionId)
+		case len(n.([]interface{})) == 0:
+			// Delete existing CloudWatch logging options.
+			input := &kinesisanalyticsv2.DeleteApplicationCloudWatchLoggingOptionInput{
+				ApplicationName:             aws.String(applicationName),
+				CloudWatchLoggingOptionId:   aws.String(o.([]interface{})[0].(map[string]interface{})["cloudwatch_logging_option_id"].(string)),
+				CurrentApplicationVersionId: aws.Int64(currentApplicationVersionId),
+			}
+
+			log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application (%s) CloudWatch logging option: %s", d.Id(), input)
+			output, err := conn.DeleteApplicationCloudWatchLoggingOption(input)
+
+			if err != nil {
+				return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s) CloudWatch logging option: %w", d.Id(), err)
+			}
+
+			if _, err := waiter.ApplicationUpdated(conn, applicationName); err != nil {
+				return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+			}
+
+			currentApplicationVersionId = aws.Int64Value(output.ApplicationVersionId)
+		default:
+			// Update existing CloudWatch logging options.
+			input.CloudWatchLoggingOptionUpdates = []*kinesisanalyticsv2.CloudWatchLoggingOptionUpdate{
+				{
+					CloudWatchLoggingOptionId: aws.String(o.([]interface{})[0].(map[string]interface{})["cloudwatch_logging_option_id"].(string)),
+					LogStreamARNUpdate:        aws.String(n.([]interface{})[0].(map[string]interface{})["log_stream_arn"].(string)),
+				},
+			}
+
+			updateApplication = true
+		}
+	}
+
+	if d.HasChange("service_execution_role") {
+		input.ServiceExecutionRoleUpdate = aws.String(d.Get("service_execution_role").(string))
+
+		updateApplication = true
+	}
+
+	if updateApplication {
+		input.CurrentApplicationVersionId = aws.Int64(currentApplicationVersionId)
+
+		log.Printf("[DEBUG] Updating Kinesis Analytics v2 Application (%s): %s", d.Id(), input)
+		_, err := kinesisAnalyticsV2RetryIAMEventualConsistency(func() (interface{}, error) {
+			return conn.UpdateApplication(input)
+		})
+
+		if err != nil {
+			return fmt.Errorf("error updating Kinesis Analytics v2 Application (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.ApplicationUpdated(conn, applicationName); err != nil {
+			return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("start_application") {
+		if d.Get("start_application").(bool) {
+			if err := kinesisAnalyticsV2StartApplication(conn, expandKinesisAnalyticsV2StartApplicationInput(d)); err != nil {
+				return err
+			}
+		} else {
+			if err := kinesisAnalyticsV2StopApplication(conn, applicationName); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.Kinesisanalyticsv2UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Kinesis Analytics v2 Application (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsV2ApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsv2conn
+
+	createTimestamp, err := time.Parse(time.RFC3339, d.Get("create_timestamp").(string))
+
+	if err != nil {
+		return fmt.Errorf("error parsing create_timestamp: %w", err)
+	}
+
+	applicationName := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Deleting Kinesis Analytics v2 Application: %s", d.Id())
+	_, err = conn.DeleteApplication(&kinesisanalyticsv2.DeleteApplicationInput{
+		ApplicationName: aws.String(applicationName),
+		CreateTimestamp: aws.Time(createTimestamp),
+	})
+
+	if tfawserr.ErrCodeEquals(err, kinesisanalyticsv2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kinesis Analytics v2 Application (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.ApplicationDeleted(conn, applicationName); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsV2ApplicationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parsedArn, err := arn.Parse(d.Id())
+
+	if err != nil {
+		return []*schema.ResourceData{}, fmt.Errorf("error parsing ARN (%s): %w", d.Id(), err)
+	}
+
+	// application/<name>
+	parts := strings.Split(parsedArn.Resource, "/")
+
+	if len(parts) != 2 || parts[0] != "application" || parts[1] == "" {
+		return []*schema.ResourceData{}, fmt.Errorf("unexpected format of ARN (%s), expected arn:${Partition}:kinesisanalytics:${Region}:${Account}:application/${ApplicationName}", d.Id())
+	}
+
+	d.Set("name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func kinesisAnalyticsV2StartApplication(conn *kinesisanalyticsv2.KinesisAnalyticsV2, input *kinesisanalyticsv2.StartApplicationInput) error {
+	applicationName := aws.StringValue(input.ApplicationName)
+
+	log.Printf("[DEBUG] Starting Kinesis Analytics v2 Application (%s): %s", applicationName, input)
+	if _, err := conn.StartApplication(input); err != nil {
+		return fmt.Errorf("error starting Kinesis Analytics v2 Application (%s): %w", applicationName, err)
+	}
+
+	if _, err := waiter.ApplicationStarted(conn, applicationName); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to start: %w", applicationName, err)
+	}
+
+	return nil
+}
+
+func kinesisAnalyticsV2StopApplication(conn *kinesisanalyticsv2.KinesisAnalyticsV2, applicationName string) error {
+	log.Printf("[DEBUG] Stopping Kinesis Analytics v2 Application: %s", applicationName)
+	_, err := conn.StopApplication(&kinesisanalyticsv2.StopApplicationInput{
+		ApplicationName: aws.String(applicationName),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping Kinesis Analytics v2 Application (%s): %w", applicationName, err)
+	}
+
+	if _, err := waiter.ApplicationStopped(conn, applicationName); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics v2 Application (%s) to stop: %w", applicationName, err)
+	}
+
+	return nil
+}
+
+// kinesisAnalyticsV2RetryIAMEventualConsistency retries the specified function for IAM eventual consistency.
+func kinesisAnalyticsV2RetryIAMEventualConsistency(f func() (interface{}, error)) (interface{}, error) {
+	var output interface{}
+
+	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		var err error
+
+		output, err = f()
+
+		// Kinesis Stream: https://github.com/terraform-providers/terraform-provider-aws/issues/7032
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges") {
+			return resource.RetryableError(err)
+		}
+
+		// Kinesis Firehose: https://github.com/terraform-providers/terraform-provider-aws/issues/7394
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "Kinesis Analytics doesn't have sufficient privileges") {
+			return resource.RetryableError(err)
+		}
+
+		// InvalidArgumentException: Given IAM role arn : arn:aws:iam::123456789012:role/xxx does not provide Invoke permissions on the Lambda resource : arn:aws:lambda:us-west-2:123456789012:function:yyy
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "does not provide Invoke permissions on the Lambda resource") {
+			return resource.RetryableError(err)
+		}
+
+		// S3: InvalidArgumentException: Given IAM role arn : arn:aws:iam::123456789012:role/xxx does not provide access to the S3 object.
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "does not provide access to the S3 object") {
+			return resource.RetryableError(err)
+		}
+
+		// VPC: InvalidArgumentException: Kinesis Analytics service doesn't have sufficient privileges to assume the role.
+		if isAWSErr(err, kinesisanalyticsv2.ErrCodeInvalidArgumentException, "doesn't have sufficient privileges to assume the role") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		output, err = f()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func expandKinesisAnalyticsV2StartApplicationInput(d *schema.ResourceData) *kinesisanalyticsv2.StartApplicationInput {
+	input := &kinesisanalyticsv2.StartApplicationInput{
+		ApplicationName:  aws.String(d.Get("name").(string)),
+		RunConfiguration: &kinesisanalyticsv2.RunConfiguration{},
+	}
+
+	if d.Get("runtime_environment").(string) == kinesisanalyticsv2.RuntimeEnvironmentSql10 {
+		if v, ok := d.GetOk("application_configuration.0.sql_application_configuration.0.input"); ok && len(v.([]interface{})) > 0 {
+			tfMap := v.([]interface{})[0].(map[string]interface{})
+			inputStartingPosition := kinesisanalyticsv2.InputStartingPositionNow
+
+			if v, ok := tfMap["input_starting_position_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				if v, ok := v[0].(map[string]interface{})["input_starting_position"].(string); ok && v != "" {
+					inputStartingPosition = v
+				}
+			}
+
+			input.RunConfiguration.SqlRunConfigurations = []*kinesisanalyticsv2.SqlRunConfiguration{
+				{
+					InputId: aws.String(tfMap["input_id"].(string)),
+					InputStartingPositionConfiguration: &kinesisanalyticsv2.InputStartingPositionConfiguration{
+						InputStartingPosition: aws.String(inputStartingPosition),
+					},
+				},
+			}
+		}
+
+		return input
+	}
+
+	if v, ok := d.GetOk("application_configuration.0.run_configuration"); ok {
+		runConfigurationUpdate := expandKinesisAnalyticsV2RunConfigurationUpdate(v.([]interface{}))
+
+		if runConfigurationUpdate != nil {
+			input.RunConfiguration.ApplicationRestoreConfiguration = runConfigurationUpdate.ApplicationRestoreConfiguration
+			input.RunConfiguration.FlinkRunConfiguration = runConfigurationUpdate.FlinkRunConfiguration
+		}
+	}
+
+	return input
+}
+
+func expandKinesisAnalyticsV2ApplicationConfiguration(vApplicationConfiguration []interface{}) *kinesisanalyticsv2.ApplicationConfiguration {
+	if len(vApplicationConfiguration) == 0 || vApplicationConfiguration[0] == nil {
+		return nil
+	}
+
+	applicationConfiguration := &kinesisanalyticsv2.ApplicationConfiguration{}
+
+	mApplicationConfiguration := vApplicationConfiguration[0].(map[string]interface{})
+
+	if vApplicationCodeConfiguration, ok := mApplicationConfiguration["application_code_configuration"].([]interface{}); ok && len(vApplicationCodeConfiguration) > 0 && vApplicationCodeConfiguration[0] != nil {
+		applicationCodeConfiguration := &kinesisanalyticsv2.ApplicationCodeConfiguration{}
+
+		mApplicationCodeConfiguration := vApplicationCodeConfiguration[0].(map[string]interface{})
+
+		if vCodeContent, ok := mApplicationCodeConfiguration["code_content"].([]interface{}); ok && len(vCodeContent) > 0 && vCodeContent[0] != nil {
+			codeContent := &kinesisanalyticsv2.CodeContent{}
+
+			mCodeContent := vCodeContent[0].(map[string]interface{})
+
+			if vS3ContentLocation, ok := mCodeContent["s3_content_location"].([]interface{}); ok && len(vS3ContentLocation) > 0 && vS3ContentLocation[0] != nil {
+				s3ContentLocation := &kinesisanalyticsv2.S3ContentLocation{}
+
+				mS3ContentLocation := vS3ContentLocation[0].(map[string]interface{})
+
+				if vBucketArn, ok := mS3ContentLocation["bucket_arn"].(string); ok && vBucketArn != "" {
+					s3ContentLocation.BucketARN = aws.String(vBucketArn)
+				}
+				if vFileKey, ok := mS3ContentLocation["file_key"].(string); ok && vFileKey != "" {
+					s3ContentLocation.FileKey = aws.String(vFileKey)
+				}
+				if vObjectVersion, ok := mS3ContentLocation["object_version"].(string); ok && vObjectVersion != "" {
+					s3ContentLocation.ObjectVersion = aws.String(vObjectVersion)
+				}
+
+				codeContent.S3ContentLocation = s3ContentLocation
+			}
+
+			if vTextContent, ok := mCodeContent["text_content"].(string); ok && vTextContent != "" {
+				codeContent.TextContent = aws.String(vTextContent)
+			}
+
+			applicationCodeConfiguration.CodeContent = codeContent
+		}
+
+		if vCodeContentType, ok := mApplicationCodeConfiguration["code_content_type"].(string); ok && vCodeContentType != "" {
+			applicationCodeConfiguration.CodeContentType = aws.String(vCodeContentType)
+		}
+
+		applicationConfiguration.ApplicationCodeConfiguration = applicationCodeConfiguration
+	}
+
+	if vApplicationSnapshotConfiguration, ok := mApplicationConfiguration["application_snapshot_configuration"].([]interface{}); ok && len(vApplicationSnapshotConfiguration) > 0 && vApplicationSnapshotConfiguration[0] != nil {
+		mApplicationSnapshotConfiguration := vApplicationSnapshotConfiguration[0].(map[string]interface{})
+
+		applicationConfiguration.ApplicationSnapshotConfiguration = &kinesisanalyticsv2.ApplicationSnapshotConfiguration{
+			SnapshotsEnabled: aws.Bool(mApplicationSnapshotConfiguration["snapshots_enabled"].(bool)),
+		}
+	}
+
+	if vEnvironmentProperties, ok := mApplicationConfiguration["environment_properties"].([]interface{}); ok && len(vEnvironmentProperties) > 0 && vEnvironmentProperties[0] != nil {
+		mEnvironmentProperties := vEnvironmentProperties[0].(map[string]interface{})
+
+		if vPropertyGroups, ok := mEnvironmentProperties["property_group"].(*schema.Set); ok && vPropertyGroups.Len() > 0 {
+			applicationConfiguration.EnvironmentProperties = &kinesisanalyticsv2.EnvironmentProperties{
+				PropertyGroups: expandKinesisAnalyticsV2PropertyGroups(vPropertyGroups.List()),
+			}
+		}
+	}
+
+	if vFlinkApplicationConfiguration, ok := mApplicationConfiguration["flink_application_configuration"].([]interface{}); ok && len(vFlinkApplicationConfiguration) > 0 && vFlinkApplicationConfiguration[0] != nil {
+		flinkApplicationConfiguration := &kinesisanalyticsv2.FlinkApplicationConfiguration{}
+
+		mFlinkApplicationConfiguration := vFlinkApplicationConfiguration[0].(map[string]interface{})
+
+		if vCheckpointConfiguration, ok := mFlinkApplicationConfiguration["checkpoint_configuration"].([]interface{}); ok && len(vCheckpointConfiguration) > 0 && vCheckpointConfiguration[0] != nil {
+			checkpointConfiguration := &kinesisanalyticsv2.CheckpointConfiguration{}
+
+			mCheckpointConfiguration := vCheckpointConfiguration[0].(map[string]interface{})
+
+			if vConfigurationType, ok := mCheckpointConfiguration["configuration_type"].(string); ok && vConfigurationType != "" {
+				checkpointConfiguration.ConfigurationType = aws.String(vConfigurationType)
+
+				if vConfigurationType == kinesisanalyticsv2.ConfigurationTypeCustom {
+					if vCheckpointingEnabled, ok := mCheckpointConfiguration["checkpointing_enabled"].(bool); ok {
+						checkpointConfiguration.CheckpointingEnabled = aws.Bool(vCheckpointingEnabled)
+					}
+					if vCheckpointInterval, ok := mCheckpointConfiguration["checkpoint_interval"].(int); ok && vCheckpointInterval > 0 {
+						checkpointConfiguration.CheckpointInterval = aws.Int64(int64(vCheckpointInterval))
+					}
+					if vMinPauseBetweenCheckpoints, ok := mCheckpointConfiguration["min_pause_between_checkpoints"].(int); ok {
+						checkpointConfiguration.MinPauseBetweenCheckpoints = aws.Int64(int64(vMinPauseBetweenCheckpoints))
+					}
+				}
+			}
+
+			flinkApplicationConfiguration.CheckpointConfiguration = checkpointConfiguration
+		}
+
+		if vMonitoringConfiguration, ok := mFlinkApplicationConfiguration["monitoring_configuration"].([]interface{}); ok && len(vMonitoringConfiguration) > 0 && vMonitoringConfiguration[0] != nil {
+			monitoringConfiguration := &kinesisanalyticsv2.MonitoringConfiguration{}
+
+			mMonitoringConfiguration := vMonitoringConfiguration[0].(map[string]interface{})
+
+			if vConfigurationType, ok := mMonitoringConfiguration["configuration_type"].(string); ok && vConfigurationType != "" {
+				monitoringConfiguration.ConfigurationType = aws.String(vConfigurationType)
+
+				if vConfigurationType == kinesisanalyticsv2.ConfigurationTypeCustom {
+					if vLogLevel, ok := mMonitoringConfiguration["log_level"].(string); ok && vLogLevel != "" {
+						monitoringConfiguration.LogLevel = aws.String(vLogLevel)
+					}
+					if vMetricsLevel, ok := mMonitoringConfiguration["metrics_level"].(string); ok && vMetricsLevel != "" {
+						monitoringConfiguration.MetricsLevel = aws.String(vMetricsLevel)
+					}
+				}
+			}
+
+			flinkApplicationConfiguration.MonitoringConfiguration = monitoringConfiguration
+		}
+
+		if vParallelismConfiguration, ok := mFlinkApplicationConfiguration["parallelism_configuration"].([]interface{}); ok && len(vParallelismConfiguration) > 0 && vParallelismConfiguration[0] != nil {
+			parallelismConfiguration := &kinesisanalyticsv2.ParallelismConfiguration{}
+
+			mParallelismConfiguration := vParallelismConfiguration[0].(map[string]interface{})
+
+			if vConfigurationType, ok := mParallelismConfiguration["configuration_type"].(string); ok && vConfigurationType != "" {
+				parallelismConfiguration.ConfigurationType = aws.String(vConfigurationType)
+
+				if vConfigurationType == kinesisanalyticsv2.ConfigurationTypeCustom {
+					if vAutoScalingEnabled, ok := mParallelismConfiguration["auto_scaling_enabled"].(bool); ok {
+						parallelismConfiguration.AutoScalingEnabled = aws.Bool(vAutoScalingEnabled)
+					}
+					if vParallelism, ok := mParallelismConfiguration["parallelism"].(int); ok && vParallelism > 0 {
+						parallelismConfiguration.Parallelism = aws.Int64(int64(vParallelism))
+					}
+					if vParallelismPerKPU, ok := mParallelismConfiguration["parallelism_per_kpu"].(int); ok && vParallelismPerKPU > 0 {
+						parallelismConfiguration.ParallelismPerKPU = aws.Int64(int64(vParallelismPerKPU))
+					}
+				}
+			}
+
+			flinkApplicationConfiguration.ParallelismConfiguration = parallelismConfiguration
+		}
+
+		applicationConfiguration.FlinkApplicationConfiguration = flinkApplicationConfiguration
+	}
+
+	if vSqlApplicationConfiguration, ok := mApplicationConfiguration["sql_application_configuration"].([]interface{}); ok && len(vSqlApplicationConfiguration) > 0 && vSqlApplicationConfiguration[0] != nil {
+		sqlApplicationConfiguration := &kinesisanalyticsv2.SqlApplicationConfiguration{}
+
+		mSqlApplicationConfiguration := vSqlApplicationConfiguration[0].(map[string]interface{})
+
+		if vInput, ok := mSqlApplicationConfiguration["input"].([]interface{}); ok && len(vInput) > 0 && vInput[0] != nil {
+			sqlApplicationConfiguration.Inputs = []*kinesisanalyticsv2.Input{expandKinesisAnalyticsV2Input(vInput)}
+		}
+
+		if vOutputs, ok := mSqlApplicationConfiguration["output"].(*schema.Set); ok {
+			outputs := []*kinesisanalyticsv2.Output{}
+
+			for _, vOutput := range vOutputs.List() {
+				mOutput, ok := vOutput.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				outputs = append(outputs, expandKinesisAnalyticsV2Output(mOutput))
+			}
+
+			sqlApplicationConfiguration.Outputs = outputs
+		}
+
+		if vReferenceDataSource, ok := mSqlApplicationConfiguration["reference_data_source"].([]interface{}); ok && len(vReferenceDataSource) > 0 && vReferenceDataSource[0] != nil {
+			sqlApplicationConfiguration.ReferenceDataSources = []*kinesisanalyticsv2.ReferenceDataSource{expandKinesisAnalyticsV2ReferenceDataSource(vReferenceDataSource)}
+		}
+
+		applicationConfiguration.SqlApplicationConfiguration = sqlApplicationConfiguration
+	}
+
+	if vVpcConfiguration, ok := mApplicationConfiguration["vpc_configuration"].([]interface{}); ok && len(vVpcConfiguration) > 0 && vVpcConfiguration[0] != nil {
+		applicationConfiguration.VpcConfigurations = []*kinesisanalyticsv2.VpcConfiguration{expandKinesisAnalyticsV2VpcConfiguration(vVpcConfiguration)}
+	}
+
+	return applicationConfiguration
+}
+
+func expandKinesisAnalyticsV2ApplicationCodeConfigurationUpdate(vApplicationCodeConfiguration []interface{}) *kinesisanalyticsv2.ApplicationCodeConfigurationUpdate {
+	if len(vApplicationCodeConfiguration) == 0 || vApplicationCodeConfiguration[0] == nil {
+		return nil
+	}
+
+	applicationCodeConfigurationUpdate := &kinesisanalyticsv2.ApplicationCodeConfigurationUpdate{}
+
+	mApplicationCodeConfiguration := vApplicationCodeConfiguration[0].(map[string]interface{})
+
+	if vCodeContent, ok := mApplicationCodeConfiguration["code_content"].([]interface{}); ok && len(vCodeContent) > 0 && vCodeContent[0] != nil {
+		codeContentUpdate := &kinesisanalyticsv2.CodeContentUpdate{}
+
+		mCodeContent := vCodeContent[0].(map[string]interface{})
+
+		if vS3ContentLocation, ok := mCodeContent["s3_content_location"].([]interface{}); ok && len(vS3ContentLocation) > 0 && vS3ContentLocation[0] != nil {
+			s3ContentLocationUpdate := &kinesisanalyticsv2.S3ContentLocationUpdate{}
+
+			mS3ContentLocation := vS3ContentLocation[0].(map[string]interface{})
+
+			if vBucketArn, ok := mS3ContentLocation["bucket_arn"].(string); ok && vBucketArn != "" {
+				s3ContentLocationUpdate.BucketARNUpdate = aws.String(vBucketArn)
+			}
+			if vFileKey, ok := mS3ContentLocation["file_key"].(string); ok && vFileKey != "" {
+				s3ContentLocationUpdate.FileKeyUpdate = aws.String(vFileKey)
+			}
+			if vObjectVersion, ok := mS3ContentLocation["object_version"].(string); ok && vObjectVersion != "" {
+				s3ContentLocationUpdate.ObjectVersionUpdate = aws.String(vObjectVersion)
+			}
+
+			codeContentUpdate.S3ContentLocationUpdate = s3ContentLocationUpdate
+		}
+
+		if vTextContent, ok := mCodeContent["text_content"].(string); ok && vTextContent != "" {
+			codeContentUpdate.TextContentUpdate = aws.String(vTextContent)
+		}
+
+		applicationCodeConfigurationUpdate.CodeContentUpdate = codeContentUpdate
+	}
+
+	if vCodeContentType, ok := mApplicationCodeConfiguration["code_content_type"].(string); ok && vCodeContentType != "" {
+		applicationCodeConfigurationUpdate.CodeContentTypeUpdate = aws.String(vCodeContentType)
+	}
+
+	return applicationCodeConfigurationUpdate
+}
+
+func expandKinesisAnalyticsV2FlinkApplicationConfigurationUpdate(vFlinkApplicationConfiguration []interface{}) *kinesisanalyticsv2.FlinkApplicationConfigurationUpdate {
+	applicationConfiguration := expandKinesisAnalyticsV2ApplicationConfiguration([]interface{}{
+		map[string]interface{}{
+			"flink_application_configuration": vFlinkApplicationConfiguration,
+		},
+	})
+
+	if applicationConfiguration == nil || applicationConfiguration.FlinkApplicationConfiguration == nil {
+		return nil
+	}
+
+	flinkApplicationConfiguration := applicationConfiguration.FlinkApplicationConfiguration
+	flinkApplicationConfigurationUpdate := &kinesisanalyticsv2.FlinkApplicationConfigurationUpdate{}
+
+	if v := flinkApplicationConfiguration.CheckpointConfiguration; v != nil {
+		flinkApplicationConfigurationUpdate.CheckpointConfigurationUpdate = &kinesisanalyticsv2.CheckpointConfigurationUpdate{
+			CheckpointIntervalUpdate:         v.CheckpointInterval,
+			CheckpointingEnabledUpdate:       v.CheckpointingEnabled,
+			ConfigurationTypeUpdate:          v.ConfigurationType,
+			MinPauseBetweenCheckpointsUpdate: v.MinPauseBetweenCheckpoints,
+		}
+	}
+
+	if v := flinkApplicationConfiguration.MonitoringConfiguration; v != nil {
+		flinkApplicationConfigurationUpdate.MonitoringConfigurationUpdate = &kinesisanalyticsv2.MonitoringConfigurationUpdate{
+			ConfigurationTypeUpdate: v.ConfigurationType,
+			LogLevelUpdate:          v.LogLevel,
+			MetricsLevelUpdate:      v.MetricsLevel,
+		}
+	}
+
+	if v := flinkApplicationConfiguration.ParallelismConfiguration; v != nil {
+		flinkApplicationConfigurationUpdate.ParallelismConfigurationUpdate = &kinesisanalyticsv2.ParallelismConfigurationUpdate{
+			AutoScalingEnabledUpdate: v.AutoScalingEnabled,
+			ConfigurationTypeUpdate:  v.ConfigurationType,
+			ParallelismPerKPUUpdate:  v.ParallelismPerKPU,
+			ParallelismUpdate:        v.Parallelism,
+		}
+	}
+
+	return flinkApplicationConfigurationUpdate
+}
+
+func expandKinesisAnalyticsV2RunConfigurationUpdate(vRunConfiguration []interface{}) *kinesisanalyticsv2.RunConfigurationUpdate {
+	if len(vRunConfiguration) == 0 || vRunConfiguration[0] == nil {
+		return nil
+	}
+
+	runConfigurationUpdate := &kinesisanalyticsv2.RunConfigurationUpdate{}
+
+	mRunConfiguration := vRunConfiguration[0].(map[string]interface{})
+
+	if vApplicationRestoreConfiguration, ok := mRunConfiguration["application_restore_configuration"].([]interface{}); ok && len(vApplicationRestoreConfiguration) > 0 && vApplicationRestoreConfiguration[0] != nil {
+		applicationRestoreConfiguration := &kinesisanalyticsv2.ApplicationRestoreConfiguration{}
+
+		mApplicationRestoreConfiguration := vApplicationRestoreConfiguration[0].(map[string]interface{})
+
+		if vApplicationRestoreType, ok := mApplicationRestoreConfiguration["application_restore_type"].(string); ok && vApplicationRestoreType != "" {
+			applicationRestoreConfiguration.ApplicationRestoreType = aws.String(vApplicationRestoreType)
+		}
+		if vSnapshotName, ok := mApplicationRestoreConfiguration["snapshot_name"].(string); ok && vSnapshotName != "" {
+			applicationRestoreConfiguration.SnapshotName = aws.String(vSnapshotName)
+		}
+
+		runConfigurationUpdate.ApplicationRestoreConfiguration = applicationRestoreConfiguration
+	}
+
+	if vFlinkRunConfiguration, ok := mRunConfiguration["flink_run_configuration"].([]interface{}); ok && len(vFlinkRunConfiguration) > 0 && vFlinkRunConfiguration[0] != nil {
+		mFlinkRunConfiguration := vFlinkRunConfiguration[0].(map[string]interface{})
+
+		runConfigurationUpdate.FlinkRunConfiguration = &kinesisanalyticsv2.FlinkRunConfiguration{
+			AllowNonRestoredState: aws.Bool(mFlinkRunConfiguration["allow_non_restored_state"].(bool)),
+		}
+	}
+
+	return runConfigurationUpdate
+}
+
+func expandKinesisAnalyticsV2CloudWatchLoggingOptions(vCloudWatchLoggingOptions []interface{}) []*kinesisanalyticsv2.CloudWatchLoggingOption {
+	if len(vCloudWatchLoggingOptions) == 0 || vCloudWatchLoggingOptions[0] == nil {
+		return nil
+	}
+
+	mCloudWatchLoggingOption := vCloudWatchLoggingOptions[0].(map[string]interface{})
+
+	return []*kinesisanalyticsv2.CloudWatchLoggingOption{
+		{
+			LogStreamARN: aws.String(mCloudWatchLoggingOption["log_stream_arn"].(string)),
+		},
+	}
+}
+
+func expandKinesisAnalyticsV2PropertyGroups(vPropertyGroups []interface{}) []*kinesisanalyticsv2.PropertyGroup {
+	propertyGroups := []*kinesisanalyticsv2.PropertyGroup{}
+
+	for _, vPropertyGroup := range vPropertyGroups {
+		mPropertyGroup, ok := vPropertyGroup.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		propertyGroup := &kinesisanalyticsv2.PropertyGroup{}
+
+		if vPropertyGroupId, ok := mPropertyGroup["property_group_id"].(string); ok && vPropertyGroupId != "" {
+			propertyGroup.PropertyGroupId = aws.String(vPropertyGroupId)
+		}
+		if vPropertyMap, ok := mPropertyGroup["property_map"].(map[string]interface{}); ok && len(vPropertyMap) > 0 {
+			propertyGroup.PropertyMap = stringMapToPointers(vPropertyMap)
+		}
+
+		propertyGroups = append(propertyGroups, propertyGroup)
+	}
+
+	return propertyGroups
+}
+
+func expandKinesisAnalyticsV2Input(vInput []interface{}) *kinesisanalyticsv2.Input {
+	if len(vInput) == 0 || vInput[0] == nil {
+		return nil
+	}
+
+	input := &kinesisanalyticsv2.Input{}
+
+	mInput := vInput[0].(map[string]interface{})
+
+	if vInputParallelism, ok := mInput["input_parallelism"].([]interface{}); ok && len(vInputParallelism) > 0 && vInputParallelism[0] != nil {
+		inputParallelism := &kinesisanalyticsv2.InputParallelism{}
+
+		mInputParallelism := vInputParallelism[0].(map[string]interface{})
+
+		if vCount, ok := mInputParallelism["count"].(int); ok && vCount > 0 {
+			inputParallelism.Count = aws.Int64(int64(vCount))
+		}
+
+		input.InputParallelism = inputParallelism
+	}
+
+	if vInputProcessingConfiguration, ok := mInput["input_processing_configuration"].([]interface{}); ok {
+		input.InputProcessingConfiguration = expandKinesisAnalyticsV2InputProcessingConfiguration(vInputProcessingConfiguration)
+	}
+
+	if vInputSchema, ok := mInput["input_schema"].([]interface{}); ok {
+		input.InputSchema = expandKinesisAnalyticsV2SourceSchema(vInputSchema)
+	}
+
+	if vKinesisFirehoseInput, ok := mInput["kinesis_firehose_input"].([]interface{}); ok && len(vKinesisFirehoseInput) > 0 && vKinesisFirehoseInput[0] != nil {
+		mKinesisFirehoseInput := vKinesisFirehoseInput[0].(map[string]interface{})
+
+		input.KinesisFirehoseInput = &kinesisanalyticsv2.KinesisFirehoseInput{
+			ResourceARN: aws.String(mKinesisFirehoseInput["resource_arn"].(string)),
+		}
+	}
+
+	if vKinesisStreamsInput, ok := mInput["kinesis_streams_input"].([]interface{}); ok && len(vKinesisStreamsInput) > 0 && vKinesisStreamsInput[0] != nil {
+		mKinesisStreamsInput := vKinesisStreamsInput[0].(map[string]interface{})
+
+		input.KinesisStreamsInput = &kinesisanalyticsv2.KinesisStreamsInput{
+			ResourceARN: aws.String(mKinesisStreamsInput["resource_arn"].(string)),
+		}
+	}
+
+	if vNamePrefix, ok := mInput["name_prefix"].(string); ok && vNamePrefix != "" {
+		input.NamePrefix = aws.String(vNamePrefix)
+	}
+
+	return input
+}
+
+func expandKinesisAnalyticsV2InputProcessingConfiguration(vInputProcessingConfiguration []interface{}) *kinesisanalyticsv2.InputProcessingConfiguration {
+	if len(vInputProcessingConfiguration) == 0 || vInputProcessingConfiguration[0] == nil {
+		return nil
+	}
+
+	inputProcessingConfiguration := &kinesisanalyticsv2.InputProcessingConfiguration{}
+
+	mInputProcessingConfiguration := vInputProcessingConfiguration[0].(map[string]interface{})
+
+	if vInputLambdaProcessor, ok := mInputProcessingConfiguration["input_lambda_processor"].([]interface{}); ok && len(vInputLambdaProcessor) > 0 && vInputLambdaProcessor[0] != nil {
+		mInputLambdaProcessor := vInputLambdaProcessor[0].(map[string]interface{})
+
+		inputProcessingConfiguration.InputLambdaProcessor = &kinesisanalyticsv2.InputLambdaProcessor{
+			ResourceARN: aws.String(mInputLambdaProcessor["resource_arn"].(string)),
+		}
+	}
+
+	return inputProcessingConfiguration
+}
+
+func expandKinesisAnalyticsV2Output(mOutput map[string]interface{}) *kinesisanalyticsv2.Output {
+	output := &kinesisanalyticsv2.Output{}
+
+	if vDestinationSchema, ok := mOutput["destination_schema"].([]interface{}); ok && len(vDestinationSchema) > 0 && vDestinationSchema[0] != nil {
+		mDestinationSchema := vDestinationSchema[0].(map[string]interface{})
+
+		output.DestinationSchema = &kinesisanalyticsv2.DestinationSchema{
+			RecordFormatType: aws.String(mDestinationSchema["record_format_type"].(string)),
+		}
+	}
+
+	if vKinesisFirehoseOutput, ok := mOutput["kinesis_firehose_output"].([]interface{}); ok && len(vKinesisFirehoseOutput) > 0 && vKinesisFirehoseOutput[0] != nil {
+		mKinesisFirehoseOutput := vKinesisFirehoseOutput[0].(map[string]interface{})
+
+		output.KinesisFirehoseOutput = &kinesisanalyticsv2.KinesisFirehoseOutput{
+			ResourceARN: aws.String(mKinesisFirehoseOutput["resource_arn"].(string)),
+		}
+	}
+
+	if vKinesisStreamsOutput, ok := mOutput["kinesis_streams_output"].([]interface{}); ok && len(vKinesisStreamsOutput) > 0 && vKinesisStreamsOutput[0] != nil {
+		mKinesisStreamsOutput := vKinesisStreamsOutput[0].(map[string]interface{})
+
+		output.KinesisStreamsOutput = &kinesisanalyticsv2.KinesisStreamsOutput{
+			ResourceARN: aws.String(mKinesisStreamsOutput["resource_arn"].(string)),
+		}
+	}
+
+	if vLambdaOutput, ok := mOutput["lambda_output"].([]interface{}); ok && len(vLambdaOutput) > 0 && vLambdaOutput[0] != nil {
+		mLambdaOutput := vLambdaOutput[0].(map[string]interface{})
+
+		output.LambdaOutput = &kinesisanalyticsv2.LambdaOutput{
+			ResourceARN: aws.String(mLambdaOutput["resource_arn"].(string)),
+		}
+	}
+
+	if vName, ok := mOutput["name"].(string); ok && vName != "" {
+		output.Name = aws.String(vName)
+	}
+
+	return output
+}
+
+func expandKinesisAnalyticsV2ReferenceDataSource(vReferenceDataSource []interface{}) *kinesisanalyticsv2.ReferenceDataSource {
+	if len(vReferenceDataSource) == 0 || vReferenceDataSource[0] == nil {
+		return nil
+	}
+
+	referenceDataSource := &kinesisanalyticsv2.ReferenceDataSource{}
+
+	mReferenceDataSource := vReferenceDataSource[0].(map[string]interface{})
+
+	if vReferenceSchema, ok := mReferenceDataSource["reference_schema"].([]interface{}); ok {
+		referenceDataSource.ReferenceSchema = expandKinesisAnalyticsV2SourceSchema(vReferenceSchema)
+	}
+
+	if vS3ReferenceDataSource, ok := mReferenceDataSource["s3_reference_data_source"].([]interface{}); ok && len(vS3ReferenceDataSource) > 0 && vS3ReferenceDataSource[0] != nil {
+		mS3ReferenceDataSource := vS3ReferenceDataSource[0].(map[string]interface{})
+
+		referenceDataSource.S3ReferenceDataSource = &kinesisanalyticsv2.S3ReferenceDataSource{
+			BucketARN: aws.String(mS3ReferenceDataSource["bucket_arn"].(string)),
+			FileKey:   aws.String(mS3ReferenceDataSource["file_key"].(string)),
+		}
+	}
+
+	if vTableName, ok := mReferenceDataSource["table_name"].(string); ok && vTableName != "" {
+		referenceDataSource.TableName = aws.String(vTableName)
+	}
+
+	return referenceDataSource
+}
+
+func expandKinesisAnalyticsV2SourceSchema(vSourceSchema []interface{}) *kinesisanalyticsv2.SourceSchema {
+	if len(vSourceSchema) == 0 || vSourceSchema[0] == nil {
+		return nil
+	}
+
+	sourceSchema := &kinesisanalyticsv2.SourceSchema{}
+
+	mSourceSchema := vSourceSchema[0].(map[string]interface{})
+
+	if vRecordColumns, ok := mSourceSchema["record_column"].([]interface{}); ok && len(vRecordColumns) > 0 {
+		recordColumns := []*kinesisanalyticsv2.RecordColumn{}
+
+		for _, vRecordColumn := range vRecordColumns {
+			mRecordColumn, ok := vRecordColumn.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			recordColumn := &kinesisanalyticsv2.RecordColumn{
+				Name:    aws.String(mRecordColumn["name"].(string)),
+				SqlType: aws.String(mRecordColumn["sql_type"].(string)),
+			}
+
+			if vMapping, ok := mRecordColumn["mapping"].(string); ok && vMapping != "" {
+				recordColumn.Mapping = aws.String(vMapping)
+			}
+
+			recordColumns = append(recordColumns, recordColumn)
+		}
+
+		sourceSchema.RecordColumns = recordColumns
+	}
+
+	if vRecordEncoding, ok := mSourceSchema["record_encoding"].(string); ok && vRecordEncoding != "" {
+		sourceSchema.RecordEncoding = aws.String(vRecordEncoding)
+	}
+
+	if vRecordFormat, ok := mSourceSchema["record_format"].([]interface{}); ok && len(vRecordFormat) > 0 && vRecordFormat[0] != nil {
+		recordFormat := &kinesisanalyticsv2.RecordFormat{}
+
+		mRecordFormat := vRecordFormat[0].(map[string]interface{})
+
+		if vMappingParameters, ok := mRecordFormat["mapping_parameters"].([]interface{}); ok && len(vMappingParameters) > 0 && vMappingParameters[0] != nil {
+			mappingParameters := &kinesisanalyticsv2.MappingParameters{}
+
+			mMappingParameters := vMappingParameters[0].(map[string]interface{})
+
+			if vCsvMappingParameters, ok := mMappingParameters["csv_mapping_parameters"].([]interface{}); ok && len(vCsvMappingParameters) > 0 && vCsvMappingParameters[0] != nil {
+				mCsvMappingParameters := vCsvMappingParameters[0].(map[string]interface{})
+
+				mappingParameters.CSVMappingParameters = &kinesisanalyticsv2.CSVMappingParameters{
+					RecordColumnDelimiter: aws.String(mCsvMappingParameters["record_column_delimiter"].(string)),
+					RecordRowDelimiter:    aws.String(mCsvMappingParameters["record_row_delimiter"].(string)),
+				}
+			}
+
+			if vJsonMappingParameters, ok := mMappingParameters["json_mapping_parameters"].([]interface{}); ok && len(vJsonMappingParameters) > 0 && vJsonMappingParameters[0] != nil {
+				mJsonMappingParameters := vJsonMappingParameters[0].(map[string]interface{})
+
+				mappingParameters.JSONMappingParameters = &kinesisanalyticsv2.JSONMappingParameters{
+					RecordRowPath: aws.String(mJsonMappingParameters["record_row_path"].(string)),
+				}
+			}
+
+			recordFormat.MappingParameters = mappingParameters
+		}
+
+		if vRecordFormatType, ok := mRecordFormat["record_format_type"].(string); ok && vRecordFormatType != "" {
+			recordFormat.RecordFormatType = aws.String(vRecordFormatType)
+		}
+
+		sourceSchema.RecordFormat = recordFormat
+	}
+
+	return sourceSchema
+}
+
+func expandKinesisAnalyticsV2VpcConfiguration(vVpcConfiguration []interface{}) *kinesisanalyticsv2.VpcConfiguration {
+	if len(vVpcConfiguration) == 0 || vVpcConfiguration[0] == nil {
+		return nil
+	}
+
+	mVpcConfiguration := vVpcConfiguration[0].(map[string]interface{})
+
+	return &kinesisanalyticsv2.VpcConfiguration{
+		SecurityGroupIds: expandStringSet(mVpcConfiguration["security_group_ids"].(*schema.Set)),
+		SubnetIds:        expandStringSet(mVpcConfiguration["subnet_ids"].(*schema.Set)),
+	}
+}
+
+func flattenKinesisAnalyticsV2ApplicationConfigurationDescription(applicationConfigurationDescription *kinesisanalyticsv2.ApplicationConfigurationDescription, flinkRunConfiguration []interface{}) []interface{} {
+	if applicationConfigurationDescription == nil {
+		return []interface{}{}
+	}
+
+	mApplicationConfiguration := map[string]interface{}{}
+
+	if applicationCodeConfigurationDescription := applicationConfigurationDescription.ApplicationCodeConfigurationDescription; applicationCodeConfigurationDescription != nil {
+		mApplicationCodeConfiguration := map[string]interface{}{
+			"code_content_type": aws.StringValue(applicationCodeConfigurationDescription.CodeContentType),
+		}
+
+		if codeContentDescription := applicationCodeConfigurationDescription.CodeContentDescription; codeContentDescription != nil {
+			mCodeContent := map[string]interface{}{
+				"text_content": aws.StringValue(codeContentDescription.TextContent),
+			}
+
+			if s3ApplicationCodeLocationDescription := codeContentDescription.S3ApplicationCodeLocationDescription; s3ApplicationCodeLocationDescription != nil {
+				mS3ContentLocation := map[string]interface{}{
+					"bucket_arn":     aws.StringValue(s3ApplicationCodeLocationDescription.BucketARN),
+					"file_key":       aws.StringValue(s3ApplicationCodeLocationDescription.FileKey),
+					"object_version": aws.StringValue(s3ApplicationCodeLocationDescription.ObjectVersion),
+				}
+
+				mCodeContent["s3_content_location"] = []interface{}{mS3ContentLocation}
+			}
+
+			mApplicationCodeConfiguration["code_content"] = []interface{}{mCodeContent}
+		}
+
+		mApplicationConfiguration["application_code_configuration"] = []interface{}{mApplicationCodeConfiguration}
+	}
+
+	if applicationSnapshotConfigurationDescription := applicationConfigurationDescription.ApplicationSnapshotConfigurationDescription; applicationSnapshotConfigurationDescription != nil {
+		mApplicationSnapshotConfiguration := map[string]interface{}{
+			"snapshots_enabled": aws.BoolValue(applicationSnapshotConfigurationDescription.SnapshotsEnabled),
+		}
+
+		mApplicationConfiguration["application_snapshot_configuration"] = []interface{}{mApplicationSnapshotConfiguration}
+	}
+
+	if environmentPropertyDescriptions := applicationConfigurationDescription.EnvironmentPropertyDescriptions; environmentPropertyDescriptions != nil && len(environmentPropertyDescriptions.PropertyGroupDescriptions) > 0 {
+		vPropertyGroups := []interface{}{}
+
+		for _, propertyGroup := range environmentPropertyDescriptions.PropertyGroupDescriptions {
+			if propertyGroup != nil {
+				mPropertyGroup := map[string]interface{}{
+					"property_group_id": aws.StringValue(propertyGroup.PropertyGroupId),
+					"property_map":      pointersMapToStringList(propertyGroup.PropertyMap),
+				}
+
+				vPropertyGroups = append(vPropertyGroups, mPropertyGroup)
+			}
+		}
+
+		mEnvironmentProperties := map[string]interface{}{
+			"property_group": vPropertyGroups,
+		}
+
+		mApplicationConfiguration["environment_properties"] = []interface{}{mEnvironmentProperties}
+	}
+
+	if flinkApplicationConfigurationDescription := applicationConfigurationDescription.FlinkApplicationConfigurationDescription; flinkApplicationConfigurationDescription != nil {
+		mFlinkApplicationConfiguration := map[string]interface{}{}
+
+		if checkpointConfigurationDescription := flinkApplicationConfigurationDescription.CheckpointConfigurationDescription; checkpointConfigurationDescription != nil {
+			mCheckpointConfiguration := map[string]interface{}{
+				"checkpoint_interval":           int(aws.Int64Value(checkpointConfigurationDescription.CheckpointInterval)),
+				"checkpointing_enabled":         aws.BoolValue(checkpointConfigurationDescription.CheckpointingEnabled),
+				"configuration_type":            aws.StringValue(checkpointConfigurationDescription.ConfigurationType),
+				"min_pause_between_checkpoints": int(aws.Int64Value(checkpointConfigurationDescription.MinPauseBetweenCheckpoints)),
+			}
+
+			mFlinkApplicationConfiguration["checkpoint_configuration"] = []interface{}{mCheckpointConfiguration}
+		}
+
+		if monitoringConfigurationDescription := flinkApplicationConfigurationDescription.MonitoringConfigurationDescription; monitoringConfigurationDescription != nil {
+			mMonitoringConfiguration := map[string]interface{}{
+				"configuration_type": aws.StringValue(monitoringConfigurationDescription.ConfigurationType),
+				"log_level":          aws.StringValue(monitoringConfigurationDescription.LogLevel),
+				"metrics_level":      aws.StringValue(monitoringConfigurationDescription.MetricsLevel),
+			}
+
+			mFlinkApplicationConfiguration["monitoring_configuration"] = []interface{}{mMonitoringConfiguration}
+		}
+
+		if parallelismConfigurationDescription := flinkApplicationConfigurationDescription.ParallelismConfigurationDescription; parallelismConfigurationDescription != nil {
+			mParallelismConfiguration := map[string]interface{}{
+				"auto_scaling_enabled": aws.BoolValue(parallelismConfigurationDescription.AutoScalingEnabled),
+				"configuration_type":   aws.StringValue(parallelismConfigurationDescription.ConfigurationType),
+				"parallelism":          int(aws.Int64Value(parallelismConfigurationDescription.Parallelism)),
+				"parallelism_per_kpu":  int(aws.Int64Value(parallelismConfigurationDescription.ParallelismPerKPU)),
+			}
+
+			mFlinkApplicationConfiguration["parallelism_configuration"] = []interface{}{mParallelismConfiguration}
+		}
+
+		mApplicationConfiguration["flink_application_configuration"] = []interface{}{mFlinkApplicationConfiguration}
+	}
+
+	if runConfigurationDescription := applicationConfigurationDescription.RunConfigurationDescription; runConfigurationDescription != nil {
+		mRunConfiguration := map[string]interface{}{
+			"flink_run_configuration": flinkRunConfiguration,
+		}
+
+		if applicationRestoreConfigurationDescription := runConfigurationDescription.ApplicationRestoreConfigurationDescription; applicationRestoreConfigurationDescription != nil {
+			mApplicationRestoreConfiguration := map[string]interface{}{
+				"application_restore_type": aws.StringValue(applicationRestoreConfigurationDescription.ApplicationRestoreType),
+				"snapshot_name":            aws.StringValue(applicationRestoreConfigurationDescription.SnapshotName),
+			}
+
+			mRunConfiguration["application_restore_configuration"] = []interface{}{mApplicationRestoreConfiguration}
+		}
+
+		mApplicationConfiguration["run_configuration"] = []interface{}{mRunConfiguration}
+	}
+
+	if sqlApplicationConfigurationDescription := applicationConfigurationDescription.SqlApplicationConfigurationDescription; sqlApplicationConfigurationDescription != nil {
+		mSqlApplicationConfiguration := map[string]interface{}{}
+
+		if inputDescriptions := sqlApplicationConfigurationDescription.InputDescriptions; len(inputDescriptions) > 0 && inputDescriptions[0] != nil {
+			inputDescription := inputDescriptions[0]
+
+			mInput := map[string]interface{}{
+				"in_app_stream_names": flattenStringList(inputDescription.InAppStreamNames),
+				"input_id":            aws.StringValue(inputDescription.InputId),
+				"input_schema":        flattenKinesisAnalyticsV2SourceSchema(inputDescription.InputSchema),
+				"name_prefix":         aws.StringValue(inputDescription.NamePrefix),
+			}
+
+			if inputParallelism := inputDescription.InputParallelism; inputParallelism != nil {
+				mInputParallelism := map[string]interface{}{
+					"count": int(aws.Int64Value(inputParallelism.Count)),
+				}
+
+				mInput["input_parallelism"] = []interface{}{mInputParallelism}
+			}
+
+			if inputProcessingConfigurationDescription := inputDescription.InputProcessingConfigurationDescription; inputProcessingConfigurationDescription != nil && inputProcessingConfigurationDescription.InputLambdaProcessorDescription != nil {
+				mInputLambdaProcessor := map[string]interface{}{
+					"resource_arn": aws.StringValue(inputProcessingConfigurationDescription.InputLambdaProcessorDescription.ResourceARN),
+				}
+
+				mInputProcessingConfiguration := map[string]interface{}{
+					"input_lambda_processor": []interface{}{mInputLambdaProcessor},
+				}
+
+				mInput["input_processing_configuration"] = []interface{}{mInputProcessingConfiguration}
+			}
+
+			if inputStartingPositionConfiguration := inputDescription.InputStartingPositionConfiguration; inputStartingPositionConfiguration != nil {
+				mInputStartingPositionConfiguration := map[string]interface{}{
+					"input_starting_position": aws.StringValue(inputStartingPositionConfiguration.InputStartingPosition),
+				}
+
+				mInput["input_starting_position_configuration"] = []interface{}{mInputStartingPositionConfiguration}
+			}
+
+			if kinesisFirehoseInputDescription := inputDescription.KinesisFirehoseInputDescription; kinesisFirehoseInputDescription != nil {
+				mKinesisFirehoseInput := map[string]interface{}{
+					"resource_arn": aws.StringValue(kinesisFirehoseInputDescription.ResourceARN),
+				}
+
+				mInput["kinesis_firehose_input"] = []interface{}{mKinesisFirehoseInput}
+			}
+
+			if kinesisStreamsInputDescription := inputDescription.KinesisStreamsInputDescription; kinesisStreamsInputDescription != nil {
+				mKinesisStreamsInput := map[string]interface{}{
+					"resource_arn": aws.StringValue(kinesisStreamsInputDescription.ResourceARN),
+				}
+
+				mInput["kinesis_streams_input"] = []interface{}{mKinesisStreamsInput}
+			}
+
+			mSqlApplicationConfiguration["input"] = []interface{}{mInput}
+		}
+
+		if outputDescriptions := sqlApplicationConfigurationDescription.OutputDescriptions; len(outputDescriptions) > 0 {
+			vOutputs := []interface{}{}
+
+			for _, outputDescription := range outputDescriptions {
+				if outputDescription == nil {
+					continue
+				}
+
+				mOutput := map[string]interface{}{
+					"name":      aws.StringValue(outputDescription.Name),
+					"output_id": aws.StringValue(outputDescription.OutputId),
+				}
+
+				if destinationSchema := outputDescription.DestinationSchema; destinationSchema != nil {
+					mDestinationSchema := map[string]interface{}{
+						"record_format_type": aws.StringValue(destinationSchema.RecordFormatType),
+					}
+
+					mOutput["destination_schema"] = []interface{}{mDestinationSchema}
+				}
+
+				if kinesisFirehoseOutputDescription := outputDescription.KinesisFirehoseOutputDescription; kinesisFirehoseOutputDescription != nil {
+					mKinesisFirehoseOutput := map[string]interface{}{
+						"resource_arn": aws.StringValue(kinesisFirehoseOutputDescription.ResourceARN),
+					}
+
+					mOutput["kinesis_firehose_output"] = []interface{}{mKinesisFirehoseOutput}
+				}
+
+				if kinesisStreamsOutputDescription := outputDescription.KinesisStreamsOutputDescription; kinesisStreamsOutputDescription != nil {
+					mKinesisStreamsOutput := map[string]interface{}{
+						"resource_arn": aws.StringValue(kinesisStreamsOutputDescription.ResourceARN),
+					}
+
+					mOutput["kinesis_streams_output"] = []interface{}{mKinesisStreamsOutput}
+				}
+
+				if lambdaOutputDescription := outputDescription.LambdaOutputDescription; lambdaOutputDescription != nil {
+					mLambdaOutput := map[string]interface{}{
+						"resource_arn": aws.StringValue(lambdaOutputDescription.ResourceARN),
+					}
+
+					mOutput["lambda_output"] = []interface{}{mLambdaOutput}
+				}
+
+				vOutputs = append(vOutputs, mOutput)
+			}
+
+			mSqlApplicationConfiguration["output"] = vOutputs
+		}
+
+		if referenceDataSourceDescriptions := sqlApplicationConfigurationDescription.ReferenceDataSourceDescriptions; len(referenceDataSourceDescriptions) > 0 && referenceDataSourceDescriptions[0] != nil {
+			referenceDataSourceDescription := referenceDataSourceDescriptions[0]
+
+			mReferenceDataSource := map[string]interface{}{
+				"reference_id":     aws.StringValue(referenceDataSourceDescription.ReferenceId),
+				"reference_schema": flattenKinesisAnalyticsV2SourceSchema(referenceDataSourceDescription.ReferenceSchema),
+				"table_name":       aws.StringValue(referenceDataSourceDescription.TableName),
+			}
+
+			if s3ReferenceDataSourceDescription := referenceDataSourceDescription.S3ReferenceDataSourceDescription; s3ReferenceDataSourceDescription != nil {
+				mS3ReferenceDataSource := map[string]interface{}{
+					"bucket_arn": aws.StringValue(s3ReferenceDataSourceDescription.BucketARN),
+					"file_key":   aws.StringValue(s3ReferenceDataSourceDescription.FileKey),
+				}
+
+				mReferenceDataSource["s3_reference_data_source"] = []interface{}{mS3ReferenceDataSource}
+			}
+
+			mSqlApplicationConfiguration["reference_data_source"] = []interface{}{mReferenceDataSource}
+		}
+
+		mApplicationConfiguration["sql_application_configuration"] = []interface{}{mSqlApplicationConfiguration}
+	}
+
+	if vpcConfigurationDescriptions := applicationConfigurationDescription.VpcConfigurationDescriptions; len(vpcConfigurationDescriptions) > 0 && vpcConfigurationDescriptions[0] != nil {
+		vpcConfigurationDescription := vpcConfigurationDescriptions[0]
+
+		mVpcConfiguration := map[string]interface{}{
+			"security_group_ids":   flattenStringSet(vpcConfigurationDescription.SecurityGroupIds),
+			"subnet_ids":           flattenStringSet(vpcConfigurationDescription.SubnetIds),
+			"vpc_configuration_id": aws.StringValue(vpcConfigurationDescription.VpcConfigurationId),
+			"vpc_id":               aws.StringValue(vpcConfigurationDescription.VpcId),
+		}
+
+		mApplicationConfiguration["vpc_configuration"] = []interface{}{mVpcConfiguration}
+	}
+
+	return []interface{}{mApplicationConfiguration}
+}
+
+func flattenKinesisAnalyticsV2CloudWatchLoggingOptionDescriptions(cloudWatchLoggingOptionDescriptions []*kinesisanalyticsv2.CloudWatchLoggingOptionDescription) []interface{} {
+	if len(cloudWatchLoggingOptionDescriptions) == 0 || cloudWatchLoggingOptionDescriptions[0] == nil {
+		return []interface{}{}
+	}
+
+	cloudWatchLoggingOptionDescription := cloudWatchLoggingOptionDescriptions[0]
+
+	mCloudWatchLoggingOption := map[string]interface{}{
+		"cloudwatch_logging_option_id": aws.StringValue(cloudWatchLoggingOptionDescription.CloudWatchLoggingOptionId),
+		"log_stream_arn":               aws.StringValue(cloudWatchLoggingOptionDescription.LogStreamARN),
+	}
+
+	return []interface{}{mCloudWatchLoggingOption}
+}
+
+func flattenKinesisAnalyticsV2SourceSchema(sourceSchema *kinesisanalyticsv2.SourceSchema) []interface{} {
+	if sourceSchema == nil {
+		return []interface{}{}
+	}
+
+	mSourceSchema := map[string]interface{}{
+		"record_encoding": aws.StringValue(sourceSchema.RecordEncoding),
+	}
+
+	if len(sourceSchema.RecordColumns) > 0 {
+		vRecordColumns := []interface{}{}
+
+		for _, recordColumn := range sourceSchema.RecordColumns {
+			if recordColumn == nil {
+				continue
+			}
+
+			mRecordColumn := map[string]interface{}{
+				"mapping":  aws.StringValue(recordColumn.Mapping),
+				"name":     aws.StringValue(recordColumn.Name),
+				"sql_type": aws.StringValue(recordColumn.SqlType),
+			}
+
+			vRecordColumns = append(vRecordColumns, mRecordColumn)
+		}
+
+		mSourceSchema["record_column"] = vRecordColumns
+	}
+
+	if recordFormat := sourceSchema.RecordFormat; recordFormat != nil {
+		mRecordFormat := map[string]interface{}{
+			"record_format_type": aws.StringValue(recordFormat.RecordFormatType),
+		}
+
+		if mappingParameters := recordFormat.MappingParameters; mappingParameters != nil {
+			mMappingParameters := map[string]interface{}{}
+
+			if csvMappingParameters := mappingParameters.CSVMappingParameters; csvMappingParameters != nil {
+				mCsvMappingParameters := map[string]interface{}{
+					"record_column_delimiter": aws.StringValue(csvMappingParameters.RecordColumnDelimiter),
+					"record_row_delimiter":    aws.StringValue(csvMappingParameters.RecordRowDelimiter),
+				}
+
+				mMappingParameters["csv_mapping_parameters"] = []interface{}{mCsvMappingParameters}
+			}
+
+			if jsonMappingParameters := mappingParameters.JSONMappingParameters; jsonMappingParameters != nil {
+				mJsonMappingParameters := map[string]interface{}{
+					"record_row_path": aws.StringValue(jsonMappingParameters.RecordRowPath),
+				}
+
+				mMappingParameters["json_mapping_parameters"] = []interface{}{mJsonMappingParameters}
+			}
+
+			mRecordFormat["mapping_parameters"] = []interface{}{mMappingParameters}
+		}
+
+		mSourceSchema["record_format"] = []interface{}{mRecordFormat}
+	}
+
+	return []interface{}{mSourceSchema}
+}
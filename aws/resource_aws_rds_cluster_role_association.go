@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfrds "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/waiter"
+)
+
+func resourceAwsRDSClusterRoleAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRDSClusterRoleAssociationCreate,
+		Read:   resourceAwsRDSClusterRoleAssociationRead,
+		Delete: resourceAwsRDSClusterRoleAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"feature_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsRDSClusterRoleAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	dbClusterID := d.Get("db_cluster_identifier").(string)
+	roleARN := d.Get("role_arn").(string)
+
+	input := &rds.AddRoleToDBClusterInput{
+		DBClusterIdentifier: aws.String(dbClusterID),
+		FeatureName:         aws.String(d.Get("feature_name").(string)),
+		RoleArn:             aws.String(roleARN),
+	}
+
+	log.Printf("[DEBUG] Creating RDS DB Cluster (%s) IAM Role association: %s", dbClusterID, input)
+	_, err := conn.AddRoleToDBCluster(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating RDS DB Cluster (%s) IAM Role (%s) association: %w", dbClusterID, roleARN, err)
+	}
+
+	d.SetId(tfrds.ClusterRoleAssociationCreateID(dbClusterID, roleARN))
+
+	if _, err := waiter.DBClusterRoleAssociationCreated(conn, dbClusterID, roleARN); err != nil {
+		return fmt.Errorf("error waiting for RDS DB Cluster (%s) IAM Role (%s) association to create: %w", dbClusterID, roleARN, err)
+	}
+
+	return resourceAwsRDSClusterRoleAssociationRead(d, meta)
+}
+
+func resourceAwsRDSClusterRoleAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	dbClusterID, roleARN, err := tfrds.ClusterRoleAssociationParseID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing RDS DB Cluster IAM Role Association ID: %w", err)
+	}
+
+	output, err := finder.DBClusterRoleByDBClusterIDAndRoleARN(conn, dbClusterID, roleARN)
+
+	if !d.IsNewResource() && isAWSErr(err, rds.ErrCodeDBClusterNotFoundFault, "") {
+		log.Printf("[WARN] RDS DB Cluster (%s) not found, removing from state", dbClusterID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Cluster (%s) IAM Role (%s) association: %w", dbClusterID, roleARN, err)
+	}
+
+	if output == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading RDS DB Cluster (%s) IAM Role (%s) association: not found after creation", dbClusterID, roleARN)
+		}
+
+		log.Printf("[WARN] RDS DB Cluster (%s) IAM Role (%s) association not found, removing from state", dbClusterID, roleARN)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("db_cluster_identifier", dbClusterID)
+	d.Set("feature_name", output.FeatureName)
+	d.Set("role_arn", output.RoleArn)
+
+	return nil
+}
+
+func resourceAwsRDSClusterRoleAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	dbClusterID, roleARN, err := tfrds.ClusterRoleAssociationParseID(d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error parsing RDS DB Cluster IAM Role Association ID: %w", err)
+	}
+
+	input := &rds.RemoveRoleFromDBClusterInput{
+		DBClusterIdentifier: aws.String(dbClusterID),
+		FeatureName:         aws.String(d.Get("feature_name").(string)),
+		RoleArn:             aws.String(roleARN),
+	}
+
+	log.Printf("[DEBUG] Deleting RDS DB Cluster (%s) IAM Role association: %s", dbClusterID, input)
+	_, err = conn.RemoveRoleFromDBCluster(input)
+
+	if isAWSErr(err, rds.ErrCodeDBClusterNotFoundFault, "") {
+		return nil
+	}
+
+	if isAWSErr(err, rds.ErrCodeDBClusterRoleNotFoundFault, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting RDS DB Cluster (%s) IAM Role (%s) association: %w", dbClusterID, roleARN, err)
+	}
+
+	if _, err := waiter.DBClusterRoleAssociationDeleted(conn, dbClusterID, roleARN); err != nil {
+		if isAWSErr(err, rds.ErrCodeDBClusterNotFoundFault, "") {
+			return nil
+		}
+
+		return fmt.Errorf("error waiting for RDS DB Cluster (%s) IAM Role (%s) association to delete: %w", dbClusterID, roleARN, err)
+	}
+
+	return nil
+}
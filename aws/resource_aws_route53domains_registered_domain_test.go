@@ -0,0 +1,287 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/route53domains/finder"
+)
+
+func testAccAwsRoute53DomainsRegisteredDomainNameFromEnv(t *testing.T) string {
+	domainName := os.Getenv("ROUTE53DOMAINS_DOMAIN_NAME")
+
+	if domainName == "" {
+		t.Skip(
+			"Environment variable ROUTE53DOMAINS_DOMAIN_NAME is not set. " +
+				"This testing requires an existing domain registered with Route 53 Domains " +
+				"in the account under test. The domain's settings are restored at the end of each test.")
+	}
+
+	return domainName
+}
+
+func testAccPreCheckAWSRoute53Domains(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).route53domainsconn
+
+	input := &route53domains.ListDomainsInput{}
+
+	_, err := conn.ListDomains(input)
+
+	if testAccPreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func TestAccAwsRoute53DomainsRegisteredDomain_tags(t *testing.T) {
+	domainName := testAccAwsRoute53DomainsRegisteredDomainNameFromEnv(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Domains(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53DomainsRegisteredDomainConfigTags1(domainName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoute53DomainsRegisteredDomainConfigTags2(domainName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccRoute53DomainsRegisteredDomainConfigTags1(domainName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsRoute53DomainsRegisteredDomain_autoRenew(t *testing.T) {
+	domainName := testAccAwsRoute53DomainsRegisteredDomainNameFromEnv(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Domains(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53DomainsRegisteredDomainConfigAutoRenew(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "false"),
+				),
+			},
+			{
+				Config: testAccRoute53DomainsRegisteredDomainConfigAutoRenew(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsRoute53DomainsRegisteredDomain_contactPrivacy(t *testing.T) {
+	domainName := testAccAwsRoute53DomainsRegisteredDomainNameFromEnv(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Domains(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName, false, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "false"),
+				),
+			},
+			{
+				Config: testAccRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName, true, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "registrant_privacy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tech_privacy", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsRoute53DomainsRegisteredDomain_nameservers(t *testing.T) {
+	domainName := testAccAwsRoute53DomainsRegisteredDomainNameFromEnv(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Domains(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53DomainsRegisteredDomainConfigNameservers(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name_server.#", "4"),
+					resource.TestCheckResourceAttrPair(resourceName, "name_server.0.name", "aws_route53_zone.test", "name_servers.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "name_server.1.name", "aws_route53_zone.test", "name_servers.1"),
+					resource.TestCheckResourceAttrPair(resourceName, "name_server.2.name", "aws_route53_zone.test", "name_servers.2"),
+					resource.TestCheckResourceAttrPair(resourceName, "name_server.3.name", "aws_route53_zone.test", "name_servers.3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsRoute53DomainsRegisteredDomain_transferLock(t *testing.T) {
+	domainName := testAccAwsRoute53DomainsRegisteredDomainNameFromEnv(t)
+	resourceName := "aws_route53domains_registered_domain.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSRoute53Domains(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoute53DomainsRegisteredDomainConfigTransferLock(domainName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "false"),
+				),
+			},
+			{
+				Config: testAccRoute53DomainsRegisteredDomainConfigTransferLock(domainName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoute53DomainsRegisteredDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transfer_lock", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoute53DomainsRegisteredDomainExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Domains Domain ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).route53domainsconn
+
+		_, err := finder.DomainDetailByName(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccRoute53DomainsRegisteredDomainConfigTags1(domainName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, domainName, tagKey1, tagValue1)
+}
+
+func testAccRoute53DomainsRegisteredDomainConfigTags2(domainName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, domainName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccRoute53DomainsRegisteredDomainConfigAutoRenew(domainName string, autoRenew bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+  auto_renew  = %[2]t
+}
+`, domainName, autoRenew)
+}
+
+func testAccRoute53DomainsRegisteredDomainConfigContactPrivacy(domainName string, adminPrivacy, registrantPrivacy, techPrivacy bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  admin_privacy      = %[2]t
+  registrant_privacy = %[3]t
+  tech_privacy       = %[4]t
+}
+`, domainName, adminPrivacy, registrantPrivacy, techPrivacy)
+}
+
+func testAccRoute53DomainsRegisteredDomainConfigNameservers(domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name = %[1]q
+
+  dynamic "name_server" {
+    for_each = aws_route53_zone.test.name_servers
+
+    content {
+      name = name_server.value
+    }
+  }
+}
+`, domainName)
+}
+
+func testAccRoute53DomainsRegisteredDomainConfigTransferLock(domainName string, transferLock bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53domains_registered_domain" "test" {
+  domain_name   = %[1]q
+  transfer_lock = %[2]t
+}
+`, domainName, transferLock)
+}
@@ -0,0 +1,962 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/waiter"
+)
+
+func resourceAwsS3ControlJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3ControlJobCreate,
+		Read:   resourceAwsS3ControlJobRead,
+		Update: resourceAwsS3ControlJobUpdate,
+		Delete: resourceAwsS3ControlJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirm": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"confirmation_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"failure_reasons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(s3control.JobManifestFieldName_Values(), false),
+										},
+									},
+									"format": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.JobManifestFormat_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: s3ControlJobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"function_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+						"s3_initiate_restore_object": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: s3ControlJobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"glacier_job_tier": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3GlacierJobTier_Values(), false),
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: s3ControlJobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"canned_access_control_list": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3CannedAccessControlList_Values(), false),
+									},
+									"metadata_directive": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3MetadataDirective_Values(), false),
+									},
+									"new_object_tagging": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"requester_pays": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"sse_aws_kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3StorageClass_Values(), false),
+									},
+									"target_key_prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"target_resource": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+						"s3_put_object_tagging": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: s3ControlJobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_set": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"progress_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number_of_tasks_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_tasks_succeeded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_number_of_tasks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(s3control.JobReportFormat_Values(), false),
+						},
+						"prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+						"report_scope": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(s3control.JobReportScope_Values(), false),
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_update_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+			"termination_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+var s3ControlJobOperationKeys = []string{
+	"operation.0.lambda_invoke",
+	"operation.0.s3_initiate_restore_object",
+	"operation.0.s3_put_object_copy",
+	"operation.0.s3_put_object_tagging",
+}
+
+func resourceAwsS3ControlJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ConfirmationRequired: aws.Bool(d.Get("confirmation_required").(bool)),
+		Manifest:             expandS3ControlJobManifest(d.Get("manifest").([]interface{})),
+		Operation:            expandS3ControlJobOperation(d.Get("operation").([]interface{})),
+		Priority:             aws.Int64(int64(d.Get("priority").(int))),
+		Report:               expandS3ControlJobReport(d.Get("report").([]interface{})),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = s3ControlS3TagsFromKeyValueTags(keyvaluetags.New(v).IgnoreAws())
+	}
+
+	log.Printf("[DEBUG] Creating S3 Control Job: %s", input)
+	output, err := conn.CreateJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Control Job: %w", err)
+	}
+
+	jobID := aws.StringValue(output.JobId)
+	d.SetId(tfs3control.JobCreateID(accountID, jobID))
+
+	job, err := waiter.JobPrepared(conn, accountID, jobID)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for S3 Control Job (%s) to prepare: %w", d.Id(), err)
+	}
+
+	if aws.StringValue(job.Status) == s3control.JobStatusSuspended && d.Get("confirm").(bool) {
+		if err := s3ControlJobConfirm(conn, accountID, jobID); err != nil {
+			return err
+		}
+	}
+
+	if d.Get("wait_for_completion").(bool) && s3ControlJobWillRun(job, d.Get("confirm").(bool)) {
+		if _, err := waiter.JobCompleted(conn, accountID, jobID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("error waiting for S3 Control Job (%s) to complete: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsS3ControlJobRead(d, meta)
+}
+
+func resourceAwsS3ControlJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	accountID, jobID, err := tfs3control.JobParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	job, err := finder.JobByID(conn, accountID, jobID)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3control.ErrCodeNotFoundException) {
+		log.Printf("[WARN] S3 Control Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Control Job (%s): %w", d.Id(), err)
+	}
+
+	if job == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading S3 Control Job (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] S3 Control Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", job.JobArn)
+	d.Set("confirmation_required", job.ConfirmationRequired)
+	d.Set("description", job.Description)
+	d.Set("job_id", job.JobId)
+	d.Set("priority", job.Priority)
+	d.Set("role_arn", job.RoleArn)
+	d.Set("status", job.Status)
+	d.Set("status_update_reason", job.StatusUpdateReason)
+
+	if job.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(job.CreationTime).Format(time.RFC3339))
+	} else {
+		d.Set("creation_time", nil)
+	}
+
+	if job.TerminationDate != nil {
+		d.Set("termination_date", aws.TimeValue(job.TerminationDate).Format(time.RFC3339))
+	} else {
+		d.Set("termination_date", nil)
+	}
+
+	if err := d.Set("failure_reasons", flattenS3ControlJobFailures(job.FailureReasons)); err != nil {
+		return fmt.Errorf("error setting failure_reasons: %w", err)
+	}
+
+	if err := d.Set("manifest", flattenS3ControlJobManifest(job.Manifest)); err != nil {
+		return fmt.Errorf("error setting manifest: %w", err)
+	}
+
+	if err := d.Set("operation", flattenS3ControlJobOperation(job.Operation)); err != nil {
+		return fmt.Errorf("error setting operation: %w", err)
+	}
+
+	if err := d.Set("progress_summary", flattenS3ControlJobProgressSummary(job.ProgressSummary)); err != nil {
+		return fmt.Errorf("error setting progress_summary: %w", err)
+	}
+
+	if err := d.Set("report", flattenS3ControlJobReport(job.Report)); err != nil {
+		return fmt.Errorf("error setting report: %w", err)
+	}
+
+	tagsOutput, err := conn.GetJobTagging(&s3control.GetJobTaggingInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for S3 Control Job (%s): %w", d.Id(), err)
+	}
+
+	tags := keyValueTagsFromS3ControlS3Tags(tagsOutput.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3ControlJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID, jobID, err := tfs3control.JobParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("priority") {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Priority:  aws.Int64(int64(d.Get("priority").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating S3 Control Job priority: %s", input)
+		if _, err := conn.UpdateJobPriority(input); err != nil {
+			return fmt.Errorf("error updating S3 Control Job (%s) priority: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		tags := keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws()
+
+		if len(tags) == 0 {
+			_, err = conn.DeleteJobTagging(&s3control.DeleteJobTaggingInput{
+				AccountId: aws.String(accountID),
+				JobId:     aws.String(jobID),
+			})
+		} else {
+			_, err = conn.PutJobTagging(&s3control.PutJobTaggingInput{
+				AccountId: aws.String(accountID),
+				JobId:     aws.String(jobID),
+				Tags:      s3ControlS3TagsFromKeyValueTags(tags),
+			})
+		}
+
+		if err != nil {
+			return fmt.Errorf("error updating S3 Control Job (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("confirm") && d.Get("confirm").(bool) && d.Get("status").(string) == s3control.JobStatusSuspended {
+		if err := s3ControlJobConfirm(conn, accountID, jobID); err != nil {
+			return err
+		}
+
+		if d.Get("wait_for_completion").(bool) {
+			if _, err := waiter.JobCompleted(conn, accountID, jobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("error waiting for S3 Control Job (%s) to complete: %w", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceAwsS3ControlJobRead(d, meta)
+}
+
+func resourceAwsS3ControlJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID, jobID, err := tfs3control.JobParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	job, err := finder.JobByID(conn, accountID, jobID)
+
+	if tfawserr.ErrCodeEquals(err, s3control.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Control Job (%s): %w", d.Id(), err)
+	}
+
+	if job == nil {
+		return nil
+	}
+
+	// Jobs cannot be deleted, only cancelled. Finished jobs are removed by S3 after 90 days.
+	switch aws.StringValue(job.Status) {
+	case s3control.JobStatusCancelled, s3control.JobStatusComplete, s3control.JobStatusFailed:
+		log.Printf("[DEBUG] S3 Control Job (%s) is %s, removing from state only", d.Id(), aws.StringValue(job.Status))
+		return nil
+	}
+
+	input := &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: aws.String(s3control.RequestedJobStatusCancelled),
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Control Job: %s", input)
+	_, err = conn.UpdateJobStatus(input)
+
+	if tfawserr.ErrCodeEquals(err, s3control.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling S3 Control Job (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.JobCancelled(conn, accountID, jobID); err != nil {
+		return fmt.Errorf("error waiting for S3 Control Job (%s) to cancel: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func s3ControlJobConfirm(conn *s3control.S3Control, accountID, jobID string) error {
+	input := &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: aws.String(s3control.RequestedJobStatusReady),
+	}
+
+	log.Printf("[DEBUG] Confirming S3 Control Job: %s", input)
+	if _, err := conn.UpdateJobStatus(input); err != nil {
+		return fmt.Errorf("error confirming S3 Control Job (%s:%s): %w", accountID, jobID, err)
+	}
+
+	return nil
+}
+
+// s3ControlJobWillRun returns whether a prepared job will go on to run without further confirmation.
+func s3ControlJobWillRun(job *s3control.JobDescriptor, confirm bool) bool {
+	if job == nil {
+		return false
+	}
+
+	if aws.StringValue(job.Status) == s3control.JobStatusSuspended {
+		return confirm
+	}
+
+	return true
+}
+
+func s3ControlS3TagsFromKeyValueTags(tags keyvaluetags.KeyValueTags) []*s3control.S3Tag {
+	result := make([]*s3control.S3Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		result = append(result, &s3control.S3Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return result
+}
+
+func keyValueTagsFromS3ControlS3Tags(tags []*s3control.S3Tag) keyvaluetags.KeyValueTags {
+	m := make(map[string]string, len(tags))
+
+	for _, tag := range tags {
+		if tag == nil {
+			continue
+		}
+
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return keyvaluetags.New(m)
+}
+
+func expandS3ControlJobManifest(tfList []interface{}) *s3control.JobManifest {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &s3control.JobManifest{}
+
+	if v, ok := tfMap["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mLocation := v[0].(map[string]interface{})
+		location := &s3control.JobManifestLocation{
+			ETag:      aws.String(mLocation["etag"].(string)),
+			ObjectArn: aws.String(mLocation["object_arn"].(string)),
+		}
+
+		if v, ok := mLocation["object_version_id"].(string); ok && v != "" {
+			location.ObjectVersionId = aws.String(v)
+		}
+
+		apiObject.Location = location
+	}
+
+	if v, ok := tfMap["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mSpec := v[0].(map[string]interface{})
+		spec := &s3control.JobManifestSpec{
+			Format: aws.String(mSpec["format"].(string)),
+		}
+
+		if v, ok := mSpec["fields"].([]interface{}); ok && len(v) > 0 {
+			spec.Fields = expandStringList(v)
+		}
+
+		apiObject.Spec = spec
+	}
+
+	return apiObject
+}
+
+func expandS3ControlJobOperation(tfList []interface{}) *s3control.JobOperation {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &s3control.JobOperation{}
+
+	if v, ok := tfMap["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mOperation := v[0].(map[string]interface{})
+
+		apiObject.LambdaInvoke = &s3control.LambdaInvokeOperation{
+			FunctionArn: aws.String(mOperation["function_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mOperation := v[0].(map[string]interface{})
+		operation := &s3control.S3InitiateRestoreObjectOperation{}
+
+		if v, ok := mOperation["expiration_in_days"].(int); ok && v > 0 {
+			operation.ExpirationInDays = aws.Int64(int64(v))
+		}
+
+		if v, ok := mOperation["glacier_job_tier"].(string); ok && v != "" {
+			operation.GlacierJobTier = aws.String(v)
+		}
+
+		apiObject.S3InitiateRestoreObject = operation
+	}
+
+	if v, ok := tfMap["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mOperation := v[0].(map[string]interface{})
+		operation := &s3control.S3CopyObjectOperation{
+			TargetResource: aws.String(mOperation["target_resource"].(string)),
+		}
+
+		if v, ok := mOperation["canned_access_control_list"].(string); ok && v != "" {
+			operation.CannedAccessControlList = aws.String(v)
+		}
+
+		if v, ok := mOperation["metadata_directive"].(string); ok && v != "" {
+			operation.MetadataDirective = aws.String(v)
+		}
+
+		if v, ok := mOperation["new_object_tagging"].(map[string]interface{}); ok && len(v) > 0 {
+			operation.NewObjectTagging = s3ControlS3TagsFromKeyValueTags(keyvaluetags.New(v))
+		}
+
+		if v, ok := mOperation["requester_pays"].(bool); ok && v {
+			operation.RequesterPays = aws.Bool(v)
+		}
+
+		if v, ok := mOperation["sse_aws_kms_key_id"].(string); ok && v != "" {
+			operation.SSEAwsKmsKeyId = aws.String(v)
+		}
+
+		if v, ok := mOperation["storage_class"].(string); ok && v != "" {
+			operation.StorageClass = aws.String(v)
+		}
+
+		if v, ok := mOperation["target_key_prefix"].(string); ok && v != "" {
+			operation.TargetKeyPrefix = aws.String(v)
+		}
+
+		apiObject.S3PutObjectCopy = operation
+	}
+
+	if v, ok := tfMap["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mOperation := v[0].(map[string]interface{})
+
+		apiObject.S3PutObjectTagging = &s3control.S3SetObjectTaggingOperation{
+			TagSet: s3ControlS3TagsFromKeyValueTags(keyvaluetags.New(mOperation["tag_set"].(map[string]interface{}))),
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3ControlJobReport(tfList []interface{}) *s3control.JobReport {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &s3control.JobReport{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["report_scope"].(string); ok && v != "" {
+		apiObject.ReportScope = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenS3ControlJobFailures(apiObjects []*s3control.JobFailure) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"failure_code":   aws.StringValue(apiObject.FailureCode),
+			"failure_reason": aws.StringValue(apiObject.FailureReason),
+		})
+	}
+
+	return tfList
+}
+
+func flattenS3ControlJobManifest(apiObject *s3control.JobManifest) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Location; v != nil {
+		tfMap["location"] = []interface{}{
+			map[string]interface{}{
+				"etag":              aws.StringValue(v.ETag),
+				"object_arn":        aws.StringValue(v.ObjectArn),
+				"object_version_id": aws.StringValue(v.ObjectVersionId),
+			},
+		}
+	}
+
+	if v := apiObject.Spec; v != nil {
+		tfMap["spec"] = []interface{}{
+			map[string]interface{}{
+				"fields": aws.StringValueSlice(v.Fields),
+				"format": aws.StringValue(v.Format),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3ControlJobOperation(apiObject *s3control.JobOperation) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LambdaInvoke; v != nil {
+		tfMap["lambda_invoke"] = []interface{}{
+			map[string]interface{}{
+				"function_arn": aws.StringValue(v.FunctionArn),
+			},
+		}
+	}
+
+	if v := apiObject.S3InitiateRestoreObject; v != nil {
+		tfMap["s3_initiate_restore_object"] = []interface{}{
+			map[string]interface{}{
+				"expiration_in_days": aws.Int64Value(v.ExpirationInDays),
+				"glacier_job_tier":   aws.StringValue(v.GlacierJobTier),
+			},
+		}
+	}
+
+	if v := apiObject.S3PutObjectCopy; v != nil {
+		tfMap["s3_put_object_copy"] = []interface{}{
+			map[string]interface{}{
+				"canned_access_control_list": aws.StringValue(v.CannedAccessControlList),
+				"metadata_directive":         aws.StringValue(v.MetadataDirective),
+				"new_object_tagging":         keyValueTagsFromS3ControlS3Tags(v.NewObjectTagging).Map(),
+				"requester_pays":             aws.BoolValue(v.RequesterPays),
+				"sse_aws_kms_key_id":         aws.StringValue(v.SSEAwsKmsKeyId),
+				"storage_class":              aws.StringValue(v.StorageClass),
+				"target_key_prefix":          aws.StringValue(v.TargetKeyPrefix),
+				"target_resource":            aws.StringValue(v.TargetResource),
+			},
+		}
+	}
+
+	if v := apiObject.S3PutObjectTagging; v != nil {
+		tfMap["s3_put_object_tagging"] = []interface{}{
+			map[string]interface{}{
+				"tag_set": keyValueTagsFromS3ControlS3Tags(v.TagSet).Map(),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenS3ControlJobProgressSummary(apiObject *s3control.JobProgressSummary) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"number_of_tasks_failed":    aws.Int64Value(apiObject.NumberOfTasksFailed),
+			"number_of_tasks_succeeded": aws.Int64Value(apiObject.NumberOfTasksSucceeded),
+			"total_number_of_tasks":     aws.Int64Value(apiObject.TotalNumberOfTasks),
+		},
+	}
+}
+
+func flattenS3ControlJobReport(apiObject *s3control.JobReport) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"bucket":       aws.StringValue(apiObject.Bucket),
+			"enabled":      aws.BoolValue(apiObject.Enabled),
+			"format":       aws.StringValue(apiObject.Format),
+			"prefix":       aws.StringValue(apiObject.Prefix),
+			"report_scope": aws.StringValue(apiObject.ReportScope),
+		},
+	}
+}
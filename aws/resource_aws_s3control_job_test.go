@@ -0,0 +1,440 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfs3control "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/s3control/finder"
+)
+
+func TestAccAWSS3ControlJob_basic(t *testing.T) {
+	var v s3control.JobDescriptor
+	resourceName := "aws_s3control_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlJobConfigPutObjectTagging(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &v),
+					testAccCheckResourceAttrAccountID(resourceName, "account_id"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "s3", regexp.MustCompile(`job/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.0.format", s3control.JobManifestFormatS3batchOperationsCsv20180820),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.0.fields.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.Processed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.total_number_of_tasks", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_succeeded", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusComplete),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirm", "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlJob_ConfirmationRequired(t *testing.T) {
+	var v s3control.JobDescriptor
+	resourceName := "aws_s3control_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlJobConfigConfirmationRequired(rName, false, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusSuspended),
+				),
+			},
+			{
+				Config: testAccAWSS3ControlJobConfigConfirmationRequired(rName, false, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "priority", "20"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusSuspended),
+				),
+			},
+			{
+				Config: testAccAWSS3ControlJobConfigConfirmationRequired(rName, true, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusComplete),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_succeeded", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlJob_Tags(t *testing.T) {
+	var v s3control.JobDescriptor
+	resourceName := "aws_s3control_job.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlJobConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirm", "wait_for_completion"},
+			},
+			{
+				Config: testAccAWSS3ControlJobConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSS3ControlJobConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3ControlJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_job" {
+			continue
+		}
+
+		accountID, jobID, err := tfs3control.JobParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		job, err := finder.JobByID(conn, accountID, jobID)
+
+		if tfawserr.ErrCodeEquals(err, s3control.ErrCodeNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if job == nil {
+			continue
+		}
+
+		// Jobs cannot be deleted, so ensure the job is no longer running.
+		switch status := aws.StringValue(job.Status); status {
+		case s3control.JobStatusCancelled, s3control.JobStatusComplete, s3control.JobStatusFailed:
+			continue
+		default:
+			return fmt.Errorf("S3 Control Job (%s) still %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3ControlJobExists(n string, v *s3control.JobDescriptor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Control Job ID is set")
+		}
+
+		accountID, jobID, err := tfs3control.JobParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+		job, err := finder.JobByID(conn, accountID, jobID)
+
+		if err != nil {
+			return err
+		}
+
+		if job == nil {
+			return fmt.Errorf("S3 Control Job (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *job
+
+		return nil
+	}
+}
+
+func testAccAWSS3ControlJobConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "target" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "target.txt"
+  content = "test"
+}
+
+resource "aws_s3_bucket_object" "manifest" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "manifest.csv"
+  content = "${aws_s3_bucket.test.bucket},${aws_s3_bucket_object.target.key}"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "batchoperations.s3.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:PutObjectTagging",
+        "s3:PutObjectVersionTagging"
+      ],
+      "Resource": "${aws_s3_bucket.test.arn}/*"
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSS3ControlJobConfigPutObjectTagging(rName string) string {
+	return composeConfig(testAccAWSS3ControlJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  description = %[1]q
+  priority    = 10
+  role_arn    = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_bucket_object.manifest.etag
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_bucket_object.manifest.key}"
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Processed = "true"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccAWSS3ControlJobConfigConfirmationRequired(rName string, confirm bool, priority int) string {
+	return composeConfig(testAccAWSS3ControlJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  confirm               = %[1]t
+  confirmation_required = true
+  priority              = %[2]d
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_bucket_object.manifest.etag
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_bucket_object.manifest.key}"
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Processed = "true"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, confirm, priority))
+}
+
+func testAccAWSS3ControlJobConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSS3ControlJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  priority = 10
+  role_arn = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_bucket_object.manifest.etag
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_bucket_object.manifest.key}"
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Processed = "true"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  tags = {
+    %[1]q = %[2]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccAWSS3ControlJobConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSS3ControlJobConfigBase(rName), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  priority = 10
+  role_arn = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_bucket_object.manifest.etag
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_bucket_object.manifest.key}"
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Processed = "true"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
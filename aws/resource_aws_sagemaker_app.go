@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfsagemaker "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/waiter"
+)
+
+func resourceAwsSagemakerApp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerAppCreate,
+		Read:   resourceAwsSagemakerAppRead,
+		Update: resourceAwsSagemakerAppUpdate,
+		Delete: resourceAwsSagemakerAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerStudioName,
+			},
+			"app_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(sagemaker.AppType_Values(), false),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_spec": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(sagemaker.AppInstanceType_Values(), false),
+						},
+						"sagemaker_image_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+			"tags": tagsSchema(),
+			"user_profile_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSagemakerAppCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	domainID := d.Get("domain_id").(string)
+	userProfileName := d.Get("user_profile_name").(string)
+	appType := d.Get("app_type").(string)
+	appName := d.Get("app_name").(string)
+	input := &sagemaker.CreateAppInput{
+		AppName:         aws.String(appName),
+		AppType:         aws.String(appType),
+		DomainId:        aws.String(domainID),
+		ResourceSpec:    expandSagemakerResourceSpec(d.Get("resource_spec").([]interface{})),
+		UserProfileName: aws.String(userProfileName),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().SagemakerTags()
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker App: %s", input)
+	_, err := conn.CreateApp(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SageMaker App (%s): %w", appName, err)
+	}
+
+	d.SetId(tfsagemaker.AppCreateID(domainID, userProfileName, appType, appName))
+
+	if _, err := waiter.AppInService(conn, domainID, userProfileName, appType, appName); err != nil {
+		return fmt.Errorf("error waiting for SageMaker App (%s) to be in service: %w", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerAppRead(d, meta)
+}
+
+func resourceAwsSagemakerAppRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	domainID, userProfileName, appType, appName, err := tfsagemaker.AppParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	app, err := finder.AppByName(conn, domainID, userProfileName, appType, appName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		log.Printf("[WARN] SageMaker App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker App (%s): %w", d.Id(), err)
+	}
+
+	if app == nil || aws.StringValue(app.Status) == sagemaker.AppStatusDeleted {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading SageMaker App (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] SageMaker App (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(app.AppArn)
+	d.Set("app_name", app.AppName)
+	d.Set("app_type", app.AppType)
+	d.Set("arn", arn)
+	d.Set("domain_id", app.DomainId)
+	d.Set("user_profile_name", app.UserProfileName)
+
+	if err := d.Set("resource_spec", flattenSagemakerResourceSpec(app.ResourceSpec)); err != nil {
+		return fmt.Errorf("error setting resource_spec: %w", err)
+	}
+
+	tags, err := keyvaluetags.SagemakerListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker App (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerAppUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.SagemakerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SageMaker App (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSagemakerAppRead(d, meta)
+}
+
+func resourceAwsSagemakerAppDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	domainID, userProfileName, appType, appName, err := tfsagemaker.AppParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting SageMaker App: %s", d.Id())
+	_, err = conn.DeleteApp(&sagemaker.DeleteAppInput{
+		AppName:         aws.String(appName),
+		AppType:         aws.String(appType),
+		DomainId:        aws.String(domainID),
+		UserProfileName: aws.String(userProfileName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		return nil
+	}
+
+	// Deleting an app that has already been deleted returns a ValidationException.
+	if tfawserr.ErrMessageContains(err, "ValidationException", "has already been deleted") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SageMaker App (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.AppDeleted(conn, domainID, userProfileName, appType, appName); err != nil {
+		return fmt.Errorf("error waiting for SageMaker App (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,294 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfsagemaker "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_sagemaker_app", &resource.Sweeper{
+		Name: "aws_sagemaker_app",
+		F:    testSweepSagemakerApps,
+	})
+}
+
+func testSweepSagemakerApps(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).sagemakerconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListAppsPages(&sagemaker.ListAppsInput{}, func(page *sagemaker.ListAppsOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, app := range page.Apps {
+			if app == nil {
+				continue
+			}
+
+			if aws.StringValue(app.Status) == sagemaker.AppStatusDeleted {
+				continue
+			}
+
+			if !strings.HasPrefix(aws.StringValue(app.UserProfileName), "tf-acc-test") {
+				continue
+			}
+
+			id := tfsagemaker.AppCreateID(aws.StringValue(app.DomainId), aws.StringValue(app.UserProfileName), aws.StringValue(app.AppType), aws.StringValue(app.AppName))
+
+			log.Printf("[INFO] Deleting SageMaker App: %s", id)
+			r := resourceAwsSagemakerApp()
+			d := r.Data(nil)
+			d.SetId(id)
+			err := r.Delete(d, client)
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting SageMaker App (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !isLast
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping SageMaker App sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing SageMaker Apps: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func testAccAWSSagemakerApp_basic(t *testing.T) {
+	var app sagemaker.DescribeAppOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerAppConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerAppExists(resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "app_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "app_type", "JupyterServer"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "sagemaker", regexp.MustCompile(`app/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_sagemaker_domain.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_profile_name", "aws_sagemaker_user_profile.test", "user_profile_name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSagemakerApp_tags(t *testing.T) {
+	var app sagemaker.DescribeAppOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerAppConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerAppExists(resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSagemakerAppConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerAppExists(resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerAppConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerAppExists(resourceName, &app),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSagemakerApp_disappears(t *testing.T) {
+	var app sagemaker.DescribeAppOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_app.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerAppConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerAppExists(resourceName, &app),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSagemakerApp(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerAppDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_app" {
+			continue
+		}
+
+		domainID, userProfileName, appType, appName, err := tfsagemaker.AppParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		app, err := finder.AppByName(conn, domainID, userProfileName, appType, appName)
+
+		if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if app != nil && aws.StringValue(app.Status) != sagemaker.AppStatusDeleted {
+			return fmt.Errorf("SageMaker App (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSagemakerAppExists(n string, v *sagemaker.DescribeAppOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker App ID is set")
+		}
+
+		domainID, userProfileName, appType, appName, err := tfsagemaker.AppParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+		app, err := finder.AppByName(conn, domainID, userProfileName, appType, appName)
+
+		if err != nil {
+			return err
+		}
+
+		if app == nil {
+			return fmt.Errorf("SageMaker App (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *app
+
+		return nil
+	}
+}
+
+func testAccAWSSagemakerAppConfigBase(rName string) string {
+	return testAccAWSSagemakerUserProfileConfigBasic(rName)
+}
+
+func testAccAWSSagemakerAppConfigBasic(rName string) string {
+	return composeConfig(testAccAWSSagemakerAppConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_app" "test" {
+  domain_id         = aws_sagemaker_domain.test.id
+  user_profile_name = aws_sagemaker_user_profile.test.user_profile_name
+  app_name          = %[1]q
+  app_type          = "JupyterServer"
+}
+`, rName))
+}
+
+func testAccAWSSagemakerAppConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSSagemakerAppConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_app" "test" {
+  domain_id         = aws_sagemaker_domain.test.id
+  user_profile_name = aws_sagemaker_user_profile.test.user_profile_name
+  app_name          = %[1]q
+  app_type          = "JupyterServer"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSSagemakerAppConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSSagemakerAppConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_app" "test" {
+  domain_id         = aws_sagemaker_domain.test.id
+  user_profile_name = aws_sagemaker_user_profile.test.user_profile_name
+  app_name          = %[1]q
+  app_type          = "JupyterServer"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
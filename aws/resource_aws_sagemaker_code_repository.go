@@ -0,0 +1,196 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+)
+
+func resourceAwsSagemakerCodeRepository() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerCodeRepositoryCreate,
+		Read:   resourceAwsSagemakerCodeRepositoryRead,
+		Update: resourceAwsSagemakerCodeRepositoryUpdate,
+		Delete: resourceAwsSagemakerCodeRepositoryDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"code_repository_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerStudioName,
+			},
+			"git_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branch": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"repository_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+						"secret_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsSagemakerCodeRepositoryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	name := d.Get("code_repository_name").(string)
+	input := &sagemaker.CreateCodeRepositoryInput{
+		CodeRepositoryName: aws.String(name),
+		GitConfig:          expandSagemakerCodeRepositoryGitConfig(d.Get("git_config").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Code Repository: %s", input)
+	_, err := conn.CreateCodeRepository(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SageMaker Code Repository (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSagemakerCodeRepositoryRead(d, meta)
+}
+
+func resourceAwsSagemakerCodeRepositoryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	codeRepository, err := finder.CodeRepositoryByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrMessageContains(err, "ValidationException", "Cannot find CodeRepository") {
+		log.Printf("[WARN] SageMaker Code Repository (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Code Repository (%s): %w", d.Id(), err)
+	}
+
+	if codeRepository == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading SageMaker Code Repository (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] SageMaker Code Repository (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", codeRepository.CodeRepositoryArn)
+	d.Set("code_repository_name", codeRepository.CodeRepositoryName)
+
+	if err := d.Set("git_config", flattenSagemakerCodeRepositoryGitConfig(codeRepository.GitConfig)); err != nil {
+		return fmt.Errorf("error setting git_config: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerCodeRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if d.HasChange("git_config.0.secret_arn") {
+		input := &sagemaker.UpdateCodeRepositoryInput{
+			CodeRepositoryName: aws.String(d.Id()),
+			GitConfig: &sagemaker.GitConfigForUpdate{
+				SecretArn: aws.String(d.Get("git_config.0.secret_arn").(string)),
+			},
+		}
+
+		log.Printf("[DEBUG] Updating SageMaker Code Repository: %s", input)
+		if _, err := conn.UpdateCodeRepository(input); err != nil {
+			return fmt.Errorf("error updating SageMaker Code Repository (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSagemakerCodeRepositoryRead(d, meta)
+}
+
+func resourceAwsSagemakerCodeRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[DEBUG] Deleting SageMaker Code Repository: %s", d.Id())
+	_, err := conn.DeleteCodeRepository(&sagemaker.DeleteCodeRepositoryInput{
+		CodeRepositoryName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrMessageContains(err, "ValidationException", "Cannot find CodeRepository") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SageMaker Code Repository (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSagemakerCodeRepositoryGitConfig(tfList []interface{}) *sagemaker.GitConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &sagemaker.GitConfig{
+		RepositoryUrl: aws.String(tfMap["repository_url"].(string)),
+	}
+
+	if v, ok := tfMap["branch"].(string); ok && v != "" {
+		apiObject.Branch = aws.String(v)
+	}
+
+	if v, ok := tfMap["secret_arn"].(string); ok && v != "" {
+		apiObject.SecretArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSagemakerCodeRepositoryGitConfig(apiObject *sagemaker.GitConfig) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"branch":         aws.StringValue(apiObject.Branch),
+			"repository_url": aws.StringValue(apiObject.RepositoryUrl),
+			"secret_arn":     aws.StringValue(apiObject.SecretArn),
+		},
+	}
+}
@@ -0,0 +1,306 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_sagemaker_code_repository", &resource.Sweeper{
+		Name: "aws_sagemaker_code_repository",
+		F:    testSweepSagemakerCodeRepositories,
+	})
+}
+
+func testSweepSagemakerCodeRepositories(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).sagemakerconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListCodeRepositoriesPages(&sagemaker.ListCodeRepositoriesInput{}, func(page *sagemaker.ListCodeRepositoriesOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, codeRepository := range page.CodeRepositorySummaryList {
+			if codeRepository == nil {
+				continue
+			}
+
+			name := aws.StringValue(codeRepository.CodeRepositoryName)
+
+			if !strings.HasPrefix(name, "tf-acc-test") {
+				continue
+			}
+
+			log.Printf("[INFO] Deleting SageMaker Code Repository: %s", name)
+			r := resourceAwsSagemakerCodeRepository()
+			d := r.Data(nil)
+			d.SetId(name)
+			err := r.Delete(d, client)
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting SageMaker Code Repository (%s): %w", name, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !isLast
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping SageMaker Code Repository sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing SageMaker Code Repositories: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSSagemakerCodeRepository_basic(t *testing.T) {
+	var codeRepository sagemaker.DescribeCodeRepositoryOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_code_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerCodeRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerCodeRepositoryConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerCodeRepositoryExists(resourceName, &codeRepository),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "sagemaker", regexp.MustCompile(`code-repository/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "code_repository_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "git_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "git_config.0.branch", ""),
+					resource.TestCheckResourceAttr(resourceName, "git_config.0.repository_url", "https://github.com/hashicorp/terraform-provider-aws.git"),
+					resource.TestCheckResourceAttr(resourceName, "git_config.0.secret_arn", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerCodeRepository_GitConfig_Branch(t *testing.T) {
+	var codeRepository sagemaker.DescribeCodeRepositoryOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_code_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerCodeRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerCodeRepositoryConfigGitConfigBranch(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerCodeRepositoryExists(resourceName, &codeRepository),
+					resource.TestCheckResourceAttr(resourceName, "git_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "git_config.0.branch", "master"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerCodeRepository_GitConfig_SecretArn(t *testing.T) {
+	var codeRepository sagemaker.DescribeCodeRepositoryOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_code_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerCodeRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerCodeRepositoryConfigGitConfigSecretArn(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerCodeRepositoryExists(resourceName, &codeRepository),
+					resource.TestCheckResourceAttrPair(resourceName, "git_config.0.secret_arn", "aws_secretsmanager_secret.test1", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSagemakerCodeRepositoryConfigGitConfigSecretArn(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerCodeRepositoryExists(resourceName, &codeRepository),
+					resource.TestCheckResourceAttrPair(resourceName, "git_config.0.secret_arn", "aws_secretsmanager_secret.test2", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerCodeRepository_disappears(t *testing.T) {
+	var codeRepository sagemaker.DescribeCodeRepositoryOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_code_repository.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerCodeRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerCodeRepositoryConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerCodeRepositoryExists(resourceName, &codeRepository),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSagemakerCodeRepository(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerCodeRepositoryDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_code_repository" {
+			continue
+		}
+
+		codeRepository, err := finder.CodeRepositoryByName(conn, rs.Primary.ID)
+
+		if tfawserr.ErrMessageContains(err, "ValidationException", "Cannot find CodeRepository") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if codeRepository != nil {
+			return fmt.Errorf("SageMaker Code Repository (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSagemakerCodeRepositoryExists(n string, v *sagemaker.DescribeCodeRepositoryOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Code Repository ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+		codeRepository, err := finder.CodeRepositoryByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if codeRepository == nil {
+			return fmt.Errorf("SageMaker Code Repository (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *codeRepository
+
+		return nil
+	}
+}
+
+func testAccAWSSagemakerCodeRepositoryConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_code_repository" "test" {
+  code_repository_name = %[1]q
+
+  git_config {
+    repository_url = "https://github.com/hashicorp/terraform-provider-aws.git"
+  }
+}
+`, rName)
+}
+
+func testAccAWSSagemakerCodeRepositoryConfigGitConfigBranch(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_code_repository" "test" {
+  code_repository_name = %[1]q
+
+  git_config {
+    repository_url = "https://github.com/hashicorp/terraform-provider-aws.git"
+    branch         = "master"
+  }
+}
+`, rName)
+}
+
+func testAccAWSSagemakerCodeRepositoryConfigGitConfigSecretArn(rName, secretResourceName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test1" {
+  name                    = "%[1]s-1"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test1" {
+  secret_id     = aws_secretsmanager_secret.test1.id
+  secret_string = jsonencode({ username = "example", password = "example" })
+}
+
+resource "aws_secretsmanager_secret" "test2" {
+  name                    = "%[1]s-2"
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_version" "test2" {
+  secret_id     = aws_secretsmanager_secret.test2.id
+  secret_string = jsonencode({ username = "example", password = "example" })
+}
+
+resource "aws_sagemaker_code_repository" "test" {
+  code_repository_name = %[1]q
+
+  git_config {
+    repository_url = "https://github.com/hashicorp/terraform-provider-aws.git"
+    secret_arn     = aws_secretsmanager_secret.%[2]s.arn
+  }
+
+  depends_on = [aws_secretsmanager_secret_version.test1, aws_secretsmanager_secret_version.test2]
+}
+`, rName, secretResourceName)
+}
@@ -0,0 +1,518 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/waiter"
+)
+
+func resourceAwsSagemakerDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerDomainCreate,
+		Read:   resourceAwsSagemakerDomainRead,
+		Update: resourceAwsSagemakerDomainUpdate,
+		Delete: resourceAwsSagemakerDomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(sagemaker.AuthMode_Values(), false),
+			},
+			"default_user_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: sagemakerUserSettingsSchema(),
+				},
+			},
+			"domain_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerStudioName,
+			},
+			"home_efs_file_system_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"home_efs_file_system": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      sagemaker.RetentionTypeRetain,
+							ValidateFunc: validation.StringInSlice(sagemaker.RetentionType_Values(), false),
+						},
+					},
+				},
+			},
+			"single_sign_on_managed_application_instance_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 16,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tagsSchema(),
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSagemakerDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	input := &sagemaker.CreateDomainInput{
+		AuthMode:            aws.String(d.Get("auth_mode").(string)),
+		DefaultUserSettings: expandSagemakerUserSettings(d.Get("default_user_settings").([]interface{})),
+		DomainName:          aws.String(d.Get("domain_name").(string)),
+		SubnetIds:           expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		VpcId:               aws.String(d.Get("vpc_id").(string)),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.HomeEfsFileSystemKmsKeyId = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().SagemakerTags()
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Domain: %s", input)
+	output, err := conn.CreateDomain(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SageMaker Domain: %w", err)
+	}
+
+	domainID, err := sagemakerDomainIDFromArn(aws.StringValue(output.DomainArn))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(domainID)
+
+	if _, err := waiter.DomainInService(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for SageMaker Domain (%s) to be in service: %w", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerDomainRead(d, meta)
+}
+
+func resourceAwsSagemakerDomainRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	domain, err := finder.DomainByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		log.Printf("[WARN] SageMaker Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Domain (%s): %w", d.Id(), err)
+	}
+
+	if domain == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading SageMaker Domain (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] SageMaker Domain (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(domain.DomainArn)
+	d.Set("arn", arn)
+	d.Set("auth_mode", domain.AuthMode)
+	d.Set("domain_name", domain.DomainName)
+	d.Set("home_efs_file_system_id", domain.HomeEfsFileSystemId)
+	d.Set("kms_key_id", domain.HomeEfsFileSystemKmsKeyId)
+	d.Set("single_sign_on_managed_application_instance_id", domain.SingleSignOnManagedApplicationInstanceId)
+	d.Set("url", domain.Url)
+	d.Set("vpc_id", domain.VpcId)
+
+	if err := d.Set("default_user_settings", flattenSagemakerUserSettings(domain.DefaultUserSettings)); err != nil {
+		return fmt.Errorf("error setting default_user_settings: %w", err)
+	}
+
+	if err := d.Set("subnet_ids", flattenStringSet(domain.SubnetIds)); err != nil {
+		return fmt.Errorf("error setting subnet_ids: %w", err)
+	}
+
+	tags, err := keyvaluetags.SagemakerListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker Domain (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if d.HasChange("default_user_settings") {
+		input := &sagemaker.UpdateDomainInput{
+			DefaultUserSettings: expandSagemakerUserSettings(d.Get("default_user_settings").([]interface{})),
+			DomainId:            aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating SageMaker Domain: %s", input)
+		if _, err := conn.UpdateDomain(input); err != nil {
+			return fmt.Errorf("error updating SageMaker Domain (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.DomainInService(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for SageMaker Domain (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.SagemakerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SageMaker Domain (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSagemakerDomainRead(d, meta)
+}
+
+func resourceAwsSagemakerDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	input := &sagemaker.DeleteDomainInput{
+		DomainId: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("retention_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		input.RetentionPolicy = &sagemaker.RetentionPolicy{
+			HomeEfsFileSystem: aws.String(tfMap["home_efs_file_system"].(string)),
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting SageMaker Domain: %s", d.Id())
+	_, err := conn.DeleteDomain(input)
+
+	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SageMaker Domain (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.DomainDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for SageMaker Domain (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// sagemakerDomainIDFromArn returns the domain ID from an ARN of the form
+// arn:aws:sagemaker:region:account-id:domain/domain-id.
+func sagemakerDomainIDFromArn(domainArn string) (string, error) {
+	parsedArn, err := arn.Parse(domainArn)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing SageMaker Domain ARN (%s): %w", domainArn, err)
+	}
+
+	domainID := strings.TrimPrefix(parsedArn.Resource, "domain/")
+
+	if domainID == "" || domainID == parsedArn.Resource {
+		return "", fmt.Errorf("unexpected format for SageMaker Domain ARN (%s)", domainArn)
+	}
+
+	return domainID, nil
+}
+
+var validateSagemakerStudioName = validation.All(
+	validation.StringLenBetween(1, 63),
+	validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`), "must start with a letter or number and contain only letters, numbers and hyphens"),
+)
+
+func sagemakerUserSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"execution_role": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateArn,
+		},
+		"jupyter_server_app_settings": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"default_resource_spec": sagemakerResourceSpecSchema(),
+				},
+			},
+		},
+		"kernel_gateway_app_settings": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"default_resource_spec": sagemakerResourceSpecSchema(),
+				},
+			},
+		},
+		"security_groups": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			MaxItems: 5,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"sharing_settings": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"notebook_output_option": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      sagemaker.NotebookOutputOptionDisabled,
+						ValidateFunc: validation.StringInSlice(sagemaker.NotebookOutputOption_Values(), false),
+					},
+					"s3_kms_key_id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateArn,
+					},
+					"s3_output_path": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"tensor_board_app_settings": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"default_resource_spec": sagemakerResourceSpecSchema(),
+				},
+			},
+		},
+	}
+}
+
+func sagemakerResourceSpecSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"instance_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(sagemaker.AppInstanceType_Values(), false),
+				},
+				"sagemaker_image_arn": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateArn,
+				},
+			},
+		},
+	}
+}
+
+func expandSagemakerUserSettings(tfList []interface{}) *sagemaker.UserSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &sagemaker.UserSettings{}
+
+	if v, ok := tfMap["execution_role"].(string); ok && v != "" {
+		apiObject.ExecutionRole = aws.String(v)
+	}
+
+	if v, ok := tfMap["jupyter_server_app_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.JupyterServerAppSettings = &sagemaker.JupyterServerAppSettings{
+			DefaultResourceSpec: expandSagemakerResourceSpec(v[0].(map[string]interface{})["default_resource_spec"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["kernel_gateway_app_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KernelGatewayAppSettings = &sagemaker.KernelGatewayAppSettings{
+			DefaultResourceSpec: expandSagemakerResourceSpec(v[0].(map[string]interface{})["default_resource_spec"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["security_groups"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroups = expandStringSet(v)
+	}
+
+	if v, ok := tfMap["sharing_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		mSharing := v[0].(map[string]interface{})
+		sharing := &sagemaker.SharingSettings{}
+
+		if v, ok := mSharing["notebook_output_option"].(string); ok && v != "" {
+			sharing.NotebookOutputOption = aws.String(v)
+		}
+
+		if v, ok := mSharing["s3_kms_key_id"].(string); ok && v != "" {
+			sharing.S3KmsKeyId = aws.String(v)
+		}
+
+		if v, ok := mSharing["s3_output_path"].(string); ok && v != "" {
+			sharing.S3OutputPath = aws.String(v)
+		}
+
+		apiObject.SharingSettings = sharing
+	}
+
+	if v, ok := tfMap["tensor_board_app_settings"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.TensorBoardAppSettings = &sagemaker.TensorBoardAppSettings{
+			DefaultResourceSpec: expandSagemakerResourceSpec(v[0].(map[string]interface{})["default_resource_spec"].([]interface{})),
+		}
+	}
+
+	return apiObject
+}
+
+func expandSagemakerResourceSpec(tfList []interface{}) *sagemaker.ResourceSpec {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &sagemaker.ResourceSpec{}
+
+	if v, ok := tfMap["instance_type"].(string); ok && v != "" {
+		apiObject.InstanceType = aws.String(v)
+	}
+
+	if v, ok := tfMap["sagemaker_image_arn"].(string); ok && v != "" {
+		apiObject.SageMakerImageArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSagemakerUserSettings(apiObject *sagemaker.UserSettings) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"execution_role":  aws.StringValue(apiObject.ExecutionRole),
+		"security_groups": flattenStringSet(apiObject.SecurityGroups),
+	}
+
+	if v := apiObject.JupyterServerAppSettings; v != nil {
+		tfMap["jupyter_server_app_settings"] = []interface{}{
+			map[string]interface{}{
+				"default_resource_spec": flattenSagemakerResourceSpec(v.DefaultResourceSpec),
+			},
+		}
+	}
+
+	if v := apiObject.KernelGatewayAppSettings; v != nil {
+		tfMap["kernel_gateway_app_settings"] = []interface{}{
+			map[string]interface{}{
+				"default_resource_spec": flattenSagemakerResourceSpec(v.DefaultResourceSpec),
+			},
+		}
+	}
+
+	if v := apiObject.SharingSettings; v != nil {
+		tfMap["sharing_settings"] = []interface{}{
+			map[string]interface{}{
+				"notebook_output_option": aws.StringValue(v.NotebookOutputOption),
+				"s3_kms_key_id":          aws.StringValue(v.S3KmsKeyId),
+				"s3_output_path":         aws.StringValue(v.S3OutputPath),
+			},
+		}
+	}
+
+	if v := apiObject.TensorBoardAppSettings; v != nil {
+		tfMap["tensor_board_app_settings"] = []interface{}{
+			map[string]interface{}{
+				"default_resource_spec": flattenSagemakerResourceSpec(v.DefaultResourceSpec),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSagemakerResourceSpec(apiObject *sagemaker.ResourceSpec) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"instance_type":       aws.StringValue(apiObject.InstanceType),
+			"sagemaker_image_arn": aws.StringValue(apiObject.SageMakerImageArn),
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"testing"
+)
+
+// Studio domains are limited to one per account per region, so every test
+// that requires a domain must run serially.
+func TestAccAWSSagemaker_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"App": {
+			"basic":      testAccAWSSagemakerApp_basic,
+			"disappears": testAccAWSSagemakerApp_disappears,
+			"tags":       testAccAWSSagemakerApp_tags,
+		},
+		"Domain": {
+			"basic":               testAccAWSSagemakerDomain_basic,
+			"defaultUserSettings": testAccAWSSagemakerDomain_defaultUserSettings,
+			"disappears":          testAccAWSSagemakerDomain_disappears,
+			"kms":                 testAccAWSSagemakerDomain_kms,
+			"tags":                testAccAWSSagemakerDomain_tags,
+		},
+		"UserProfile": {
+			"basic":        testAccAWSSagemakerUserProfile_basic,
+			"disappears":   testAccAWSSagemakerUserProfile_disappears,
+			"tags":         testAccAWSSagemakerUserProfile_tags,
+			"userSettings": testAccAWSSagemakerUserProfile_userSettings,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
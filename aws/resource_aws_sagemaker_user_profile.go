@@ -0,0 +1,232 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfsagemaker "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/waiter"
+)
+
+func resourceAwsSagemakerUserProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerUserProfileCreate,
+		Read:   resourceAwsSagemakerUserProfileRead,
+		Update: resourceAwsSagemakerUserProfileUpdate,
+		Delete: resourceAwsSagemakerUserProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"home_efs_file_system_uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"single_sign_on_user_identifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"single_sign_on_user_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+			"user_profile_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerStudioName,
+			},
+			"user_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: sagemakerUserSettingsSchema(),
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsSagemakerUserProfileCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	domainID := d.Get("domain_id").(string)
+	userProfileName := d.Get("user_profile_name").(string)
+	input := &sagemaker.CreateUserProfileInput{
+		DomainId:        aws.String(domainID),
+		UserProfileName: aws.String(userProfileName),
+		UserSettings:    expandSagemakerUserSettings(d.Get("user_settings").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("single_sign_on_user_identifier"); ok {
+		input.SingleSignOnUserIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("single_sign_on_user_value"); ok {
+		input.SingleSignOnUserValue = aws.String(v.(string))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().SagemakerTags()
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker User Profile: %s", input)
+	_, err := conn.CreateUserProfile(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SageMaker User Profile (%s): %w", userProfileName, err)
+	}
+
+	d.SetId(tfsagemaker.UserProfileCreateID(domainID, userProfileName))
+
+	if _, err := waiter.UserProfileInService(conn, domainID, userProfileName); err != nil {
+		return fmt.Errorf("error waiting for SageMaker User Profile (%s) to be in service: %w", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerUserProfileRead(d, meta)
+}
+
+func resourceAwsSagemakerUserProfileRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	domainID, userProfileName, err := tfsagemaker.UserProfileParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	userProfile, err := finder.UserProfileByName(conn, domainID, userProfileName)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		log.Printf("[WARN] SageMaker User Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker User Profile (%s): %w", d.Id(), err)
+	}
+
+	if userProfile == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading SageMaker User Profile (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] SageMaker User Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	arn := aws.StringValue(userProfile.UserProfileArn)
+	d.Set("arn", arn)
+	d.Set("domain_id", userProfile.DomainId)
+	d.Set("home_efs_file_system_uid", userProfile.HomeEfsFileSystemUid)
+	d.Set("single_sign_on_user_identifier", userProfile.SingleSignOnUserIdentifier)
+	d.Set("single_sign_on_user_value", userProfile.SingleSignOnUserValue)
+	d.Set("user_profile_name", userProfile.UserProfileName)
+
+	if err := d.Set("user_settings", flattenSagemakerUserSettings(userProfile.UserSettings)); err != nil {
+		return fmt.Errorf("error setting user_settings: %w", err)
+	}
+
+	tags, err := keyvaluetags.SagemakerListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker User Profile (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerUserProfileUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	domainID, userProfileName, err := tfsagemaker.UserProfileParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("user_settings") {
+		input := &sagemaker.UpdateUserProfileInput{
+			DomainId:        aws.String(domainID),
+			UserProfileName: aws.String(userProfileName),
+			UserSettings:    expandSagemakerUserSettings(d.Get("user_settings").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Updating SageMaker User Profile: %s", input)
+		if _, err := conn.UpdateUserProfile(input); err != nil {
+			return fmt.Errorf("error updating SageMaker User Profile (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waiter.UserProfileInService(conn, domainID, userProfileName); err != nil {
+			return fmt.Errorf("error waiting for SageMaker User Profile (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.SagemakerUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SageMaker User Profile (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSagemakerUserProfileRead(d, meta)
+}
+
+func resourceAwsSagemakerUserProfileDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	domainID, userProfileName, err := tfsagemaker.UserProfileParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting SageMaker User Profile: %s", d.Id())
+	_, err = conn.DeleteUserProfile(&sagemaker.DeleteUserProfileInput{
+		DomainId:        aws.String(domainID),
+		UserProfileName: aws.String(userProfileName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SageMaker User Profile (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.UserProfileDeleted(conn, domainID, userProfileName); err != nil {
+		return fmt.Errorf("error waiting for SageMaker User Profile (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,339 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfsagemaker "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_sagemaker_user_profile", &resource.Sweeper{
+		Name: "aws_sagemaker_user_profile",
+		F:    testSweepSagemakerUserProfiles,
+		Dependencies: []string{
+			"aws_sagemaker_app",
+		},
+	})
+}
+
+func testSweepSagemakerUserProfiles(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).sagemakerconn
+	var sweeperErrs *multierror.Error
+
+	err = conn.ListUserProfilesPages(&sagemaker.ListUserProfilesInput{}, func(page *sagemaker.ListUserProfilesOutput, isLast bool) bool {
+		if page == nil {
+			return !isLast
+		}
+
+		for _, userProfile := range page.UserProfiles {
+			if userProfile == nil {
+				continue
+			}
+
+			if !strings.HasPrefix(aws.StringValue(userProfile.UserProfileName), "tf-acc-test") {
+				continue
+			}
+
+			id := tfsagemaker.UserProfileCreateID(aws.StringValue(userProfile.DomainId), aws.StringValue(userProfile.UserProfileName))
+
+			log.Printf("[INFO] Deleting SageMaker User Profile: %s", id)
+			r := resourceAwsSagemakerUserProfile()
+			d := r.Data(nil)
+			d.SetId(id)
+			err := r.Delete(d, client)
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error deleting SageMaker User Profile (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		return !isLast
+	})
+
+	if testSweepSkipSweepError(err) {
+		log.Printf("[WARN] Skipping SageMaker User Profile sweep for %s: %s", region, err)
+		return sweeperErrs.ErrorOrNil()
+	}
+
+	if err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing SageMaker User Profiles: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func testAccAWSSagemakerUserProfile_basic(t *testing.T) {
+	var userProfile sagemaker.DescribeUserProfileOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_user_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerUserProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerUserProfileConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerUserProfileExists(resourceName, &userProfile),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "sagemaker", regexp.MustCompile(`user-profile/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_id", "aws_sagemaker_domain.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "home_efs_file_system_uid"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_profile_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSagemakerUserProfile_tags(t *testing.T) {
+	var userProfile sagemaker.DescribeUserProfileOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_user_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerUserProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerUserProfileConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerUserProfileExists(resourceName, &userProfile),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSagemakerUserProfileConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerUserProfileExists(resourceName, &userProfile),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSSagemakerUserProfileConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerUserProfileExists(resourceName, &userProfile),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSagemakerUserProfile_userSettings(t *testing.T) {
+	var userProfile sagemaker.DescribeUserProfileOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_user_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerUserProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerUserProfileConfigUserSettings(rName, "ml.t3.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerUserProfileExists(resourceName, &userProfile),
+					resource.TestCheckResourceAttr(resourceName, "user_settings.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_settings.0.execution_role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "user_settings.0.tensor_board_app_settings.0.default_resource_spec.0.instance_type", "ml.t3.micro"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSagemakerUserProfileConfigUserSettings(rName, "ml.t3.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerUserProfileExists(resourceName, &userProfile),
+					resource.TestCheckResourceAttr(resourceName, "user_settings.0.tensor_board_app_settings.0.default_resource_spec.0.instance_type", "ml.t3.small"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSagemakerUserProfile_disappears(t *testing.T) {
+	var userProfile sagemaker.DescribeUserProfileOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_user_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerUserProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerUserProfileConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerUserProfileExists(resourceName, &userProfile),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsSagemakerUserProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerUserProfileDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_user_profile" {
+			continue
+		}
+
+		domainID, userProfileName, err := tfsagemaker.UserProfileParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		userProfile, err := finder.UserProfileByName(conn, domainID, userProfileName)
+
+		if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if userProfile != nil {
+			return fmt.Errorf("SageMaker User Profile (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSagemakerUserProfileExists(n string, v *sagemaker.DescribeUserProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker User Profile ID is set")
+		}
+
+		domainID, userProfileName, err := tfsagemaker.UserProfileParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+		userProfile, err := finder.UserProfileByName(conn, domainID, userProfileName)
+
+		if err != nil {
+			return err
+		}
+
+		if userProfile == nil {
+			return fmt.Errorf("SageMaker User Profile (%s) not found", rs.Primary.ID)
+		}
+
+		*v = *userProfile
+
+		return nil
+	}
+}
+
+func testAccAWSSagemakerUserProfileConfigBase(rName string) string {
+	return testAccAWSSagemakerDomainConfigBasic(rName)
+}
+
+func testAccAWSSagemakerUserProfileConfigBasic(rName string) string {
+	return composeConfig(testAccAWSSagemakerUserProfileConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_user_profile" "test" {
+  domain_id         = aws_sagemaker_domain.test.id
+  user_profile_name = %[1]q
+}
+`, rName))
+}
+
+func testAccAWSSagemakerUserProfileConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSSagemakerUserProfileConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_user_profile" "test" {
+  domain_id         = aws_sagemaker_domain.test.id
+  user_profile_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSSagemakerUserProfileConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(testAccAWSSagemakerUserProfileConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_user_profile" "test" {
+  domain_id         = aws_sagemaker_domain.test.id
+  user_profile_name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccAWSSagemakerUserProfileConfigUserSettings(rName, instanceType string) string {
+	return composeConfig(testAccAWSSagemakerUserProfileConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_user_profile" "test" {
+  domain_id         = aws_sagemaker_domain.test.id
+  user_profile_name = %[1]q
+
+  user_settings {
+    execution_role = aws_iam_role.test.arn
+
+    tensor_board_app_settings {
+      default_resource_spec {
+        instance_type = %[2]q
+      }
+    }
+  }
+}
+`, rName, instanceType))
+}
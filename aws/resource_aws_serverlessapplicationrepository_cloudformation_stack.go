@@ -0,0 +1,377 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	serverlessrepository "github.com/aws/aws-sdk-go/service/serverlessapplicationrepository"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	cfwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cloudformation/waiter"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/serverlessapplicationrepository/finder"
+)
+
+const (
+	serverlessApplicationRepositoryCloudFormationStackNamePrefix = "serverlessrepo-"
+
+	serverlessApplicationRepositoryCloudFormationStackTagApplicationID   = "serverlessrepo:applicationId"
+	serverlessApplicationRepositoryCloudFormationStackTagSemanticVersion = "serverlessrepo:semanticVersion"
+)
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStack() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate,
+		Read:   resourceAwsServerlessApplicationRepositoryCloudFormationStackRead,
+		Update: resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate,
+		Delete: resourceAwsServerlessApplicationRepositoryCloudFormationStackDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsServerlessApplicationRepositoryCloudFormationStackImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"application_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"capabilities": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(serverlessrepository.Capability_Values(), false),
+				},
+				Set: schema.HashString,
+			},
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"semantic_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackCreate(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*AWSClient).cfconn
+
+	changeSet, err := createServerlessApplicationRepositoryCloudFormationChangeSet(d, meta.(*AWSClient))
+
+	if err != nil {
+		return fmt.Errorf("error creating Serverless Application Repository CloudFormation Stack (%s) change set: %w", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(changeSet.StackId))
+
+	_, err = cfConn.ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+		ChangeSetName: changeSet.ChangeSetId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error executing Serverless Application Repository CloudFormation Stack (%s) change set: %w", d.Id(), err)
+	}
+
+	status, err := waitForCloudFormationStackCreation(cfConn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Serverless Application Repository CloudFormation Stack (%s) creation: %w", d.Id(), err)
+	}
+
+	if status != cloudformation.StackStatusCreateComplete {
+		reasons, err := getCloudFormationFailures(d.Id(), cfConn)
+
+		if err != nil {
+			return fmt.Errorf("error getting Serverless Application Repository CloudFormation Stack (%s) failure reasons: %w", d.Id(), err)
+		}
+
+		return fmt.Errorf("error creating Serverless Application Repository CloudFormation Stack (%s): %s: %q", d.Id(), status, reasons)
+	}
+
+	log.Printf("[INFO] Serverless Application Repository CloudFormation Stack (%s) created", d.Id())
+
+	return resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d, meta)
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d *schema.ResourceData, meta interface{}) error {
+	serverlessConn := meta.(*AWSClient).serverlessapplicationrepositoryconn
+	cfConn := meta.(*AWSClient).cfconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	output, err := cfConn.DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(d.Id()),
+	})
+
+	// ValidationError: Stack with id % does not exist
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, "ValidationError") {
+		log.Printf("[WARN] Serverless Application Repository CloudFormation Stack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error describing Serverless Application Repository CloudFormation Stack (%s): %w", d.Id(), err)
+	}
+
+	if output == nil || len(output.Stacks) == 0 || aws.StringValue(output.Stacks[0].StackStatus) == cloudformation.StackStatusDeleteComplete {
+		if d.IsNewResource() {
+			return fmt.Errorf("error describing Serverless Application Repository CloudFormation Stack (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Serverless Application Repository CloudFormation Stack (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	stack := output.Stacks[0]
+
+	// Serverless Application Repository prepends a prefix to the stack name
+	d.Set("name", strings.TrimPrefix(aws.StringValue(stack.StackName), serverlessApplicationRepositoryCloudFormationStackNamePrefix))
+
+	tags := keyvaluetags.CloudformationKeyValueTags(stack.Tags)
+
+	applicationID := aws.StringValue(tags.KeyValue(serverlessApplicationRepositoryCloudFormationStackTagApplicationID))
+	if applicationID == "" {
+		return fmt.Errorf("error describing Serverless Application Repository CloudFormation Stack (%s): missing required tag %q", d.Id(), serverlessApplicationRepositoryCloudFormationStackTagApplicationID)
+	}
+	d.Set("application_id", applicationID)
+
+	semanticVersion := aws.StringValue(tags.KeyValue(serverlessApplicationRepositoryCloudFormationStackTagSemanticVersion))
+	if semanticVersion == "" {
+		return fmt.Errorf("error describing Serverless Application Repository CloudFormation Stack (%s): missing required tag %q", d.Id(), serverlessApplicationRepositoryCloudFormationStackTagSemanticVersion)
+	}
+	d.Set("semantic_version", semanticVersion)
+
+	if err := d.Set("tags", tags.IgnoreServerlessApplicationRepository().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	application, err := finder.Application(serverlessConn, applicationID, semanticVersion)
+
+	if err != nil {
+		return fmt.Errorf("error getting Serverless Application Repository application (%s, v%s): %w", applicationID, semanticVersion, err)
+	}
+
+	var parameterDefinitions []*serverlessrepository.ParameterDefinition
+	if application.Version != nil {
+		parameterDefinitions = application.Version.ParameterDefinitions
+	}
+
+	if err := d.Set("parameters", flattenServerlessApplicationRepositoryNonDefaultCloudFormationParameters(stack.Parameters, parameterDefinitions)); err != nil {
+		return fmt.Errorf("error setting parameters: %w", err)
+	}
+
+	if err := d.Set("outputs", flattenCloudFormationOutputs(stack.Outputs)); err != nil {
+		return fmt.Errorf("error setting outputs: %w", err)
+	}
+
+	if err := d.Set("capabilities", flattenServerlessApplicationRepositoryStackCapabilities(d, stack.Capabilities)); err != nil {
+		return fmt.Errorf("error setting capabilities: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackUpdate(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*AWSClient).cfconn
+
+	changeSet, err := createServerlessApplicationRepositoryCloudFormationChangeSet(d, meta.(*AWSClient))
+
+	if err != nil {
+		if changeSet != nil && strings.Contains(aws.StringValue(changeSet.StatusReason), "didn't contain changes") {
+			log.Printf("[DEBUG] Serverless Application Repository CloudFormation Stack (%s) has no updates", d.Id())
+
+			return resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d, meta)
+		}
+
+		return fmt.Errorf("error creating Serverless Application Repository CloudFormation Stack (%s) change set: %w", d.Id(), err)
+	}
+
+	lastUpdatedTime, err := getLastCfEventTimestamp(d.Id(), cfConn)
+
+	if err != nil {
+		return fmt.Errorf("error getting Serverless Application Repository CloudFormation Stack (%s) last event: %w", d.Id(), err)
+	}
+
+	_, err = cfConn.ExecuteChangeSet(&cloudformation.ExecuteChangeSetInput{
+		ChangeSetName: changeSet.ChangeSetId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error executing Serverless Application Repository CloudFormation Stack (%s) change set: %w", d.Id(), err)
+	}
+
+	stack, err := cfwaiter.StackUpdated(cfConn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Serverless Application Repository CloudFormation Stack (%s) update: %w", d.Id(), err)
+	}
+
+	if status := aws.StringValue(stack.StackStatus); status == cloudformation.StackStatusUpdateRollbackComplete || status == cloudformation.StackStatusUpdateRollbackFailed {
+		reasons, err := getCloudFormationRollbackReasons(d.Id(), lastUpdatedTime, cfConn)
+
+		if err != nil {
+			return fmt.Errorf("error getting Serverless Application Repository CloudFormation Stack (%s) rollback reasons: %w", d.Id(), err)
+		}
+
+		return fmt.Errorf("error updating Serverless Application Repository CloudFormation Stack (%s): %s: %q", d.Id(), status, reasons)
+	}
+
+	log.Printf("[INFO] Serverless Application Repository CloudFormation Stack (%s) updated", d.Id())
+
+	return resourceAwsServerlessApplicationRepositoryCloudFormationStackRead(d, meta)
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackDelete(d *schema.ResourceData, meta interface{}) error {
+	cfConn := meta.(*AWSClient).cfconn
+
+	_, err := cfConn.DeleteStack(&cloudformation.DeleteStackInput{
+		StackName: aws.String(d.Id()),
+	})
+
+	// ValidationError: Stack with id % does not exist
+	if tfawserr.ErrCodeEquals(err, "ValidationError") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Serverless Application Repository CloudFormation Stack (%s): %w", d.Id(), err)
+	}
+
+	if err := waitForCloudFormationStackDeletion(cfConn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Serverless Application Repository CloudFormation Stack (%s) deletion: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsServerlessApplicationRepositoryCloudFormationStackImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	cfConn := meta.(*AWSClient).cfconn
+
+	stackID := d.Id()
+
+	// If this isn't an ARN, it's the stack name
+	if _, err := arn.Parse(stackID); err != nil {
+		if !strings.HasPrefix(stackID, serverlessApplicationRepositoryCloudFormationStackNamePrefix) {
+			stackID = serverlessApplicationRepositoryCloudFormationStackNamePrefix + stackID
+		}
+	}
+
+	output, err := cfConn.DescribeStacks(&cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackID),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error describing Serverless Application Repository CloudFormation Stack (%s): %w", stackID, err)
+	}
+
+	if output == nil || len(output.Stacks) == 0 {
+		return nil, fmt.Errorf("error describing Serverless Application Repository CloudFormation Stack (%s): not found", stackID)
+	}
+
+	d.SetId(aws.StringValue(output.Stacks[0].StackId))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func createServerlessApplicationRepositoryCloudFormationChangeSet(d *schema.ResourceData, client *AWSClient) (*cloudformation.DescribeChangeSetOutput, error) {
+	serverlessConn := client.serverlessapplicationrepositoryconn
+	cfConn := client.cfconn
+
+	input := &serverlessrepository.CreateCloudFormationChangeSetRequest{
+		ApplicationId: aws.String(d.Get("application_id").(string)),
+		Capabilities:  expandStringSet(d.Get("capabilities").(*schema.Set)),
+		StackName:     aws.String(d.Get("name").(string)),
+		Tags:          keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().ServerlessapplicationrepositoryTags(),
+	}
+
+	if v, ok := d.GetOk("semantic_version"); ok {
+		input.SemanticVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.ParameterOverrides = expandServerlessApplicationRepositoryCloudFormationChangeSetParameters(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Serverless Application Repository CloudFormation change set: %s", input)
+	output, err := serverlessConn.CreateCloudFormationChangeSet(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cfwaiter.ChangeSetCreated(cfConn, aws.StringValue(output.StackId), aws.StringValue(output.ChangeSetId))
+}
+
+func expandServerlessApplicationRepositoryCloudFormationChangeSetParameters(params map[string]interface{}) []*serverlessrepository.ParameterValue {
+	var appParams []*serverlessrepository.ParameterValue
+	for k, v := range params {
+		appParams = append(appParams, &serverlessrepository.ParameterValue{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+	return appParams
+}
+
+// flattenServerlessApplicationRepositoryNonDefaultCloudFormationParameters returns only
+// the stack parameters whose values differ from the application's defaults.
+func flattenServerlessApplicationRepositoryNonDefaultCloudFormationParameters(cfParams []*cloudformation.Parameter, rawParameterDefinitions []*serverlessrepository.ParameterDefinition) map[string]interface{} {
+	parameterDefinitions := make(map[string]*serverlessrepository.ParameterDefinition, len(rawParameterDefinitions))
+	for _, p := range rawParameterDefinitions {
+		parameterDefinitions[aws.StringValue(p.Name)] = p
+	}
+
+	params := make(map[string]interface{}, len(cfParams))
+	for _, p := range cfParams {
+		key := aws.StringValue(p.ParameterKey)
+		value := aws.StringValue(p.ParameterValue)
+		if pd, ok := parameterDefinitions[key]; ok && pd.DefaultValue != nil && value == aws.StringValue(pd.DefaultValue) {
+			continue
+		}
+		params[key] = value
+	}
+	return params
+}
+
+// flattenServerlessApplicationRepositoryStackCapabilities preserves CAPABILITY_RESOURCE_POLICY
+// if it has been configured, as it is not returned by CloudFormation.
+func flattenServerlessApplicationRepositoryStackCapabilities(d *schema.ResourceData, c []*string) *schema.Set {
+	existingCapabilities := d.Get("capabilities").(*schema.Set)
+	capabilities := flattenStringSet(c)
+	if existingCapabilities.Contains(serverlessrepository.CapabilityCapabilityResourcePolicy) {
+		capabilities.Add(serverlessrepository.CapabilityCapabilityResourcePolicy)
+	}
+	return capabilities
+}
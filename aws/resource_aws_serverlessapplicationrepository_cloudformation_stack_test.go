@@ -0,0 +1,475 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfawsresource"
+)
+
+func TestAccAwsServerlessApplicationRepositoryCloudFormationStack_basic(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := acctest.RandomWithPrefix("tf-acc-test")
+	appARN := testAccAwsServerlessApplicationRepositoryPostgresSingleUserRotatorApplicationARN()
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig(stackName, appARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "name", stackName),
+					resource.TestCheckResourceAttr(resourceName, "application_id", appARN),
+					resource.TestCheckResourceAttrSet(resourceName, "semantic_version"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.functionName", fmt.Sprintf("func-%s", stackName)),
+					resource.TestCheckResourceAttrSet(resourceName, "parameters.endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.%", "1"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "outputs.RotationLambdaARN", "lambda", regexp.MustCompile(fmt.Sprintf("function:func-%s$", stackName))),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "2"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", "CAPABILITY_IAM"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", "CAPABILITY_RESOURCE_POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsServerlessApplicationRepositoryCloudFormationStackNameImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsServerlessApplicationRepositoryCloudFormationStackNameNoPrefixImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessApplicationRepositoryCloudFormationStack_disappears(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := acctest.RandomWithPrefix("tf-acc-test")
+	appARN := testAccAwsServerlessApplicationRepositoryPostgresSingleUserRotatorApplicationARN()
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig(stackName, appARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServerlessApplicationRepositoryCloudFormationStack(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessApplicationRepositoryCloudFormationStack_versioned(t *testing.T) {
+	var stack1, stack2, stack3 cloudformation.Stack
+	stackName := acctest.RandomWithPrefix("tf-acc-test")
+	appARN := testAccAwsServerlessApplicationRepositoryPostgresSingleUserRotatorApplicationARN()
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
+
+	const (
+		version1 = "1.0.13"
+		version2 = "1.1.36"
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig_versioned(stackName, appARN, version1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack1),
+					resource.TestCheckResourceAttr(resourceName, "semantic_version", version1),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", "CAPABILITY_IAM"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig_versioned2(stackName, appARN, version2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack2),
+					testAccCheckCloudFormationStackNotRecreated(&stack1, &stack2),
+					resource.TestCheckResourceAttr(resourceName, "semantic_version", version2),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "2"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", "CAPABILITY_IAM"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", "CAPABILITY_RESOURCE_POLICY"),
+				),
+			},
+			{
+				// Confirm removal of "CAPABILITY_RESOURCE_POLICY" is handled properly
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig_versioned(stackName, appARN, version1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack3),
+					testAccCheckCloudFormationStackNotRecreated(&stack2, &stack3),
+					resource.TestCheckResourceAttr(resourceName, "semantic_version", version1),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "1"),
+					tfawsresource.TestCheckTypeSetElemAttr(resourceName, "capabilities.*", "CAPABILITY_IAM"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessApplicationRepositoryCloudFormationStack_Tags(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := acctest.RandomWithPrefix("tf-acc-test")
+	appARN := testAccAwsServerlessApplicationRepositoryPostgresSingleUserRotatorApplicationARN()
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfigTags1(stackName, appARN, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfigTags2(stackName, appARN, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfigTags1(stackName, appARN, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsServerlessApplicationRepositoryCloudFormationStack_update(t *testing.T) {
+	var stack cloudformation.Stack
+	stackName := acctest.RandomWithPrefix("tf-acc-test")
+	appARN := testAccAwsServerlessApplicationRepositoryPostgresSingleUserRotatorApplicationARN()
+	initialName := acctest.RandomWithPrefix("FuncName1")
+	updatedName := acctest.RandomWithPrefix("FuncName2")
+	resourceName := "aws_serverlessapplicationrepository_cloudformation_stack.postgres-rotator"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig_update(stackName, appARN, initialName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "application_id", appARN),
+					resource.TestCheckResourceAttr(resourceName, "parameters.functionName", initialName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "value"),
+				),
+			},
+			{
+				Config: testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig_update(stackName, appARN, updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "application_id", appARN),
+					resource.TestCheckResourceAttr(resourceName, "parameters.functionName", updatedName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key", "value"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServerlessApplicationRepositoryCloudFormationStackExists(n string, stack *cloudformation.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cfconn
+		params := &cloudformation.DescribeStacksInput{
+			StackName: aws.String(rs.Primary.ID),
+		}
+		resp, err := conn.DescribeStacks(params)
+		if err != nil {
+			return err
+		}
+		if len(resp.Stacks) == 0 {
+			return fmt.Errorf("CloudFormation stack (%s) not found", rs.Primary.ID)
+		}
+
+		*stack = *resp.Stacks[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAwsServerlessApplicationRepositoryCloudFormationStackDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_serverlessapplicationrepository_cloudformation_stack" {
+			continue
+		}
+
+		resp, err := conn.DescribeStacks(&cloudformation.DescribeStacksInput{
+			StackName: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, "ValidationError", "does not exist") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, s := range resp.Stacks {
+			if aws.StringValue(s.StackId) == rs.Primary.ID && aws.StringValue(s.StackStatus) != cloudformation.StackStatusDeleteComplete {
+				return fmt.Errorf("Serverless Application Repository CloudFormation Stack (%s) still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAwsServerlessApplicationRepositoryCloudFormationStackNameImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return serverlessApplicationRepositoryCloudFormationStackNamePrefix + rs.Primary.Attributes["name"], nil
+	}
+}
+
+func testAccAwsServerlessApplicationRepositoryCloudFormationStackNameNoPrefixImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["name"], nil
+	}
+}
+
+func testAccCheckCloudFormationStackNotRecreated(i, j *cloudformation.Stack) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.StackId) != aws.StringValue(j.StackId) {
+			return fmt.Errorf("CloudFormation stack recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAwsServerlessApplicationRepositoryPostgresSingleUserRotatorApplicationARN() string {
+	return arn.ARN{
+		Partition: testAccGetPartition(),
+		Service:   "serverlessrepo",
+		Region:    "us-east-1",
+		AccountID: "297356227824",
+		Resource:  "applications/SecretsManagerRDSPostgreSQLRotationSingleUser",
+	}.String()
+}
+
+func testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig(stackName, appARN string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name           = %[1]q
+  application_id = %[2]q
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, stackName, appARN)
+}
+
+func testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig_update(stackName, appARN, functionName string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name           = %[1]q
+  application_id = %[2]q
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = %[3]q
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+
+  tags = {
+    key = "value"
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, stackName, appARN, functionName)
+}
+
+func testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig_versioned(stackName, appARN, version string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name             = %[1]q
+  application_id   = %[2]q
+  semantic_version = %[3]q
+
+  capabilities = [
+    "CAPABILITY_IAM",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, stackName, appARN, version)
+}
+
+func testAccAwsServerlessApplicationRepositoryCloudFormationStackConfig_versioned2(stackName, appARN, version string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name             = %[1]q
+  application_id   = %[2]q
+  semantic_version = %[3]q
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, stackName, appARN, version)
+}
+
+func testAccAwsServerlessApplicationRepositoryCloudFormationStackConfigTags1(stackName, appARN, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name           = %[1]q
+  application_id = %[2]q
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, stackName, appARN, tagKey1, tagValue1)
+}
+
+func testAccAwsServerlessApplicationRepositoryCloudFormationStackConfigTags2(stackName, appARN, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_serverlessapplicationrepository_cloudformation_stack" "postgres-rotator" {
+  name           = %[1]q
+  application_id = %[2]q
+
+  capabilities = [
+    "CAPABILITY_IAM",
+    "CAPABILITY_RESOURCE_POLICY",
+  ]
+
+  parameters = {
+    functionName = "func-%[1]s"
+    endpoint     = "secretsmanager.${data.aws_region.current.name}.${data.aws_partition.current.dns_suffix}"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, stackName, appARN, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	tfservicecatalog "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/waiter"
+)
+
+func resourceAwsServiceCatalogPortfolioShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogPortfolioShareCreate,
+		Read:   resourceAwsServiceCatalogPortfolioShareRead,
+		Delete: resourceAwsServiceCatalogPortfolioShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(servicecatalog.OrganizationNodeType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPortfolioShareCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID := d.Get("portfolio_id").(string)
+	shareType := d.Get("type").(string)
+	principalID := d.Get("principal_id").(string)
+	input := &servicecatalog.CreatePortfolioShareInput{
+		PortfolioId: aws.String(portfolioID),
+	}
+
+	if shareType == servicecatalog.OrganizationNodeTypeAccount {
+		input.AccountId = aws.String(principalID)
+	} else {
+		input.OrganizationNode = &servicecatalog.OrganizationNode{
+			Type:  aws.String(shareType),
+			Value: aws.String(principalID),
+		}
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Portfolio Share: %s", input)
+	output, err := conn.CreatePortfolioShare(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Portfolio (%s) Share: %w", portfolioID, err)
+	}
+
+	d.SetId(tfservicecatalog.PortfolioShareCreateID(portfolioID, shareType, principalID))
+
+	// Only organization node shares are asynchronous.
+	if v := aws.StringValue(output.PortfolioShareToken); v != "" {
+		if _, err := waiter.PortfolioShareReady(conn, v); err != nil {
+			return fmt.Errorf("error waiting for Service Catalog Portfolio Share (%s) to complete: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsServiceCatalogPortfolioShareRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPortfolioShareRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, shareType, principalID, err := tfservicecatalog.PortfolioShareParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	node, err := finder.PortfolioShare(conn, portfolioID, shareType, principalID)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Service Catalog Portfolio Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Portfolio Share (%s): %w", d.Id(), err)
+	}
+
+	if node == nil {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Service Catalog Portfolio Share (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Service Catalog Portfolio Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("portfolio_id", portfolioID)
+	d.Set("principal_id", node.Value)
+	d.Set("type", node.Type)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPortfolioShareDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, shareType, principalID, err := tfservicecatalog.PortfolioShareParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.DeletePortfolioShareInput{
+		PortfolioId: aws.String(portfolioID),
+	}
+
+	if shareType == servicecatalog.OrganizationNodeTypeAccount {
+		input.AccountId = aws.String(principalID)
+	} else {
+		input.OrganizationNode = &servicecatalog.OrganizationNode{
+			Type:  aws.String(shareType),
+			Value: aws.String(principalID),
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Portfolio Share: %s", d.Id())
+	output, err := conn.DeletePortfolioShare(input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Portfolio Share (%s): %w", d.Id(), err)
+	}
+
+	if v := aws.StringValue(output.PortfolioShareToken); v != "" {
+		if _, err := waiter.PortfolioShareReady(conn, v); err != nil {
+			return fmt.Errorf("error waiting for Service Catalog Portfolio Share (%s) to be deleted: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
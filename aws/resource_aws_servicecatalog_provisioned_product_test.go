@@ -0,0 +1,283 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/servicecatalog/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_servicecatalog_provisioned_product", &resource.Sweeper{
+		Name: "aws_servicecatalog_provisioned_product",
+		F:    testSweepServiceCatalogProvisionedProducts,
+	})
+}
+
+func testSweepServiceCatalogProvisionedProducts(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).scconn
+	var sweeperErrs *multierror.Error
+
+	input := &servicecatalog.ScanProvisionedProductsInput{
+		AccessLevelFilter: &servicecatalog.AccessLevelFilter{
+			Key:   aws.String(servicecatalog.AccessLevelFilterKeyAccount),
+			Value: aws.String("self"),
+		},
+	}
+
+	for {
+		output, err := conn.ScanProvisionedProducts(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping Service Catalog Provisioned Product sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Service Catalog Provisioned Products: %w", err))
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		for _, detail := range output.ProvisionedProducts {
+			if detail == nil {
+				continue
+			}
+
+			if !strings.HasPrefix(aws.StringValue(detail.Name), "tf-acc-test") {
+				continue
+			}
+
+			id := aws.StringValue(detail.Id)
+
+			log.Printf("[INFO] Terminating Service Catalog Provisioned Product: %s", id)
+			r := resourceAwsServiceCatalogProvisionedProduct()
+			d := r.Data(nil)
+			d.SetId(id)
+			d.Set("ignore_errors", true)
+			err := r.Delete(d, client)
+
+			if err != nil {
+				sweeperErr := fmt.Errorf("error terminating Service Catalog Provisioned Product (%s): %w", id, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		if aws.StringValue(output.NextPageToken) == "" {
+			break
+		}
+
+		input.PageToken = output.NextPageToken
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSServiceCatalogProvisionedProduct_basic(t *testing.T) {
+	var provisionedProduct servicecatalog.ProvisionedProductDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProvisionedProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName, &provisionedProduct),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_record_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.0.key", "VpcID"),
+					resource.TestCheckResourceAttrSet(resourceName, "path_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "provisioning_artifact_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.ProvisionedProductStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "type", "CFN_STACK"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ignore_errors", "provisioning_parameters"},
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProvisionedProduct_disappears(t *testing.T) {
+	var provisionedProduct servicecatalog.ProvisionedProductDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProvisionedProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName, &provisionedProduct),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsServiceCatalogProvisionedProduct(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProvisionedProduct_Tags(t *testing.T) {
+	var provisionedProduct servicecatalog.ProvisionedProductDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_servicecatalog_provisioned_product.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceCatalogProvisionedProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName, &provisionedProduct),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogProvisionedProductConfigTags1(rName, "key1", "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName, &provisionedProduct),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsServiceCatalogProvisionedProductDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_provisioned_product" {
+			continue
+		}
+
+		output, err := finder.ProvisionedProductByID(conn, rs.Primary.ID)
+
+		if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Service Catalog Provisioned Product (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsServiceCatalogProvisionedProductExists(resourceName string, provisionedProduct *servicecatalog.ProvisionedProductDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Provisioned Product ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		output, err := finder.ProvisionedProductByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Service Catalog Provisioned Product (%s) not found", rs.Primary.ID)
+		}
+
+		*provisionedProduct = *output
+
+		return nil
+	}
+}
+
+func testAccAWSServiceCatalogProvisionedProductConfigBase(rName string) string {
+	return composeConfig(testAccAWSServiceCatalogProductPortfolioConfigBase(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  disable_template_validation = true
+  name                        = "%[1]s-artifact"
+  product_id                  = aws_servicecatalog_product.test.id
+  template_url                = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test.key}"
+}
+
+resource "aws_servicecatalog_product_portfolio_association" "test" {
+  portfolio_id = aws_servicecatalog_portfolio.test.id
+  product_id   = aws_servicecatalog_product.test.id
+}
+
+resource "aws_servicecatalog_principal_portfolio_association" "test" {
+  portfolio_id  = aws_servicecatalog_portfolio.test.id
+  principal_arn = data.aws_caller_identity.current.arn
+}
+`, rName))
+}
+
+func testAccAWSServiceCatalogProvisionedProductConfigBasic(rName string) string {
+	return composeConfig(testAccAWSServiceCatalogProvisionedProductConfigBase(rName), fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                     = %[1]q
+  product_id               = aws_servicecatalog_product_portfolio_association.test.product_id
+  provisioning_artifact_id = aws_servicecatalog_provisioning_artifact.test.provisioning_artifact_id
+
+  depends_on = [aws_servicecatalog_principal_portfolio_association.test]
+}
+`, rName))
+}
+
+func testAccAWSServiceCatalogProvisionedProductConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(testAccAWSServiceCatalogProvisionedProductConfigBase(rName), fmt.Sprintf(`
+resource "aws_servicecatalog_provisioned_product" "test" {
+  name                     = %[1]q
+  product_id               = aws_servicecatalog_product_portfolio_association.test.product_id
+  provisioning_artifact_id = aws_servicecatalog_provisioning_artifact.test.provisioning_artifact_id
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_servicecatalog_principal_portfolio_association.test]
+}
+`, rName, tagKey1, tagValue1))
+}
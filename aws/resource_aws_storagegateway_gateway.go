@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/storagegateway/waiter"
 )
 
 const (
@@ -105,6 +106,10 @@ func resourceAwsStorageGatewayGateway() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"active_directory_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"domain_name": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -283,6 +288,10 @@ func resourceAwsStorageGatewayGatewayCreate(d *schema.ResourceData, meta interfa
 		if err != nil {
 			return fmt.Errorf("error joining Active Directory domain: %w", err)
 		}
+
+		if _, err := waiter.SmbActiveDirectorySettingsJoined(conn, d.Id(), waiter.SmbActiveDirectorySettingsJoinedTimeout); err != nil {
+			return fmt.Errorf("error waiting for Storage Gateway Gateway (%s) to join Active Directory domain (%s): %w", d.Id(), m["domain_name"].(string), err)
+		}
 	}
 
 	if v, ok := d.GetOk("smb_guest_password"); ok && v.(string) != "" {
@@ -415,7 +424,8 @@ func resourceAwsStorageGatewayGatewayRead(d *schema.ResourceData, meta interface
 		}
 	} else {
 		m := map[string]interface{}{
-			"domain_name": aws.StringValue(smbSettingsOutput.DomainName),
+			"active_directory_status": aws.StringValue(smbSettingsOutput.ActiveDirectoryStatus),
+			"domain_name":             aws.StringValue(smbSettingsOutput.DomainName),
 			// The Storage Gateway API currently provides no way to read these values
 			// "password": ...,
 			// "username": ...,
@@ -508,6 +518,10 @@ func resourceAwsStorageGatewayGatewayUpdate(d *schema.ResourceData, meta interfa
 		if err != nil {
 			return fmt.Errorf("error joining Active Directory domain: %w", err)
 		}
+
+		if _, err := waiter.SmbActiveDirectorySettingsJoined(conn, d.Id(), waiter.SmbActiveDirectorySettingsJoinedTimeout); err != nil {
+			return fmt.Errorf("error waiting for Storage Gateway Gateway (%s) to join Active Directory domain (%s): %w", d.Id(), m["domain_name"].(string), err)
+		}
 	}
 
 	if d.HasChange("smb_guest_password") {
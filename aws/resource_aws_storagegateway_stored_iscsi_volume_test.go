@@ -0,0 +1,393 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/storagegateway/finder"
+)
+
+func TestAccAWSStorageGatewayStoredIscsiVolume_basic(t *testing.T) {
+	var storedIscsiVolume storagegateway.StorediSCSIVolume
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
+	localDiskDataSourceName := "data.aws_storagegateway_local_disk.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayStoredIscsiVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayStoredIscsiVolumeConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName, &storedIscsiVolume),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "storagegateway", regexp.MustCompile(`gateway/sgw-.+/volume/vol-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "chap_enabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "disk_id", localDiskDataSourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "gateway_arn", "aws_storagegateway_gateway.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "kms_encrypted", "false"),
+					resource.TestCheckResourceAttr(resourceName, "lun_number", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", "aws_instance.test", "private_ip"),
+					resource.TestMatchResourceAttr(resourceName, "network_interface_port", regexp.MustCompile(`^\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "preserve_existing_data", "false"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "target_arn", "storagegateway", regexp.MustCompile(fmt.Sprintf(`gateway/sgw-.+/target/iqn.1997-05.com.amazon:%s`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "target_name", rName),
+					resource.TestMatchResourceAttr(resourceName, "volume_id", regexp.MustCompile(`^vol-.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "volume_size_in_bytes", "10737418240"),
+					resource.TestCheckResourceAttr(resourceName, "volume_status", "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", "STORED iSCSI"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayStoredIscsiVolume_kms(t *testing.T) {
+	var storedIscsiVolume storagegateway.StorediSCSIVolume
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayStoredIscsiVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayStoredIscsiVolumeConfigKMSEncrypted(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName, &storedIscsiVolume),
+					resource.TestCheckResourceAttr(resourceName, "kms_encrypted", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key", keyResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayStoredIscsiVolume_Tags(t *testing.T) {
+	var storedIscsiVolume storagegateway.StorediSCSIVolume
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayStoredIscsiVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayStoredIscsiVolumeConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName, &storedIscsiVolume),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSStorageGatewayStoredIscsiVolumeConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName, &storedIscsiVolume),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSStorageGatewayStoredIscsiVolumeConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName, &storedIscsiVolume),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayStoredIscsiVolume_SnapshotId(t *testing.T) {
+	var storedIscsiVolume storagegateway.StorediSCSIVolume
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
+	snapshotResourceName := "aws_ebs_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayStoredIscsiVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayStoredIscsiVolumeConfigSnapshotId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName, &storedIscsiVolume),
+					resource.TestCheckResourceAttrPair(resourceName, "snapshot_id", snapshotResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayStoredIscsiVolume_disappears(t *testing.T) {
+	var storedIscsiVolume storagegateway.StorediSCSIVolume
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_stored_iscsi_volume.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayStoredIscsiVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayStoredIscsiVolumeConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName, &storedIscsiVolume),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsStorageGatewayStoredIscsiVolume(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSStorageGatewayStoredIscsiVolumeExists(resourceName string, storedIscsiVolume *storagegateway.StorediSCSIVolume) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+		output, err := finder.StorediSCSIVolumeByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Storage Gateway stored iSCSI volume: %w", err)
+		}
+
+		if output == nil {
+			return fmt.Errorf("Storage Gateway stored iSCSI volume %q not found", rs.Primary.ID)
+		}
+
+		*storedIscsiVolume = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSStorageGatewayStoredIscsiVolumeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_storagegateway_stored_iscsi_volume" {
+			continue
+		}
+
+		output, err := finder.StorediSCSIVolumeByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			if isAWSErrStorageGatewayGatewayNotFound(err) {
+				continue
+			}
+			if isAWSErr(err, storagegateway.ErrorCodeVolumeNotFound, "") {
+				continue
+			}
+			if isAWSErr(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified volume was not found") {
+				continue
+			}
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Storage Gateway stored iSCSI volume %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSStorageGatewayStoredIscsiVolumeConfigBase(rName string) string {
+	return composeConfig(
+		testAccAWSStorageGatewayGatewayConfig_GatewayType_Stored(rName),
+		fmt.Sprintf(`
+resource "aws_ebs_volume" "buffer" {
+  availability_zone = aws_instance.test.availability_zone
+  size              = 10
+  type              = "gp2"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_volume_attachment" "buffer" {
+  device_name  = "/dev/xvdb"
+  force_detach = true
+  instance_id  = aws_instance.test.id
+  volume_id    = aws_ebs_volume.buffer.id
+}
+
+data "aws_storagegateway_local_disk" "buffer" {
+  disk_node   = aws_volume_attachment.buffer.device_name
+  gateway_arn = aws_storagegateway_gateway.test.arn
+}
+
+resource "aws_storagegateway_upload_buffer" "test" {
+  disk_id     = data.aws_storagegateway_local_disk.buffer.id
+  gateway_arn = aws_storagegateway_gateway.test.arn
+}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = aws_instance.test.availability_zone
+  size              = 10
+  type              = "gp2"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_volume_attachment" "test" {
+  device_name  = "/dev/xvdc"
+  force_detach = true
+  instance_id  = aws_instance.test.id
+  volume_id    = aws_ebs_volume.test.id
+}
+
+data "aws_storagegateway_local_disk" "test" {
+  disk_node   = aws_volume_attachment.test.device_name
+  gateway_arn = aws_storagegateway_gateway.test.arn
+}
+`, rName))
+}
+
+func testAccAWSStorageGatewayStoredIscsiVolumeConfigBasic(rName string) string {
+	return composeConfig(
+		testAccAWSStorageGatewayStoredIscsiVolumeConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_storagegateway_stored_iscsi_volume" "test" {
+  gateway_arn            = aws_storagegateway_upload_buffer.test.gateway_arn
+  network_interface_id   = aws_instance.test.private_ip
+  target_name            = %[1]q
+  preserve_existing_data = false
+  disk_id                = data.aws_storagegateway_local_disk.test.id
+}
+`, rName))
+}
+
+func testAccAWSStorageGatewayStoredIscsiVolumeConfigKMSEncrypted(rName string) string {
+	return composeConfig(
+		testAccAWSStorageGatewayStoredIscsiVolumeConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+}
+
+resource "aws_storagegateway_stored_iscsi_volume" "test" {
+  gateway_arn            = aws_storagegateway_upload_buffer.test.gateway_arn
+  network_interface_id   = aws_instance.test.private_ip
+  target_name            = %[1]q
+  preserve_existing_data = false
+  disk_id                = data.aws_storagegateway_local_disk.test.id
+  kms_encrypted          = true
+  kms_key                = aws_kms_key.test.arn
+}
+`, rName))
+}
+
+func testAccAWSStorageGatewayStoredIscsiVolumeConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return composeConfig(
+		testAccAWSStorageGatewayStoredIscsiVolumeConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_storagegateway_stored_iscsi_volume" "test" {
+  gateway_arn            = aws_storagegateway_upload_buffer.test.gateway_arn
+  network_interface_id   = aws_instance.test.private_ip
+  target_name            = %[1]q
+  preserve_existing_data = false
+  disk_id                = data.aws_storagegateway_local_disk.test.id
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccAWSStorageGatewayStoredIscsiVolumeConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return composeConfig(
+		testAccAWSStorageGatewayStoredIscsiVolumeConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_storagegateway_stored_iscsi_volume" "test" {
+  gateway_arn            = aws_storagegateway_upload_buffer.test.gateway_arn
+  network_interface_id   = aws_instance.test.private_ip
+  target_name            = %[1]q
+  preserve_existing_data = false
+  disk_id                = data.aws_storagegateway_local_disk.test.id
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccAWSStorageGatewayStoredIscsiVolumeConfigSnapshotId(rName string) string {
+	return composeConfig(
+		testAccAWSStorageGatewayStoredIscsiVolumeConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_ebs_volume" "snapshot" {
+  availability_zone = aws_instance.test.availability_zone
+  size              = 1
+  type              = "gp2"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ebs_snapshot" "test" {
+  volume_id = aws_ebs_volume.snapshot.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_storagegateway_stored_iscsi_volume" "test" {
+  gateway_arn            = aws_storagegateway_upload_buffer.test.gateway_arn
+  network_interface_id   = aws_instance.test.private_ip
+  target_name            = %[1]q
+  preserve_existing_data = false
+  disk_id                = data.aws_storagegateway_local_disk.test.id
+  snapshot_id            = aws_ebs_snapshot.test.id
+}
+`, rName))
+}
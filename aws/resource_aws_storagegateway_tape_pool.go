@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/storagegateway/finder"
+)
+
+func resourceAwsStorageGatewayTapePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsStorageGatewayTapePoolCreate,
+		Read:   resourceAwsStorageGatewayTapePoolRead,
+		Update: resourceAwsStorageGatewayTapePoolUpdate,
+		Delete: resourceAwsStorageGatewayTapePoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pool_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"retention_lock_time_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 36500),
+			},
+			"retention_lock_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      storagegateway.RetentionLockTypeNone,
+				ValidateFunc: validation.StringInSlice(storagegateway.RetentionLockType_Values(), false),
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(storagegateway.TapeStorageClass_Values(), false),
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsStorageGatewayTapePoolCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	input := &storagegateway.CreateTapePoolInput{
+		PoolName:          aws.String(d.Get("pool_name").(string)),
+		RetentionLockType: aws.String(d.Get("retention_lock_type").(string)),
+		StorageClass:      aws.String(d.Get("storage_class").(string)),
+		Tags:              keyvaluetags.New(d.Get("tags").(map[string]interface{})).IgnoreAws().StoragegatewayTags(),
+	}
+
+	if v, ok := d.GetOk("retention_lock_time_in_days"); ok {
+		input.RetentionLockTimeInDays = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Creating Storage Gateway Tape Pool: %s", input)
+	output, err := conn.CreateTapePool(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Storage Gateway Tape Pool: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.PoolARN))
+
+	return resourceAwsStorageGatewayTapePoolRead(d, meta)
+}
+
+func resourceAwsStorageGatewayTapePoolRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	pool, err := finder.TapePoolByARN(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading Storage Gateway Tape Pool (%s): %w", d.Id(), err)
+	}
+
+	if pool == nil || aws.StringValue(pool.PoolStatus) == storagegateway.PoolStatusDeleted {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Storage Gateway Tape Pool (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Storage Gateway Tape Pool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	poolARN := aws.StringValue(pool.PoolARN)
+	d.Set("arn", poolARN)
+	d.Set("pool_name", pool.PoolName)
+	d.Set("retention_lock_time_in_days", pool.RetentionLockTimeInDays)
+	d.Set("retention_lock_type", pool.RetentionLockType)
+	d.Set("storage_class", pool.StorageClass)
+
+	tags, err := keyvaluetags.StoragegatewayListTags(conn, poolARN)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for Storage Gateway Tape Pool (%s): %w", poolARN, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsStorageGatewayTapePoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.StoragegatewayUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Storage Gateway Tape Pool (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsStorageGatewayTapePoolRead(d, meta)
+}
+
+func resourceAwsStorageGatewayTapePoolDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	input := &storagegateway.DeleteTapePoolInput{
+		PoolARN: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Storage Gateway Tape Pool: %s", input)
+	_, err := conn.DeleteTapePool(input)
+
+	if isAWSErr(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified pool was not found") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Storage Gateway Tape Pool (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
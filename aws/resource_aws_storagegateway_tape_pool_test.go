@@ -0,0 +1,299 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/storagegateway/finder"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_storagegateway_tape_pool", &resource.Sweeper{
+		Name: "aws_storagegateway_tape_pool",
+		F:    testSweepStorageGatewayTapePools,
+	})
+}
+
+func testSweepStorageGatewayTapePools(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.(*AWSClient).storagegatewayconn
+	var sweeperErrs *multierror.Error
+
+	input := &storagegateway.ListTapePoolsInput{}
+	for {
+		output, err := conn.ListTapePools(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping Storage Gateway Tape Pool sweep for %s: %s", region, err)
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		if err != nil {
+			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Storage Gateway Tape Pools: %w", err))
+			return sweeperErrs.ErrorOrNil()
+		}
+
+		for _, pool := range output.PoolInfos {
+			arn := aws.StringValue(pool.PoolARN)
+
+			if aws.StringValue(pool.PoolStatus) == storagegateway.PoolStatusDeleted {
+				continue
+			}
+
+			r := resourceAwsStorageGatewayTapePool()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			log.Printf("[INFO] Deleting Storage Gateway Tape Pool: %s", arn)
+			if err := r.Delete(d, client); err != nil {
+				sweeperErr := fmt.Errorf("error deleting Storage Gateway Tape Pool (%s): %w", arn, err)
+				log.Printf("[ERROR] %s", sweeperErr)
+				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				continue
+			}
+		}
+
+		if aws.StringValue(output.Marker) == "" {
+			break
+		}
+
+		input.Marker = output.Marker
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
+
+func TestAccAWSStorageGatewayTapePool_basic(t *testing.T) {
+	var pool storagegateway.PoolInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_tape_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayTapePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayTapePoolConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayTapePoolExists(resourceName, &pool),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "storagegateway", regexp.MustCompile(`tapepool/pool-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "pool_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "GLACIER"),
+					resource.TestCheckResourceAttr(resourceName, "retention_lock_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "retention_lock_time_in_days", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayTapePool_RetentionLock(t *testing.T) {
+	var pool storagegateway.PoolInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_tape_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayTapePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayTapePoolConfigRetentionLock(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayTapePoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "pool_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "storage_class", "GLACIER"),
+					resource.TestCheckResourceAttr(resourceName, "retention_lock_type", "GOVERNANCE"),
+					resource.TestCheckResourceAttr(resourceName, "retention_lock_time_in_days", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayTapePool_Tags(t *testing.T) {
+	var pool storagegateway.PoolInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_tape_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayTapePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayTapePoolConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayTapePoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSStorageGatewayTapePoolConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayTapePoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSStorageGatewayTapePoolConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayTapePoolExists(resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSStorageGatewayTapePool_disappears(t *testing.T) {
+	var pool storagegateway.PoolInfo
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_storagegateway_tape_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSStorageGatewayTapePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSStorageGatewayTapePoolConfigBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSStorageGatewayTapePoolExists(resourceName, &pool),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsStorageGatewayTapePool(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSStorageGatewayTapePoolExists(resourceName string, pool *storagegateway.PoolInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+		output, err := finder.TapePoolByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return fmt.Errorf("error reading Storage Gateway tape pool: %w", err)
+		}
+
+		if output == nil || aws.StringValue(output.PoolStatus) == storagegateway.PoolStatusDeleted {
+			return fmt.Errorf("Storage Gateway tape pool %q not found", rs.Primary.ID)
+		}
+
+		*pool = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSStorageGatewayTapePoolDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).storagegatewayconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_storagegateway_tape_pool" {
+			continue
+		}
+
+		output, err := finder.TapePoolByARN(conn, rs.Primary.ID)
+
+		if isAWSErr(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified pool was not found") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && aws.StringValue(output.PoolStatus) != storagegateway.PoolStatusDeleted {
+			return fmt.Errorf("Storage Gateway tape pool %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSStorageGatewayTapePoolConfigBasic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_storagegateway_tape_pool" "test" {
+  pool_name     = %[1]q
+  storage_class = "GLACIER"
+}
+`, rName)
+}
+
+func testAccAWSStorageGatewayTapePoolConfigRetentionLock(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_storagegateway_tape_pool" "test" {
+  pool_name                   = %[1]q
+  storage_class               = "GLACIER"
+  retention_lock_type         = "GOVERNANCE"
+  retention_lock_time_in_days = 1
+}
+`, rName)
+}
+
+func testAccAWSStorageGatewayTapePoolConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_storagegateway_tape_pool" "test" {
+  pool_name     = %[1]q
+  storage_class = "GLACIER"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSStorageGatewayTapePoolConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_storagegateway_tape_pool" "test" {
+  pool_name     = %[1]q
+  storage_class = "GLACIER"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
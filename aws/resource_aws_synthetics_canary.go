@@ -0,0 +1,740 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/synthetics"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/synthetics/waiter"
+)
+
+func resourceAwsSyntheticsCanary() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSyntheticsCanaryCreate,
+		Read:   resourceAwsSyntheticsCanaryRead,
+		Update: resourceAwsSyntheticsCanaryUpdate,
+		Delete: resourceAwsSyntheticsCanaryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"artifact_s3_location": {
+				Type:     schema.TypeString,
+				Required: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimPrefix(new, "s3://") == old
+				},
+			},
+			"delete_lambda": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"engine_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"failure_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      31,
+				ValidateFunc: validation.IntBetween(1, 455),
+			},
+			"handler": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 21),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-z_\-]+$`), "must contain only lowercase alphanumeric characters, hyphens, or underscores"),
+				),
+			},
+			"run_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"memory_in_mb": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.All(
+								validation.IntDivisibleBy(64),
+								validation.IntAtLeast(960),
+							),
+						},
+						"timeout_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      840,
+							ValidateFunc: validation.IntBetween(60, 840),
+						},
+					},
+				},
+			},
+			"runtime_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"zip_file", "s3_bucket"},
+				RequiredWith: []string{"s3_key"},
+			},
+			"s3_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"zip_file"},
+				RequiredWith:  []string{"s3_bucket"},
+			},
+			"s3_version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"zip_file"},
+			},
+			"schedule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"duration_in_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"expression": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"source_location_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_canary": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"success_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      31,
+				ValidateFunc: validation.IntBetween(1, 455),
+			},
+			"tags": tagsSchema(),
+			"timeline": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_started": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_stopped": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"zip_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ExactlyOneOf:  []string{"zip_file", "s3_bucket"},
+				ConflictsWith: []string{"s3_key", "s3_version"},
+			},
+		},
+	}
+}
+
+func resourceAwsSyntheticsCanaryCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).syntheticsconn
+
+	name := d.Get("name").(string)
+	input := &synthetics.CreateCanaryInput{
+		ArtifactS3Location:           aws.String(d.Get("artifact_s3_location").(string)),
+		ExecutionRoleArn:             aws.String(d.Get("execution_role_arn").(string)),
+		FailureRetentionPeriodInDays: aws.Int64(int64(d.Get("failure_retention_period").(int))),
+		Name:                         aws.String(name),
+		RuntimeVersion:               aws.String(d.Get("runtime_version").(string)),
+		Schedule:                     expandSyntheticsCanarySchedule(d.Get("schedule").([]interface{})),
+		SuccessRetentionPeriodInDays: aws.Int64(int64(d.Get("success_retention_period").(int))),
+	}
+
+	code, err := expandSyntheticsCanaryCode(d)
+
+	if err != nil {
+		return err
+	}
+
+	input.Code = code
+
+	if v, ok := d.GetOk("run_config"); ok {
+		input.RunConfig = expandSyntheticsCanaryRunConfig(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("vpc_config"); ok {
+		input.VpcConfig = expandSyntheticsCanaryVpcConfig(v.([]interface{}))
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		input.Tags = keyvaluetags.New(v).IgnoreAws().SyntheticsTags()
+	}
+
+	log.Printf("[DEBUG] Creating Synthetics Canary: %s", name)
+	_, err = conn.CreateCanary(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Synthetics Canary (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waiter.CanaryReady(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Synthetics Canary (%s) creation: %w", d.Id(), err)
+	}
+
+	if d.Get("start_canary").(bool) {
+		if err := syntheticsStartCanary(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSyntheticsCanaryRead(d, meta)
+}
+
+func resourceAwsSyntheticsCanaryRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).syntheticsconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	canary, err := finder.CanaryByName(conn, d.Id())
+
+	if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Synthetics Canary (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Synthetics Canary (%s): %w", d.Id(), err)
+	}
+
+	if canary == nil {
+		log.Printf("[WARN] Synthetics Canary (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	canaryArn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "synthetics",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("canary:%s", aws.StringValue(canary.Name)),
+	}.String()
+
+	d.Set("arn", canaryArn)
+	d.Set("artifact_s3_location", canary.ArtifactS3Location)
+	d.Set("engine_arn", canary.EngineArn)
+	d.Set("execution_role_arn", canary.ExecutionRoleArn)
+	d.Set("failure_retention_period", canary.FailureRetentionPeriodInDays)
+	d.Set("name", canary.Name)
+	d.Set("runtime_version", canary.RuntimeVersion)
+	d.Set("success_retention_period", canary.SuccessRetentionPeriodInDays)
+
+	if canary.Code != nil {
+		d.Set("handler", canary.Code.Handler)
+		d.Set("source_location_arn", canary.Code.SourceLocationArn)
+	}
+
+	if canary.Status != nil {
+		d.Set("status", canary.Status.State)
+	}
+
+	if err := d.Set("run_config", flattenSyntheticsCanaryRunConfig(canary.RunConfig)); err != nil {
+		return fmt.Errorf("error setting run_config: %w", err)
+	}
+
+	if err := d.Set("schedule", flattenSyntheticsCanarySchedule(canary.Schedule)); err != nil {
+		return fmt.Errorf("error setting schedule: %w", err)
+	}
+
+	if err := d.Set("timeline", flattenSyntheticsCanaryTimeline(canary.Timeline)); err != nil {
+		return fmt.Errorf("error setting timeline: %w", err)
+	}
+
+	if err := d.Set("vpc_config", flattenSyntheticsCanaryVpcConfig(canary.VpcConfig)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %w", err)
+	}
+
+	if err := d.Set("tags", keyvaluetags.SyntheticsKeyValueTags(canary.Tags).IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSyntheticsCanaryUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).syntheticsconn
+
+	if d.HasChanges(
+		"execution_role_arn",
+		"failure_retention_period",
+		"handler",
+		"run_config",
+		"runtime_version",
+		"s3_bucket",
+		"s3_key",
+		"s3_version",
+		"schedule",
+		"success_retention_period",
+		"vpc_config",
+		"zip_file") {
+		input := &synthetics.UpdateCanaryInput{
+			Name: aws.String(d.Id()),
+		}
+
+		if d.HasChanges("handler", "s3_bucket", "s3_key", "s3_version", "zip_file") {
+			code, err := expandSyntheticsCanaryCode(d)
+
+			if err != nil {
+				return err
+			}
+
+			input.Code = code
+		}
+
+		if d.HasChange("execution_role_arn") {
+			input.ExecutionRoleArn = aws.String(d.Get("execution_role_arn").(string))
+		}
+
+		if d.HasChange("failure_retention_period") {
+			input.FailureRetentionPeriodInDays = aws.Int64(int64(d.Get("failure_retention_period").(int)))
+		}
+
+		if d.HasChange("run_config") {
+			input.RunConfig = expandSyntheticsCanaryRunConfig(d.Get("run_config").([]interface{}))
+		}
+
+		if d.HasChange("runtime_version") {
+			input.RuntimeVersion = aws.String(d.Get("runtime_version").(string))
+		}
+
+		if d.HasChange("schedule") {
+			input.Schedule = expandSyntheticsCanarySchedule(d.Get("schedule").([]interface{}))
+		}
+
+		if d.HasChange("success_retention_period") {
+			input.SuccessRetentionPeriodInDays = aws.Int64(int64(d.Get("success_retention_period").(int)))
+		}
+
+		if d.HasChange("vpc_config") {
+			input.VpcConfig = expandSyntheticsCanaryVpcConfig(d.Get("vpc_config").([]interface{}))
+		}
+
+		status := d.Get("status").(string)
+
+		log.Printf("[DEBUG] Updating Synthetics Canary: %s", d.Id())
+		_, err := conn.UpdateCanary(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Synthetics Canary (%s): %w", d.Id(), err)
+		}
+
+		switch status {
+		case synthetics.CanaryStateRunning:
+			_, err = waiter.CanaryRunning(conn, d.Id())
+		case synthetics.CanaryStateStopped:
+			_, err = waiter.CanaryStopped(conn, d.Id())
+		default:
+			_, err = waiter.CanaryReady(conn, d.Id())
+		}
+
+		if err != nil {
+			return fmt.Errorf("error waiting for Synthetics Canary (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("start_canary") {
+		status := d.Get("status").(string)
+
+		if d.Get("start_canary").(bool) {
+			if status != synthetics.CanaryStateRunning {
+				if err := syntheticsStartCanary(conn, d.Id()); err != nil {
+					return err
+				}
+			}
+		} else {
+			if status == synthetics.CanaryStateRunning {
+				if err := syntheticsStopCanary(conn, d.Id()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+
+		if err := keyvaluetags.SyntheticsUpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating Synthetics Canary (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSyntheticsCanaryRead(d, meta)
+}
+
+func resourceAwsSyntheticsCanaryDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).syntheticsconn
+
+	if status := d.Get("status").(string); status == synthetics.CanaryStateRunning {
+		if err := syntheticsStopCanary(conn, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Synthetics Canary: %s", d.Id())
+	_, err := conn.DeleteCanary(&synthetics.DeleteCanaryInput{
+		Name: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, synthetics.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Synthetics Canary (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waiter.CanaryDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Synthetics Canary (%s) deletion: %w", d.Id(), err)
+	}
+
+	if d.Get("delete_lambda").(bool) {
+		lambdaconn := meta.(*AWSClient).lambdaconn
+
+		if err := syntheticsDeleteCanaryLambda(lambdaconn, d.Get("engine_arn").(string), d.Get("source_location_arn").(string)); err != nil {
+			return fmt.Errorf("error deleting Synthetics Canary (%s) Lambda resources: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func syntheticsStartCanary(conn *synthetics.Synthetics, name string) error {
+	log.Printf("[DEBUG] Starting Synthetics Canary: %s", name)
+	_, err := conn.StartCanary(&synthetics.StartCanaryInput{
+		Name: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error starting Synthetics Canary (%s): %w", name, err)
+	}
+
+	if _, err := waiter.CanaryRunning(conn, name); err != nil {
+		return fmt.Errorf("error waiting for Synthetics Canary (%s) start: %w", name, err)
+	}
+
+	return nil
+}
+
+func syntheticsStopCanary(conn *synthetics.Synthetics, name string) error {
+	log.Printf("[DEBUG] Stopping Synthetics Canary: %s", name)
+	_, err := conn.StopCanary(&synthetics.StopCanaryInput{
+		Name: aws.String(name),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping Synthetics Canary (%s): %w", name, err)
+	}
+
+	if _, err := waiter.CanaryStopped(conn, name); err != nil {
+		return fmt.Errorf("error waiting for Synthetics Canary (%s) stop: %w", name, err)
+	}
+
+	return nil
+}
+
+// syntheticsDeleteCanaryLambda removes the Lambda function and layer that
+// the Synthetics service creates on behalf of a canary. They are not removed
+// when the canary itself is deleted.
+func syntheticsDeleteCanaryLambda(conn *lambda.Lambda, engineArn, sourceLocationArn string) error {
+	if engineArn != "" {
+		parsedArn, err := arn.Parse(engineArn)
+
+		if err != nil {
+			return fmt.Errorf("error parsing engine ARN (%s): %w", engineArn, err)
+		}
+
+		// Resource is of the form function:name[:qualifier].
+		parts := strings.Split(parsedArn.Resource, ":")
+
+		if len(parts) < 2 {
+			return fmt.Errorf("unexpected format for engine ARN (%s)", engineArn)
+		}
+
+		log.Printf("[DEBUG] Deleting Lambda Function: %s", parts[1])
+		_, err = conn.DeleteFunction(&lambda.DeleteFunctionInput{
+			FunctionName: aws.String(parts[1]),
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceNotFoundException) {
+			return fmt.Errorf("error deleting Lambda Function (%s): %w", parts[1], err)
+		}
+	}
+
+	if sourceLocationArn != "" {
+		parsedArn, err := arn.Parse(sourceLocationArn)
+
+		if err != nil {
+			return fmt.Errorf("error parsing source location ARN (%s): %w", sourceLocationArn, err)
+		}
+
+		// Resource is of the form layer:name:version.
+		parts := strings.Split(parsedArn.Resource, ":")
+
+		if len(parts) < 2 {
+			return fmt.Errorf("unexpected format for source location ARN (%s)", sourceLocationArn)
+		}
+
+		layerName := parts[1]
+		var versions []*int64
+
+		err = conn.ListLayerVersionsPages(&lambda.ListLayerVersionsInput{
+			LayerName: aws.String(layerName),
+		}, func(page *lambda.ListLayerVersionsOutput, lastPage bool) bool {
+			for _, v := range page.LayerVersions {
+				if v == nil {
+					continue
+				}
+
+				versions = append(versions, v.Version)
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return fmt.Errorf("error listing Lambda Layer (%s) versions: %w", layerName, err)
+		}
+
+		for _, version := range versions {
+			log.Printf("[DEBUG] Deleting Lambda Layer Version: %s:%d", layerName, aws.Int64Value(version))
+			_, err := conn.DeleteLayerVersion(&lambda.DeleteLayerVersionInput{
+				LayerName:     aws.String(layerName),
+				VersionNumber: version,
+			})
+
+			if err != nil {
+				return fmt.Errorf("error deleting Lambda Layer Version (%s:%d): %w", layerName, aws.Int64Value(version), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandSyntheticsCanaryCode(d *schema.ResourceData) (*synthetics.CanaryCodeInput, error) {
+	code := &synthetics.CanaryCodeInput{
+		Handler: aws.String(d.Get("handler").(string)),
+	}
+
+	if v, ok := d.GetOk("zip_file"); ok {
+		zipFile, err := loadFileContent(v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to load %q: %w", v.(string), err)
+		}
+
+		code.ZipFile = zipFile
+	} else {
+		code.S3Bucket = aws.String(d.Get("s3_bucket").(string))
+		code.S3Key = aws.String(d.Get("s3_key").(string))
+
+		if v, ok := d.GetOk("s3_version"); ok {
+			code.S3Version = aws.String(v.(string))
+		}
+	}
+
+	return code, nil
+}
+
+func expandSyntheticsCanarySchedule(l []interface{}) *synthetics.CanaryScheduleInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	schedule := &synthetics.CanaryScheduleInput{
+		Expression: aws.String(m["expression"].(string)),
+	}
+
+	if v, ok := m["duration_in_seconds"].(int); ok {
+		schedule.DurationInSeconds = aws.Int64(int64(v))
+	}
+
+	return schedule
+}
+
+func flattenSyntheticsCanarySchedule(schedule *synthetics.CanaryScheduleOutput) []interface{} {
+	if schedule == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"duration_in_seconds": aws.Int64Value(schedule.DurationInSeconds),
+		"expression":          aws.StringValue(schedule.Expression),
+	}
+
+	return []interface{}{m}
+}
+
+func expandSyntheticsCanaryRunConfig(l []interface{}) *synthetics.CanaryRunConfigInput {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	runConfig := &synthetics.CanaryRunConfigInput{
+		TimeoutInSeconds: aws.Int64(int64(m["timeout_in_seconds"].(int))),
+	}
+
+	if v, ok := m["memory_in_mb"].(int); ok && v > 0 {
+		runConfig.MemoryInMB = aws.Int64(int64(v))
+	}
+
+	return runConfig
+}
+
+func flattenSyntheticsCanaryRunConfig(runConfig *synthetics.CanaryRunConfigOutput) []interface{} {
+	if runConfig == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"memory_in_mb":       aws.Int64Value(runConfig.MemoryInMB),
+		"timeout_in_seconds": aws.Int64Value(runConfig.TimeoutInSeconds),
+	}
+
+	return []interface{}{m}
+}
+
+func expandSyntheticsCanaryVpcConfig(l []interface{}) *synthetics.VpcConfigInput {
+	if len(l) == 0 || l[0] == nil {
+		return &synthetics.VpcConfigInput{}
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &synthetics.VpcConfigInput{
+		SecurityGroupIds: expandStringSet(m["security_group_ids"].(*schema.Set)),
+		SubnetIds:        expandStringSet(m["subnet_ids"].(*schema.Set)),
+	}
+}
+
+func flattenSyntheticsCanaryVpcConfig(vpcConfig *synthetics.VpcConfigOutput) []interface{} {
+	if vpcConfig == nil || aws.StringValue(vpcConfig.VpcId) == "" {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"security_group_ids": flattenStringSet(vpcConfig.SecurityGroupIds),
+		"subnet_ids":         flattenStringSet(vpcConfig.SubnetIds),
+		"vpc_id":             aws.StringValue(vpcConfig.VpcId),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenSyntheticsCanaryTimeline(timeline *synthetics.CanaryTimeline) []interface{} {
+	if timeline == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"created": aws.TimeValue(timeline.Created).Format(time.RFC3339),
+	}
+
+	if timeline.LastModified != nil {
+		m["last_modified"] = aws.TimeValue(timeline.LastModified).Format(time.RFC3339)
+	}
+
+	if timeline.LastStarted != nil {
+		m["last_started"] = aws.TimeValue(timeline.LastStarted).Format(time.RFC3339)
+	}
+
+	if timeline.LastStopped != nil {
+		m["last_stopped"] = aws.TimeValue(timeline.LastStopped).Format(time.RFC3339)
+	}
+
+	return []interface{}{m}
+}
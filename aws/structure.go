@@ -1062,6 +1062,15 @@ func flattenStringSet(list []*string) *schema.Set {
 	return schema.NewSet(schema.HashString, flattenStringList(list))
 }
 
+// Takes list of pointers to int64s and returns a schema.Set of ints
+func flattenInt64Set(list []*int64) *schema.Set {
+	vs := make([]interface{}, 0, len(list))
+	for _, v := range list {
+		vs = append(vs, int(aws.Int64Value(v)))
+	}
+	return schema.NewSet(schema.HashInt, vs)
+}
+
 // hashStringCaseInsensitive hashes strings in a case insensitive manner.
 // If you want a Set of strings and are case inensitive, this is the SchemaSetFunc you want.
 func hashStringCaseInsensitive(v interface{}) int {
@@ -5305,6 +5314,505 @@ func flattenAppmeshRouteSpec(spec *appmesh.RouteSpec) []interface{} {
 	return []interface{}{mSpec}
 }
 
+func expandAppmeshVirtualGatewaySpec(vSpec []interface{}) *appmesh.VirtualGatewaySpec {
+	spec := &appmesh.VirtualGatewaySpec{}
+
+	if len(vSpec) == 0 || vSpec[0] == nil {
+		return spec
+	}
+	mSpec := vSpec[0].(map[string]interface{})
+
+	if vBackendDefaults, ok := mSpec["backend_defaults"].([]interface{}); ok && len(vBackendDefaults) > 0 && vBackendDefaults[0] != nil {
+		mBackendDefaults := vBackendDefaults[0].(map[string]interface{})
+
+		spec.BackendDefaults = &appmesh.VirtualGatewayBackendDefaults{}
+
+		if vClientPolicy, ok := mBackendDefaults["client_policy"].([]interface{}); ok && len(vClientPolicy) > 0 && vClientPolicy[0] != nil {
+			mClientPolicy := vClientPolicy[0].(map[string]interface{})
+
+			spec.BackendDefaults.ClientPolicy = &appmesh.VirtualGatewayClientPolicy{}
+
+			if vTls, ok := mClientPolicy["tls"].([]interface{}); ok && len(vTls) > 0 && vTls[0] != nil {
+				mTls := vTls[0].(map[string]interface{})
+
+				tls := &appmesh.VirtualGatewayClientPolicyTls{}
+
+				if vEnforce, ok := mTls["enforce"].(bool); ok {
+					tls.Enforce = aws.Bool(vEnforce)
+				}
+
+				if vPorts, ok := mTls["ports"].(*schema.Set); ok && vPorts.Len() > 0 {
+					tls.Ports = expandInt64Set(vPorts)
+				}
+
+				if vValidation, ok := mTls["validation"].([]interface{}); ok && len(vValidation) > 0 && vValidation[0] != nil {
+					mValidation := vValidation[0].(map[string]interface{})
+
+					tls.Validation = &appmesh.VirtualGatewayTlsValidationContext{}
+
+					if vTrust, ok := mValidation["trust"].([]interface{}); ok && len(vTrust) > 0 && vTrust[0] != nil {
+						mTrust := vTrust[0].(map[string]interface{})
+
+						tls.Validation.Trust = &appmesh.VirtualGatewayTlsValidationContextTrust{}
+
+						if vAcm, ok := mTrust["acm"].([]interface{}); ok && len(vAcm) > 0 && vAcm[0] != nil {
+							mAcm := vAcm[0].(map[string]interface{})
+
+							if vCertificateAuthorityArns, ok := mAcm["certificate_authority_arns"].(*schema.Set); ok && vCertificateAuthorityArns.Len() > 0 {
+								tls.Validation.Trust.Acm = &appmesh.VirtualGatewayTlsValidationContextAcmTrust{
+									CertificateAuthorityArns: expandStringSet(vCertificateAuthorityArns),
+								}
+							}
+						}
+
+						if vFile, ok := mTrust["file"].([]interface{}); ok && len(vFile) > 0 && vFile[0] != nil {
+							mFile := vFile[0].(map[string]interface{})
+
+							if vCertificateChain, ok := mFile["certificate_chain"].(string); ok && vCertificateChain != "" {
+								tls.Validation.Trust.File = &appmesh.VirtualGatewayTlsValidationContextFileTrust{
+									CertificateChain: aws.String(vCertificateChain),
+								}
+							}
+						}
+					}
+				}
+
+				spec.BackendDefaults.ClientPolicy.Tls = tls
+			}
+		}
+	}
+
+	if vListeners, ok := mSpec["listener"].([]interface{}); ok && len(vListeners) > 0 && vListeners[0] != nil {
+		listeners := []*appmesh.VirtualGatewayListener{}
+
+		for _, vListener := range vListeners {
+			listener := &appmesh.VirtualGatewayListener{}
+
+			mListener := vListener.(map[string]interface{})
+
+			if vHealthCheck, ok := mListener["health_check"].([]interface{}); ok && len(vHealthCheck) > 0 && vHealthCheck[0] != nil {
+				mHealthCheck := vHealthCheck[0].(map[string]interface{})
+
+				listener.HealthCheck = &appmesh.VirtualGatewayHealthCheckPolicy{}
+
+				if vHealthyThreshold, ok := mHealthCheck["healthy_threshold"].(int); ok && vHealthyThreshold > 0 {
+					listener.HealthCheck.HealthyThreshold = aws.Int64(int64(vHealthyThreshold))
+				}
+				if vIntervalMillis, ok := mHealthCheck["interval_millis"].(int); ok && vIntervalMillis > 0 {
+					listener.HealthCheck.IntervalMillis = aws.Int64(int64(vIntervalMillis))
+				}
+				if vPath, ok := mHealthCheck["path"].(string); ok && vPath != "" {
+					listener.HealthCheck.Path = aws.String(vPath)
+				}
+				if vPort, ok := mHealthCheck["port"].(int); ok && vPort > 0 {
+					listener.HealthCheck.Port = aws.Int64(int64(vPort))
+				}
+				if vProtocol, ok := mHealthCheck["protocol"].(string); ok && vProtocol != "" {
+					listener.HealthCheck.Protocol = aws.String(vProtocol)
+				}
+				if vTimeoutMillis, ok := mHealthCheck["timeout_millis"].(int); ok && vTimeoutMillis > 0 {
+					listener.HealthCheck.TimeoutMillis = aws.Int64(int64(vTimeoutMillis))
+				}
+				if vUnhealthyThreshold, ok := mHealthCheck["unhealthy_threshold"].(int); ok && vUnhealthyThreshold > 0 {
+					listener.HealthCheck.UnhealthyThreshold = aws.Int64(int64(vUnhealthyThreshold))
+				}
+			}
+
+			if vPortMapping, ok := mListener["port_mapping"].([]interface{}); ok && len(vPortMapping) > 0 && vPortMapping[0] != nil {
+				mPortMapping := vPortMapping[0].(map[string]interface{})
+
+				listener.PortMapping = &appmesh.VirtualGatewayPortMapping{}
+
+				if vPort, ok := mPortMapping["port"].(int); ok && vPort > 0 {
+					listener.PortMapping.Port = aws.Int64(int64(vPort))
+				}
+				if vProtocol, ok := mPortMapping["protocol"].(string); ok && vProtocol != "" {
+					listener.PortMapping.Protocol = aws.String(vProtocol)
+				}
+			}
+
+			if vTls, ok := mListener["tls"].([]interface{}); ok && len(vTls) > 0 && vTls[0] != nil {
+				mTls := vTls[0].(map[string]interface{})
+
+				listener.Tls = &appmesh.VirtualGatewayListenerTls{}
+
+				if vMode, ok := mTls["mode"].(string); ok && vMode != "" {
+					listener.Tls.Mode = aws.String(vMode)
+				}
+
+				if vCertificate, ok := mTls["certificate"].([]interface{}); ok && len(vCertificate) > 0 && vCertificate[0] != nil {
+					mCertificate := vCertificate[0].(map[string]interface{})
+
+					listener.Tls.Certificate = &appmesh.VirtualGatewayListenerTlsCertificate{}
+
+					if vAcm, ok := mCertificate["acm"].([]interface{}); ok && len(vAcm) > 0 && vAcm[0] != nil {
+						mAcm := vAcm[0].(map[string]interface{})
+
+						if vCertificateArn, ok := mAcm["certificate_arn"].(string); ok && vCertificateArn != "" {
+							listener.Tls.Certificate.Acm = &appmesh.VirtualGatewayListenerTlsAcmCertificate{
+								CertificateArn: aws.String(vCertificateArn),
+							}
+						}
+					}
+
+					if vFile, ok := mCertificate["file"].([]interface{}); ok && len(vFile) > 0 && vFile[0] != nil {
+						mFile := vFile[0].(map[string]interface{})
+
+						listener.Tls.Certificate.File = &appmesh.VirtualGatewayListenerTlsFileCertificate{}
+
+						if vCertificateChain, ok := mFile["certificate_chain"].(string); ok && vCertificateChain != "" {
+							listener.Tls.Certificate.File.CertificateChain = aws.String(vCertificateChain)
+						}
+						if vPrivateKey, ok := mFile["private_key"].(string); ok && vPrivateKey != "" {
+							listener.Tls.Certificate.File.PrivateKey = aws.String(vPrivateKey)
+						}
+					}
+				}
+			}
+
+			listeners = append(listeners, listener)
+		}
+
+		spec.Listeners = listeners
+	}
+
+	if vLogging, ok := mSpec["logging"].([]interface{}); ok && len(vLogging) > 0 && vLogging[0] != nil {
+		mLogging := vLogging[0].(map[string]interface{})
+
+		if vAccessLog, ok := mLogging["access_log"].([]interface{}); ok && len(vAccessLog) > 0 && vAccessLog[0] != nil {
+			mAccessLog := vAccessLog[0].(map[string]interface{})
+
+			if vFile, ok := mAccessLog["file"].([]interface{}); ok && len(vFile) > 0 && vFile[0] != nil {
+				mFile := vFile[0].(map[string]interface{})
+
+				if vPath, ok := mFile["path"].(string); ok && vPath != "" {
+					spec.Logging = &appmesh.VirtualGatewayLogging{
+						AccessLog: &appmesh.VirtualGatewayAccessLog{
+							File: &appmesh.VirtualGatewayFileAccessLog{
+								Path: aws.String(vPath),
+							},
+						},
+					}
+				}
+			}
+		}
+	}
+
+	return spec
+}
+
+func flattenAppmeshVirtualGatewaySpec(spec *appmesh.VirtualGatewaySpec) []interface{} {
+	if spec == nil {
+		return []interface{}{}
+	}
+
+	mSpec := map[string]interface{}{}
+
+	if spec.BackendDefaults != nil {
+		mBackendDefaults := map[string]interface{}{}
+
+		if clientPolicy := spec.BackendDefaults.ClientPolicy; clientPolicy != nil {
+			mClientPolicy := map[string]interface{}{}
+
+			if tls := clientPolicy.Tls; tls != nil {
+				mTls := map[string]interface{}{
+					"enforce": aws.BoolValue(tls.Enforce),
+					"ports":   flattenInt64Set(tls.Ports),
+				}
+
+				if validation := tls.Validation; validation != nil {
+					mValidation := map[string]interface{}{}
+
+					if trust := validation.Trust; trust != nil {
+						mTrust := map[string]interface{}{}
+
+						if trust.Acm != nil {
+							mTrust["acm"] = []interface{}{
+								map[string]interface{}{
+									"certificate_authority_arns": flattenStringSet(trust.Acm.CertificateAuthorityArns),
+								},
+							}
+						}
+
+						if trust.File != nil {
+							mTrust["file"] = []interface{}{
+								map[string]interface{}{
+									"certificate_chain": aws.StringValue(trust.File.CertificateChain),
+								},
+							}
+						}
+
+						mValidation["trust"] = []interface{}{mTrust}
+					}
+
+					mTls["validation"] = []interface{}{mValidation}
+				}
+
+				mClientPolicy["tls"] = []interface{}{mTls}
+			}
+
+			mBackendDefaults["client_policy"] = []interface{}{mClientPolicy}
+		}
+
+		mSpec["backend_defaults"] = []interface{}{mBackendDefaults}
+	}
+
+	if spec.Listeners != nil && spec.Listeners[0] != nil {
+		// Per schema definition, set at most 1 Listener
+		listener := spec.Listeners[0]
+		mListener := map[string]interface{}{}
+
+		if listener.HealthCheck != nil {
+			mHealthCheck := map[string]interface{}{
+				"healthy_threshold":   int(aws.Int64Value(listener.HealthCheck.HealthyThreshold)),
+				"interval_millis":     int(aws.Int64Value(listener.HealthCheck.IntervalMillis)),
+				"path":                aws.StringValue(listener.HealthCheck.Path),
+				"port":                int(aws.Int64Value(listener.HealthCheck.Port)),
+				"protocol":            aws.StringValue(listener.HealthCheck.Protocol),
+				"timeout_millis":      int(aws.Int64Value(listener.HealthCheck.TimeoutMillis)),
+				"unhealthy_threshold": int(aws.Int64Value(listener.HealthCheck.UnhealthyThreshold)),
+			}
+			mListener["health_check"] = []interface{}{mHealthCheck}
+		}
+
+		if listener.PortMapping != nil {
+			mPortMapping := map[string]interface{}{
+				"port":     int(aws.Int64Value(listener.PortMapping.Port)),
+				"protocol": aws.StringValue(listener.PortMapping.Protocol),
+			}
+			mListener["port_mapping"] = []interface{}{mPortMapping}
+		}
+
+		if tls := listener.Tls; tls != nil {
+			mTls := map[string]interface{}{
+				"mode": aws.StringValue(tls.Mode),
+			}
+
+			if certificate := tls.Certificate; certificate != nil {
+				mCertificate := map[string]interface{}{}
+
+				if certificate.Acm != nil {
+					mCertificate["acm"] = []interface{}{
+						map[string]interface{}{
+							"certificate_arn": aws.StringValue(certificate.Acm.CertificateArn),
+						},
+					}
+				}
+
+				if certificate.File != nil {
+					mCertificate["file"] = []interface{}{
+						map[string]interface{}{
+							"certificate_chain": aws.StringValue(certificate.File.CertificateChain),
+							"private_key":       aws.StringValue(certificate.File.PrivateKey),
+						},
+					}
+				}
+
+				mTls["certificate"] = []interface{}{mCertificate}
+			}
+
+			mListener["tls"] = []interface{}{mTls}
+		}
+
+		mSpec["listener"] = []interface{}{mListener}
+	}
+
+	if spec.Logging != nil && spec.Logging.AccessLog != nil && spec.Logging.AccessLog.File != nil {
+		mSpec["logging"] = []interface{}{
+			map[string]interface{}{
+				"access_log": []interface{}{
+					map[string]interface{}{
+						"file": []interface{}{
+							map[string]interface{}{
+								"path": aws.StringValue(spec.Logging.AccessLog.File.Path),
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	return []interface{}{mSpec}
+}
+
+func expandAppmeshGatewayRouteSpec(vSpec []interface{}) *appmesh.GatewayRouteSpec {
+	spec := &appmesh.GatewayRouteSpec{}
+
+	if len(vSpec) == 0 || vSpec[0] == nil {
+		return spec
+	}
+	mSpec := vSpec[0].(map[string]interface{})
+
+	if vGrpcRoute, ok := mSpec["grpc_route"].([]interface{}); ok && len(vGrpcRoute) > 0 && vGrpcRoute[0] != nil {
+		mGrpcRoute := vGrpcRoute[0].(map[string]interface{})
+
+		spec.GrpcRoute = &appmesh.GrpcGatewayRoute{}
+
+		if vAction, ok := mGrpcRoute["action"].([]interface{}); ok {
+			spec.GrpcRoute.Action = &appmesh.GrpcGatewayRouteAction{
+				Target: expandAppmeshGatewayRouteTarget(vAction),
+			}
+		}
+
+		if vMatch, ok := mGrpcRoute["match"].([]interface{}); ok && len(vMatch) > 0 && vMatch[0] != nil {
+			mMatch := vMatch[0].(map[string]interface{})
+
+			spec.GrpcRoute.Match = &appmesh.GrpcGatewayRouteMatch{}
+
+			if vServiceName, ok := mMatch["service_name"].(string); ok && vServiceName != "" {
+				spec.GrpcRoute.Match.ServiceName = aws.String(vServiceName)
+			}
+		}
+	}
+
+	if vHttp2Route, ok := mSpec["http2_route"].([]interface{}); ok {
+		spec.Http2Route = expandAppmeshHttpGatewayRoute(vHttp2Route)
+	}
+
+	if vHttpRoute, ok := mSpec["http_route"].([]interface{}); ok {
+		spec.HttpRoute = expandAppmeshHttpGatewayRoute(vHttpRoute)
+	}
+
+	return spec
+}
+
+func expandAppmeshHttpGatewayRoute(vHttpRoute []interface{}) *appmesh.HttpGatewayRoute {
+	if len(vHttpRoute) == 0 || vHttpRoute[0] == nil {
+		return nil
+	}
+	mHttpRoute := vHttpRoute[0].(map[string]interface{})
+
+	httpRoute := &appmesh.HttpGatewayRoute{}
+
+	if vAction, ok := mHttpRoute["action"].([]interface{}); ok {
+		httpRoute.Action = &appmesh.HttpGatewayRouteAction{
+			Target: expandAppmeshGatewayRouteTarget(vAction),
+		}
+	}
+
+	if vMatch, ok := mHttpRoute["match"].([]interface{}); ok && len(vMatch) > 0 && vMatch[0] != nil {
+		mMatch := vMatch[0].(map[string]interface{})
+
+		httpRoute.Match = &appmesh.HttpGatewayRouteMatch{}
+
+		if vPrefix, ok := mMatch["prefix"].(string); ok && vPrefix != "" {
+			httpRoute.Match.Prefix = aws.String(vPrefix)
+		}
+	}
+
+	return httpRoute
+}
+
+// expandAppmeshGatewayRouteTarget expands the target of a gateway route action.
+func expandAppmeshGatewayRouteTarget(vAction []interface{}) *appmesh.GatewayRouteTarget {
+	if len(vAction) == 0 || vAction[0] == nil {
+		return nil
+	}
+	mAction := vAction[0].(map[string]interface{})
+
+	vTarget, ok := mAction["target"].([]interface{})
+	if !ok || len(vTarget) == 0 || vTarget[0] == nil {
+		return nil
+	}
+	mTarget := vTarget[0].(map[string]interface{})
+
+	target := &appmesh.GatewayRouteTarget{}
+
+	if vVirtualService, ok := mTarget["virtual_service"].([]interface{}); ok && len(vVirtualService) > 0 && vVirtualService[0] != nil {
+		mVirtualService := vVirtualService[0].(map[string]interface{})
+
+		target.VirtualService = &appmesh.GatewayRouteVirtualService{}
+
+		if vVirtualServiceName, ok := mVirtualService["virtual_service_name"].(string); ok && vVirtualServiceName != "" {
+			target.VirtualService.VirtualServiceName = aws.String(vVirtualServiceName)
+		}
+	}
+
+	return target
+}
+
+func flattenAppmeshGatewayRouteSpec(spec *appmesh.GatewayRouteSpec) []interface{} {
+	if spec == nil {
+		return []interface{}{}
+	}
+
+	mSpec := map[string]interface{}{}
+
+	if grpcRoute := spec.GrpcRoute; grpcRoute != nil {
+		mGrpcRoute := map[string]interface{}{}
+
+		if action := grpcRoute.Action; action != nil {
+			mGrpcRoute["action"] = flattenAppmeshGatewayRouteTarget(action.Target)
+		}
+
+		if match := grpcRoute.Match; match != nil {
+			mGrpcRoute["match"] = []interface{}{
+				map[string]interface{}{
+					"service_name": aws.StringValue(match.ServiceName),
+				},
+			}
+		}
+
+		mSpec["grpc_route"] = []interface{}{mGrpcRoute}
+	}
+
+	if spec.Http2Route != nil {
+		mSpec["http2_route"] = flattenAppmeshHttpGatewayRoute(spec.Http2Route)
+	}
+
+	if spec.HttpRoute != nil {
+		mSpec["http_route"] = flattenAppmeshHttpGatewayRoute(spec.HttpRoute)
+	}
+
+	return []interface{}{mSpec}
+}
+
+func flattenAppmeshHttpGatewayRoute(httpRoute *appmesh.HttpGatewayRoute) []interface{} {
+	if httpRoute == nil {
+		return []interface{}{}
+	}
+
+	mHttpRoute := map[string]interface{}{}
+
+	if action := httpRoute.Action; action != nil {
+		mHttpRoute["action"] = flattenAppmeshGatewayRouteTarget(action.Target)
+	}
+
+	if match := httpRoute.Match; match != nil {
+		mHttpRoute["match"] = []interface{}{
+			map[string]interface{}{
+				"prefix": aws.StringValue(match.Prefix),
+			},
+		}
+	}
+
+	return []interface{}{mHttpRoute}
+}
+
+// flattenAppmeshGatewayRouteTarget flattens the target of a gateway route into a gateway route action.
+func flattenAppmeshGatewayRouteTarget(target *appmesh.GatewayRouteTarget) []interface{} {
+	if target == nil {
+		return []interface{}{}
+	}
+
+	mTarget := map[string]interface{}{}
+
+	if virtualService := target.VirtualService; virtualService != nil {
+		mTarget["virtual_service"] = []interface{}{
+			map[string]interface{}{
+				"virtual_service_name": aws.StringValue(virtualService.VirtualServiceName),
+			},
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"target": []interface{}{mTarget},
+		},
+	}
+}
+
 func expandRoute53ResolverEndpointIpAddresses(vIpAddresses *schema.Set) []*route53resolver.IpAddressRequest {
 	ipAddressRequests := []*route53resolver.IpAddressRequest{}
 
@@ -544,6 +544,25 @@ func validateCloudWatchEventRuleName(v interface{}, k string) (ws []string, erro
 	return
 }
 
+func validateCloudWatchEventBusName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 || len(value) > 256 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 1 and 256 characters: %q", k, value))
+	}
+
+	// http://docs.aws.amazon.com/eventbridge/latest/APIReference/API_CreateEventBus.html
+	// Partner event bus names contain slashes.
+	pattern := `^[/\.\-_A-Za-z0-9]+$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't comply with restrictions (%q): %q",
+			k, pattern, value))
+	}
+
+	return
+}
+
 func validateCloudWatchLogResourcePolicyDocument(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	// http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutResourcePolicy.html
@@ -169,6 +169,33 @@ func TestValidateCloudWatchEventRuleName(t *testing.T) {
 	}
 }
 
+func TestValidateCloudWatchEventBusName(t *testing.T) {
+	validNames := []string{
+		"HelloWorl_d",
+		"hello-world",
+		"hello.World0125",
+		"aws.partner/example.com/123/event-source",
+	}
+	for _, v := range validNames {
+		_, errors := validateCloudWatchEventBusName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CW event bus name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"special@character",
+		strings.Repeat("W", 257),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateCloudWatchEventBusName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CW event bus name", v)
+		}
+	}
+}
+
 func TestValidateLambdaFunctionName(t *testing.T) {
 	validNames := []string{
 		"arn:aws:lambda:us-west-2:123456789012:function:ThumbNail",